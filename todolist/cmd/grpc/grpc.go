@@ -1,26 +1,45 @@
 package main
 
 import (
+	"context"
+	"crypto/rsa"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"log"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	errors_stack "github.com/go-errors/errors"
 	grpcMiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpcAuth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
 	grpcRecovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
+	"github.com/lib/pq"
+	"github.com/prometheus/client_golang/prometheus"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/grpclog"
 
+	"github.com/overridesh/sgg-todolist-service/internal/auth"
+	"github.com/overridesh/sgg-todolist-service/internal/broker"
+	"github.com/overridesh/sgg-todolist-service/internal/certificate"
 	healthcheck "github.com/overridesh/sgg-todolist-service/internal/grpc/healthcheck"
 	"github.com/overridesh/sgg-todolist-service/internal/grpc/todolist"
+	"github.com/overridesh/sgg-todolist-service/internal/logging"
+	"github.com/overridesh/sgg-todolist-service/internal/metrics"
+	"github.com/overridesh/sgg-todolist-service/internal/notifier"
+	"github.com/overridesh/sgg-todolist-service/internal/publisher"
 	"github.com/overridesh/sgg-todolist-service/internal/repository"
+	"github.com/overridesh/sgg-todolist-service/internal/tracing"
+	"github.com/overridesh/sgg-todolist-service/internal/webhook"
+	"github.com/overridesh/sgg-todolist-service/internal/worker"
 	"github.com/overridesh/sgg-todolist-service/pkg/storage/sql"
 	pbTodoList "github.com/overridesh/sgg-todolist-service/proto"
 	"github.com/overridesh/sgg-todolist-service/tools"
@@ -30,6 +49,16 @@ type app struct {
 	config     *Config
 	grpcServer *grpc.Server
 	sql        sql.DB
+	dataSource string
+	listener   *pq.Listener
+	stopJobs   context.CancelFunc
+	// certificates of the server, they are reloaded when their files change
+	certificates *certificate.Manager
+	// metrics of the requests and of the database, served by the admin server
+	registry      *prometheus.Registry
+	metricsServer *http.Server
+	// tracer of the requests, its spans are flushed when the app stops
+	tracer *sdktrace.TracerProvider
 }
 
 // Config secrets for app
@@ -42,10 +71,87 @@ type Config struct {
 		Host     string `envconfig:"DATABASE_HOSTNAME" required:"true"`
 		Port     int32  `envconfig:"DATABASE_PORT" required:"true"`
 	}
-	Certfile string `envconfig:"CERT_FILE" required:"true"`
-	Keyfile  string `envconfig:"KEY_FILE" required:"true"`
-	Host     string `default:"0.0.0.0" envconfig:"HOST"`
-	Port     int    `default:"10000" envconfig:"PORT"`
+	// Deleted tasks, comments and labels are purged after Days, 0 keeps them forever
+	Retention struct {
+		Days     int           `default:"30" envconfig:"RETENTION_DAYS"`
+		Interval time.Duration `default:"1h" envconfig:"RETENTION_INTERVAL"`
+	}
+	// Due reminders are posted to WebhookURL when set, otherwise they are only logged
+	Reminders struct {
+		Interval       time.Duration `default:"30s" envconfig:"REMINDERS_INTERVAL"`
+		BatchSize      int           `default:"100" envconfig:"REMINDERS_BATCH_SIZE"`
+		WebhookURL     string        `envconfig:"REMINDERS_WEBHOOK_URL"`
+		WebhookTimeout time.Duration `default:"10s" envconfig:"REMINDERS_WEBHOOK_TIMEOUT"`
+	}
+	// A failed delivery is retried after BackoffBase, doubling the delay up to BackoffMax, until MaxAttempts. The
+	// webhooks only reach public addresses, AllowPrivate also accepts loopback and private ones like a local receiver
+	Webhooks struct {
+		Interval     time.Duration `default:"5s" envconfig:"WEBHOOKS_INTERVAL"`
+		BatchSize    int           `default:"100" envconfig:"WEBHOOKS_BATCH_SIZE"`
+		Timeout      time.Duration `default:"10s" envconfig:"WEBHOOKS_TIMEOUT"`
+		BackoffBase  time.Duration `default:"30s" envconfig:"WEBHOOKS_BACKOFF_BASE"`
+		BackoffMax   time.Duration `default:"1h" envconfig:"WEBHOOKS_BACKOFF_MAX"`
+		MaxAttempts  int32         `default:"8" envconfig:"WEBHOOKS_MAX_ATTEMPTS"`
+		AllowPrivate bool          `default:"false" envconfig:"WEBHOOKS_ALLOW_PRIVATE"`
+	}
+	// The events of the outbox are relayed to the webhooks, and also logged when LogEvents is set. A failed event is
+	// relayed again after BackoffBase, doubling the delay up to BackoffMax, and the next events of its task wait for it
+	Outbox struct {
+		Interval    time.Duration `default:"1s" envconfig:"OUTBOX_INTERVAL"`
+		BatchSize   int           `default:"100" envconfig:"OUTBOX_BATCH_SIZE"`
+		LogEvents   bool          `default:"false" envconfig:"OUTBOX_LOG_EVENTS"`
+		BackoffBase time.Duration `default:"1s" envconfig:"OUTBOX_BACKOFF_BASE"`
+		BackoffMax  time.Duration `default:"5m" envconfig:"OUTBOX_BACKOFF_MAX"`
+	}
+	// Every WatchTasks stream holds up to BufferSize events, the streams that fall behind are ended
+	Watch struct {
+		BufferSize int `default:"256" envconfig:"WATCH_BUFFER_SIZE"`
+	}
+	// Callers send a JWT signed with Secret (HS256) or with a key of JWKSFile (RS256), Disabled accepts any caller
+	Auth struct {
+		Disabled bool   `default:"false" envconfig:"AUTH_DISABLED"`
+		Secret   string `envconfig:"AUTH_JWT_SECRET"`
+		JWKSFile string `envconfig:"AUTH_JWKS_FILE"`
+		Issuer   string `envconfig:"AUTH_ISSUER"`
+		Audience string `envconfig:"AUTH_AUDIENCE"`
+	}
+	// Mode is production for JSON logs or development for readable ones, the logs under Level are dropped
+	Log struct {
+		Mode  string `default:"production" envconfig:"LOG_MODE"`
+		Level string `default:"info" envconfig:"LOG_LEVEL"`
+	}
+	// The spans are exported to the OTLP collector of Endpoint, or written to stdout with the stdout exporter, none
+	// records no spans. SampleRatio of the traces that start in the service are sampled, the others follow their caller.
+	Tracing struct {
+		Exporter    string  `default:"none" envconfig:"TRACING_EXPORTER"`
+		Endpoint    string  `envconfig:"TRACING_ENDPOINT"`
+		Insecure    bool    `default:"false" envconfig:"TRACING_INSECURE"`
+		SampleRatio float64 `default:"1" envconfig:"TRACING_SAMPLE_RATIO"`
+	}
+	// With ClientCAfile every caller needs a client certificate signed by it, the certificate is the caller unless it is
+	// one of the ClientCertProxies, like the gateway, that forward the tokens of their callers
+	ClientCAfile      string   `envconfig:"CLIENT_CA_FILE"`
+	ClientCertProxies []string `envconfig:"CLIENT_CERT_PROXIES"`
+	Certfile          string   `envconfig:"CERT_FILE" required:"true"`
+	Keyfile           string   `envconfig:"KEY_FILE" required:"true"`
+	// The certificate files are checked for changes every CertReloadInterval, a rotated certificate needs no restart
+	CertReloadInterval time.Duration `default:"1m" envconfig:"CERT_RELOAD_INTERVAL"`
+	Host               string        `default:"0.0.0.0" envconfig:"HOST"`
+	Port               int           `default:"10000" envconfig:"PORT"`
+	// The admin port serves the Prometheus metrics on /metrics, 0 does not serve them
+	MetricsPort int `default:"9090" envconfig:"METRICS_PORT"`
+}
+
+// validate rejects the settings the app cannot run with, before anything is started
+func (c *Config) validate() error {
+	if c.Retention.Days > 0 && c.Retention.Interval <= 0 {
+		return errors.New("RETENTION_INTERVAL must be positive when RETENTION_DAYS is set")
+	}
+	if c.CertReloadInterval <= 0 {
+		return errors.New("CERT_RELOAD_INTERVAL must be positive")
+	}
+
+	return nil
 }
 
 func main() {
@@ -60,21 +166,36 @@ func main() {
 	if err = tools.GetConfig("", &config); err != nil {
 		log.Fatal(err)
 	}
+	if err = config.validate(); err != nil {
+		log.Fatal(err)
+	}
 
 	// Load Config
 	prg.config = &config
 
 	// Db Connection
-	prg.sql, err = sql.NewConnection(fmt.Sprintf("user=%s password=%s host=%s port=%d dbname=%s sslmode=disable",
+	prg.dataSource = fmt.Sprintf("user=%s password=%s host=%s port=%d dbname=%s sslmode=disable",
 		prg.config.Database.User,
 		prg.config.Database.Password,
 		prg.config.Database.Host,
 		prg.config.Database.Port,
 		prg.config.Database.Name,
-	))
+	)
+	prg.sql, err = sql.NewConnection(prg.dataSource)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Trace and record the queries of every repository
+	exporter, err := tracing.NewExporter(context.Background(), prg.config.Tracing.Exporter, prg.config.Tracing.Endpoint, prg.config.Tracing.Insecure)
 	if err != nil {
 		log.Fatal(err)
 	}
+	prg.tracer = tracing.NewProvider("todolist-grpc", exporter, prg.config.Tracing.SampleRatio)
+	tracing.SetGlobal(prg.tracer)
+
+	prg.registry = metrics.NewRegistry()
+	prg.sql = tracing.NewDB(metrics.NewDB(prg.sql, prg.registry), prg.tracer)
 
 	// start app
 	if err = prg.Start(); err != nil {
@@ -83,8 +204,7 @@ func main() {
 }
 
 func (p *app) Start() error {
-	// For now I won't dwell on something custom for logs.
-	logger, err := zap.NewDevelopment()
+	logger, err := logging.New(p.config.Log.Mode, p.config.Log.Level)
 	if err != nil {
 		log.Fatalf("cannot create new logger, error %v", err)
 	}
@@ -100,58 +220,198 @@ func (p *app) Start() error {
 		logger.Sugar().Fatalf("failed to listen: %v", err)
 	}
 
-	p.grpcServer, err = p.startGRPCServer(zapgrpc.NewLogger(zap.L()))
+	p.certificates, err = certificate.NewManager(p.config.Certfile, p.config.Keyfile, p.config.CertReloadInterval)
+	if err != nil {
+		logger.Sugar().Fatalf("failed to load certificate: %v", err)
+	}
+
+	// The api keys are the key store of the authentication too
+	apiKeyRepository := repository.NewApiKeyRepository(p.sql)
+
+	p.grpcServer, err = p.startGRPCServer(zapgrpc.NewLogger(zap.L()), apiKeyRepository)
 	if err != nil {
 		logger.Sugar().Fatalf("failed to start grpc server: %v", err)
 	}
 
+	taskRepository := repository.NewTaskRepository(p.sql)
+	reminderRepository := repository.NewReminderRepository(p.sql)
+	webhookRepository := repository.NewWebhookRepository(p.sql)
+	eventRepository := repository.NewEventRepository(p.sql)
+
+	// The webhooks are created and posted on behalf of the callers, only to public addresses unless allowed
+	var webhookGuard *webhook.Guard
+	if !p.config.Webhooks.AllowPrivate {
+		webhookGuard = webhook.NewGuard(net.DefaultResolver)
+	}
+
+	// The events written by any replica are notified to all of them
+	p.listener = pq.NewListener(p.dataSource, 10*time.Second, time.Minute, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			zap.S().Warnf("events listener, error: %v", err)
+		}
+	})
+	eventBroker := broker.NewBroker(eventRepository, p.listener, p.config.Watch.BufferSize)
+
 	// Register grpc service
 	pbTodoList.RegisterTodoListServiceServer(
 		p.grpcServer,
-		todolist.NewGRPC(
-			repository.NewTaskRepository(p.sql),
-			repository.NewCommentRepository(p.sql),
-			repository.NewLabelRepository(p.sql),
-		),
+		todolist.NewGRPC(todolist.Dependencies{
+			TaskRepository:     taskRepository,
+			CommentRepository:  repository.NewCommentRepository(p.sql),
+			LabelRepository:    repository.NewLabelRepository(p.sql),
+			ReminderRepository: reminderRepository,
+			WebhookRepository:  webhookRepository,
+			EventRepository:    eventRepository,
+			MemberRepository:   repository.NewMemberRepository(p.sql),
+			ApiKeyRepository:   apiKeyRepository,
+			Subscriber:         eventBroker,
+			WebhookGuard:       webhookGuard,
+		}),
 	)
-	pbTodoList.RegisterHealthcheckServiceServer(p.grpcServer, healthcheck.NewGRPC())
+	pbTodoList.RegisterHealthcheckServiceServer(p.grpcServer, healthcheck.NewGRPC(p.certificates))
+
+	// Background jobs
+	ctx, cancel := context.WithCancel(context.Background())
+	p.stopJobs = cancel
+
+	go eventBroker.Run(ctx)
+	go p.certificates.Run(ctx)
+
+	if p.config.MetricsPort > 0 {
+		p.metricsServer = metrics.NewServer(fmt.Sprintf("%s:%d", p.config.Host, p.config.MetricsPort), p.registry)
+		go p.serveMetrics()
+	}
+
+	if p.config.Retention.Days > 0 {
+		go worker.NewRetentionJob(taskRepository, p.config.Retention.Days, p.config.Retention.Interval).Run(ctx)
+	}
+
+	if p.config.Reminders.Interval > 0 {
+		var reminderNotifier notifier.Notifier = notifier.NewLogNotifier()
+		if p.config.Reminders.WebhookURL != "" {
+			reminderNotifier = notifier.NewWebhookNotifier(p.config.Reminders.WebhookURL, p.config.Reminders.WebhookTimeout)
+		}
+
+		go worker.NewReminderScheduler(
+			reminderRepository,
+			reminderNotifier,
+			p.config.Reminders.Interval,
+			p.config.Reminders.BatchSize,
+		).Run(ctx)
+	}
+
+	if p.config.Webhooks.Interval > 0 {
+		go worker.NewWebhookDispatcher(
+			webhookRepository,
+			webhook.NewSender(p.config.Webhooks.Timeout, webhookGuard).Deliver,
+			worker.Backoff{
+				Base:        p.config.Webhooks.BackoffBase,
+				Max:         p.config.Webhooks.BackoffMax,
+				MaxAttempts: p.config.Webhooks.MaxAttempts,
+			},
+			p.config.Webhooks.Interval,
+			p.config.Webhooks.BatchSize,
+		).Run(ctx)
+	}
+
+	if p.config.Outbox.Interval > 0 {
+		var publishers []publisher.EventPublisher = []publisher.EventPublisher{
+			publisher.NewWebhookPublisher(webhookRepository),
+		}
+		if p.config.Outbox.LogEvents {
+			publishers = append(publishers, publisher.NewLogPublisher())
+		}
+
+		go worker.NewOutboxRelay(
+			eventRepository,
+			publisher.NewMultiPublisher(publishers...),
+			worker.Backoff{
+				Base: p.config.Outbox.BackoffBase,
+				Max:  p.config.Outbox.BackoffMax,
+			},
+			p.config.Outbox.Interval,
+			p.config.Outbox.BatchSize,
+		).Run(ctx)
+	}
 
 	return p.grpcServer.Serve(lis)
 }
 
 // startGRPCServer start a new grpc server
-func (p *app) startGRPCServer(logger grpclog.LoggerV2) (*grpc.Server, error) {
-	// Define customfunc to handle panic
-	var customFunc = func(err interface{}) error {
-		logger.Error(
+func (p *app) startGRPCServer(logger grpclog.LoggerV2, apiKeys auth.KeyStore) (*grpc.Server, error) {
+	// Define customfunc to handle panic, it is logged with the id of the request
+	var customFunc = func(ctx context.Context, err interface{}) error {
+		logging.FromContext(ctx).Error(
 			"panic",
 			zap.Any("raw", err),
-			errors_stack.Wrap(err, 2).ErrorStack(),
+			zap.String("stack", errors_stack.Wrap(err, 2).ErrorStack()),
 		)
 		return errors.New("internal server error")
 	}
 
-	// Shared options for the logger, with a custom gRPC code to log level function.
 	opts := []grpcRecovery.Option{
-		grpcRecovery.WithRecoveryHandler(customFunc),
+		grpcRecovery.WithRecoveryHandlerContext(customFunc),
 	}
 
-	transport, err := credentials.NewServerTLSFromFile(p.config.Certfile, p.config.Keyfile)
+	transport, err := p.newServerCredentials()
 	if err != nil {
 		return nil, err
 	}
 
-	options := []grpc.ServerOption{
-		grpc.UnaryInterceptor(
-			grpcMiddleware.ChainUnaryServer(
-				// Just for recovery from the panic
-				grpcRecovery.UnaryServerInterceptor(opts...),
-			),
-		),
+	// The metrics go first, so they also record the recovered panics and the rejected callers
+	grpcMetrics := metrics.NewGRPC(p.registry)
+
+	// The span of the request is the parent of the spans of the handlers and of the queries. Every request is logged
+	// with its id, the handlers get the logger of the request in their context.
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		tracing.UnaryServerInterceptor(p.tracer),
+		grpcMetrics.UnaryServerInterceptor(),
+		logging.UnaryServerInterceptor(zap.L()),
 		// Just for recovery from the panic
-		grpcMiddleware.WithStreamServerChain(
-			grpcRecovery.StreamServerInterceptor(opts...),
-		),
+		grpcRecovery.UnaryServerInterceptor(opts...),
+	}
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		tracing.StreamServerInterceptor(p.tracer),
+		grpcMetrics.StreamServerInterceptor(),
+		logging.StreamServerInterceptor(zap.L()),
+		// Just for recovery from the panic
+		grpcRecovery.StreamServerInterceptor(opts...),
+	}
+
+	// Put the identity of the caller in the context of the handlers, the repositories are scoped by it
+	authFunc := auth.DefaultAuthFunc
+	if p.config.Auth.Disabled {
+		zap.L().Warn("authentication is disabled, any caller is accepted as the default identity")
+	} else {
+		authenticator, err := p.newAuthenticator()
+		if err != nil {
+			return nil, err
+		}
+		authFunc = authenticator.AuthFunc
+	}
+
+	// The services with a client certificate are the caller of their certificate
+	if len(p.config.ClientCAfile) > 0 {
+		authFunc = auth.CertificateAuthFunc(p.config.ClientCertProxies, authFunc)
+	}
+
+	// The service to service callers send an api key instead, limited to the scopes of the key
+	authFunc = auth.ApiKeyAuthFunc(apiKeys, authFunc)
+
+	unaryInterceptors = append(unaryInterceptors,
+		grpcAuth.UnaryServerInterceptor(authFunc),
+		logging.UnaryCallerInterceptor(callerSubject),
+		auth.UnaryScopeInterceptor(todolist.MethodScope),
+	)
+	streamInterceptors = append(streamInterceptors,
+		grpcAuth.StreamServerInterceptor(authFunc),
+		logging.StreamCallerInterceptor(callerSubject),
+		auth.StreamScopeInterceptor(todolist.MethodScope),
+	)
+
+	options := []grpc.ServerOption{
+		grpc.UnaryInterceptor(grpcMiddleware.ChainUnaryServer(unaryInterceptors...)),
+		grpcMiddleware.WithStreamServerChain(streamInterceptors...),
 		grpc.Creds(transport),
 	}
 
@@ -161,15 +421,101 @@ func (p *app) startGRPCServer(logger grpclog.LoggerV2) (*grpc.Server, error) {
 	return grpc.NewServer(options...), nil
 }
 
+// callerSubject is the caller of the access logs, the subject of the identity of the request
+func callerSubject(ctx context.Context) string {
+	if identity, ok := auth.FromContext(ctx); ok {
+		return identity.Subject
+	}
+	return ""
+}
+
+// newAuthenticator validates the tokens with the secret and the keys of the JWKS file, at least one of them is required
+func (p *app) newAuthenticator() (*auth.Authenticator, error) {
+	var keys map[string]*rsa.PublicKey
+
+	if len(p.config.Auth.JWKSFile) > 0 {
+		var err error
+		keys, err = auth.LoadJWKS(p.config.Auth.JWKSFile)
+		if err != nil {
+			return nil, fmt.Errorf("cannot load jwks: %w", err)
+		}
+	}
+
+	if len(p.config.Auth.Secret) == 0 && len(keys) == 0 {
+		return nil, errors.New("AUTH_JWT_SECRET or AUTH_JWKS_FILE is required, unless AUTH_DISABLED is set")
+	}
+
+	return auth.NewAuthenticator(p.config.Auth.Secret, keys, p.config.Auth.Issuer, p.config.Auth.Audience), nil
+}
+
+// newServerCredentials serves TLS with the reloadable certificate of the server, and requires a verified client
+// certificate when there is a client CA
+func (p *app) newServerCredentials() (credentials.TransportCredentials, error) {
+	config := tls.Config{
+		GetCertificate: p.certificates.GetCertificate,
+		MinVersion:     tls.VersionTLS12,
+	}
+
+	if len(p.config.ClientCAfile) == 0 {
+		return credentials.NewTLS(&config), nil
+	}
+
+	if len(p.config.ClientCertProxies) == 0 {
+		return nil, errors.New("CLIENT_CERT_PROXIES is required with CLIENT_CA_FILE, it names the certificates of the gateways")
+	}
+
+	clientCAs, err := auth.LoadCertPool(p.config.ClientCAfile)
+	if err != nil {
+		return nil, err
+	}
+
+	config.ClientCAs = clientCAs
+	config.ClientAuth = tls.RequireAndVerifyClientCert
+
+	return credentials.NewTLS(&config), nil
+}
+
+// serveMetrics serves the metrics on the admin port, without TLS, it is not exposed like the port of the service
+func (p *app) serveMetrics() {
+	zap.S().Infof("Serving metrics on http://%s%s", p.metricsServer.Addr, metrics.Path)
+	if err := p.metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		zap.S().Errorf("cannot serve metrics, error: %v", err)
+	}
+}
+
 func (p *app) stop() {
+	if p.metricsServer != nil {
+		zap.L().Warn("stopping metrics server")
+		if err := p.metricsServer.Close(); err != nil {
+			zap.S().Errorf("cannot close metrics server, error: %v", err)
+		}
+	}
+	if p.stopJobs != nil {
+		zap.L().Warn("stopping background jobs")
+		p.stopJobs()
+	}
 	if p.grpcServer != nil {
 		zap.L().Warn("stopping grpc server")
 		p.grpcServer.Stop()
 	}
+	if p.listener != nil {
+		zap.L().Warn("stopping events listener")
+		if err := p.listener.Close(); err != nil {
+			zap.S().Errorf("cannot close events listener, error: %v", err)
+		}
+	}
+	if p.tracer != nil {
+		zap.L().Warn("flushing traces")
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		if err := p.tracer.Shutdown(ctx); err != nil {
+			zap.S().Errorf("cannot flush traces, error: %v", err)
+		}
+		cancel()
+	}
 	if p.sql != nil {
 		zap.L().Warn("stopping db connection")
 		if err := p.sql.Close(); err != nil {
-			zap.S().Errorw("cannot close connection, error: %v", err)
+			zap.S().Errorf("cannot close connection, error: %v", err)
 		}
 	}
 }
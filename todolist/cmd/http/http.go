@@ -2,18 +2,24 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
 	"io/fs"
 	"log"
 	"mime"
 	"net/http"
+	"net/url"
 	"os"
 	"os/signal"
 	"strconv"
 	"strings"
 	"syscall"
+	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/prometheus/client_golang/prometheus"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapgrpc"
 	"google.golang.org/grpc"
@@ -23,14 +29,30 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/overridesh/sgg-todolist-service/internal/auth"
+	"github.com/overridesh/sgg-todolist-service/internal/certificate"
+	"github.com/overridesh/sgg-todolist-service/internal/logging"
+	"github.com/overridesh/sgg-todolist-service/internal/metrics"
+	"github.com/overridesh/sgg-todolist-service/internal/sse"
+	"github.com/overridesh/sgg-todolist-service/internal/tracing"
 	pbTodoList "github.com/overridesh/sgg-todolist-service/proto"
 	"github.com/overridesh/sgg-todolist-service/third_party"
 	"github.com/overridesh/sgg-todolist-service/tools"
 )
 
+// requestURLKey is the context key for the url of the request served by the gateway
+type requestURLKey struct{}
+
 type app struct {
 	config     *Config
 	grpcServer *grpc.Server
+	// metrics of the gateway, served by the admin server
+	registry      *prometheus.Registry
+	metricsServer *http.Server
+	// tracer of the requests, its spans are flushed when the app stops
+	tracer *sdktrace.TracerProvider
+	// stops the reload of the certificates
+	stopJobs context.CancelFunc
 }
 
 // Config secrets for app
@@ -41,8 +63,38 @@ type Config struct {
 	APIPrefix              string `default:"/api" envconfig:"API_PREFIX"`
 	PaymentGatewayGRPCHost string `envconfig:"TODOLIST_GRPC_HOST" required:"true"`
 	PaymentGatewayGRPCCert string `envconfig:"TODOLIST_GRPC_CERT" required:"true"`
-	Certfile               string `envconfig:"CERT_FILE" required:"true"`
-	Keyfile                string `envconfig:"KEY_FILE" required:"true"`
+	// The client certificate of the gateway, for a gRPC server that requires them
+	PaymentGatewayGRPCClientCert string `envconfig:"TODOLIST_GRPC_CLIENT_CERT"`
+	PaymentGatewayGRPCClientKey  string `envconfig:"TODOLIST_GRPC_CLIENT_KEY"`
+	Certfile                     string `envconfig:"CERT_FILE" required:"true"`
+	Keyfile                      string `envconfig:"KEY_FILE" required:"true"`
+	// The certificate files are checked for changes every CertReloadInterval, a rotated certificate needs no restart
+	CertReloadInterval time.Duration `default:"1m" envconfig:"CERT_RELOAD_INTERVAL"`
+	// The events stream sends a heartbeat every SSEHeartbeat, so the proxies keep it open while it is idle
+	SSEHeartbeat time.Duration `default:"15s" envconfig:"SSE_HEARTBEAT"`
+	// The admin port serves the Prometheus metrics on /metrics, 0 does not serve them
+	MetricsPort int `default:"9091" envconfig:"METRICS_PORT"`
+	// The spans are exported to the OTLP collector of TracingEndpoint, or written to stdout with the stdout exporter,
+	// none records no spans. TracingSampleRatio of the traces that start in the gateway are sampled.
+	TracingExporter    string  `default:"none" envconfig:"TRACING_EXPORTER"`
+	TracingEndpoint    string  `envconfig:"TRACING_ENDPOINT"`
+	TracingInsecure    bool    `default:"false" envconfig:"TRACING_INSECURE"`
+	TracingSampleRatio float64 `default:"1" envconfig:"TRACING_SAMPLE_RATIO"`
+	// LogMode is production for JSON logs or development for readable ones, the logs under LogLevel are dropped
+	LogMode  string `default:"production" envconfig:"LOG_MODE"`
+	LogLevel string `default:"info" envconfig:"LOG_LEVEL"`
+}
+
+// validate rejects the settings the app cannot run with, before anything is started
+func (c *Config) validate() error {
+	if c.SSEHeartbeat <= 0 {
+		return errors.New("SSE_HEARTBEAT must be positive")
+	}
+	if c.CertReloadInterval <= 0 {
+		return errors.New("CERT_RELOAD_INTERVAL must be positive")
+	}
+
+	return nil
 }
 
 func main() {
@@ -57,6 +109,9 @@ func main() {
 	if err = tools.GetConfig("", &config); err != nil {
 		log.Fatal(err)
 	}
+	if err = config.validate(); err != nil {
+		log.Fatal(err)
+	}
 
 	// Load Config
 	prg.config = &config
@@ -68,8 +123,7 @@ func main() {
 }
 
 func (p *app) Start() error {
-	// For now I won't dwell on something custom for logs.
-	logger, err := zap.NewDevelopment()
+	logger, err := logging.New(p.config.LogMode, p.config.LogLevel)
 	if err != nil {
 		log.Fatalf("cannot create new logger, error %v", err)
 	}
@@ -78,16 +132,46 @@ func (p *app) Start() error {
 	// Replace global logger of zap, so we can use "zap.L()" and Set GRPC Logger
 	zap.ReplaceGlobals(logger)
 
-	server, err := p.startHTTPServer()
+	// Background jobs
+	ctx, cancel := context.WithCancel(context.Background())
+	p.stopJobs = cancel
+
+	certificates, err := certificate.NewManager(p.config.Certfile, p.config.Keyfile, p.config.CertReloadInterval)
+	if err != nil {
+		log.Fatalf("cannot load certificate, error %v", err)
+	}
+	go certificates.Run(ctx)
+
+	// The traces start in the gateway and continue in the gRPC server
+	exporter, err := tracing.NewExporter(context.Background(), p.config.TracingExporter, p.config.TracingEndpoint, p.config.TracingInsecure)
+	if err != nil {
+		log.Fatalf("cannot create tracing exporter, error %v", err)
+	}
+	p.tracer = tracing.NewProvider("todolist-http", exporter, p.config.TracingSampleRatio)
+	tracing.SetGlobal(p.tracer)
+
+	p.registry = metrics.NewRegistry()
+	if p.config.MetricsPort > 0 {
+		p.metricsServer = metrics.NewServer(fmt.Sprintf("%s:%d", p.config.Host, p.config.MetricsPort), p.registry)
+		go p.serveMetrics()
+	}
+
+	server, err := p.startHTTPServer(ctx)
 	if err != nil {
 		log.Fatalf("cannot create new server, error %v", err)
 	}
 
-	return server.ListenAndServeTLS(p.config.Certfile, p.config.Keyfile)
+	server.TLSConfig = &tls.Config{
+		GetCertificate: certificates.GetCertificate,
+		MinVersion:     tls.VersionTLS12,
+	}
+
+	// The certificate comes from the TLS config
+	return server.ListenAndServeTLS("", "")
 }
 
-func (p *app) startHTTPServer() (*http.Server, error) {
-	transport, err := credentials.NewClientTLSFromFile(p.config.PaymentGatewayGRPCCert, "")
+func (p *app) startHTTPServer(ctx context.Context) (*http.Server, error) {
+	transport, err := p.newClientCredentials(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -97,19 +181,23 @@ func (p *app) startHTTPServer() (*http.Server, error) {
 
 	// Create a client connection to the gRPC Server we just started.
 	// This is where the gRPC-Gateway proxies the requests.
-	conn, err := grpc.DialContext(
-		context.Background(),
-		p.config.PaymentGatewayGRPCHost,
+	dialOptions := append([]grpc.DialOption{
 		grpc.WithTransportCredentials(transport),
 		grpc.WithBlock(),
-	)
+	}, tracing.DialOptions(p.tracer)...)
+
+	conn, err := grpc.DialContext(context.Background(), p.config.PaymentGatewayGRPCHost, dialOptions...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to dial server: %w", err)
 	}
 
 	gwmux := runtime.NewServeMux(
 		runtime.WithErrorHandler(handlerError),
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
 		runtime.WithForwardResponseOption(httpResponseModifier),
+		// Names the route of the request for the metrics and the traces
+		runtime.WithMetadata(metrics.Route),
+		runtime.WithMetadata(tracing.Route),
 		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
 			MarshalOptions: protojson.MarshalOptions{
 				UseProtoNames:   true,
@@ -132,6 +220,13 @@ func (p *app) startHTTPServer() (*http.Server, error) {
 		return nil, fmt.Errorf("failed to register gateway: %w", err)
 	}
 
+	// Server-Sent Events of the tasks, the gateway cannot stream them to the browsers
+	eventsPath := p.config.APIPrefix + "/v1/events"
+	eventsRoute := sse.NewHandler(pbTodoList.NewTodoListServiceClient(conn), p.config.SSEHeartbeat)
+
+	// Requests, status codes and latency of every route of the gateway
+	gatewayRoute := metrics.NewHTTP(p.registry).Handler(gwmux)
+
 	// OpenAPI Swagger Documentation
 	openAPIRoute := getOpenAPIHandler()
 
@@ -139,20 +234,52 @@ func (p *app) startHTTPServer() (*http.Server, error) {
 
 	gwServer := &http.Server{
 		Addr: gatewayAddr,
-		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Every request is logged with its id, the id is forwarded to the gRPC server and sent back to the caller
+		Handler: tracing.NewHandler(logging.NewHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == eventsPath {
+				eventsRoute.ServeHTTP(w, r)
+				return
+			}
 			if strings.HasPrefix(r.URL.Path, p.config.APIPrefix) {
-				gwmux.ServeHTTP(w, r)
+				// Keep the url of the request, it is needed to build the pagination links
+				gatewayRoute.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), requestURLKey{}, r.URL)))
 				return
 			}
 			openAPIRoute.ServeHTTP(w, r)
-		}),
+		}), zap.L()), p.tracer),
 	}
 
-	zap.S().Infof("Serving gRPC-Gateway and OpenAPI Documentation on https://", gatewayAddr)
+	zap.S().Infof("Serving gRPC-Gateway and OpenAPI Documentation on https://%s", gatewayAddr)
 	return gwServer, nil
 }
 
+// serveMetrics serves the metrics on the admin port, without TLS, it is not exposed like the port of the gateway
+func (p *app) serveMetrics() {
+	zap.S().Infof("Serving metrics on http://%s%s", p.metricsServer.Addr, metrics.Path)
+	if err := p.metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		zap.S().Errorf("cannot serve metrics, error: %v", err)
+	}
+}
+
 func (p *app) stop() {
+	if p.tracer != nil {
+		zap.L().Warn("flushing traces")
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		if err := p.tracer.Shutdown(ctx); err != nil {
+			zap.S().Errorf("cannot flush traces, error: %v", err)
+		}
+		cancel()
+	}
+	if p.metricsServer != nil {
+		zap.L().Warn("stopping metrics server")
+		if err := p.metricsServer.Close(); err != nil {
+			zap.S().Errorf("cannot close metrics server, error: %v", err)
+		}
+	}
+	if p.stopJobs != nil {
+		zap.L().Warn("stopping background jobs")
+		p.stopJobs()
+	}
 	if p.grpcServer != nil {
 		zap.L().Warn("stopping grpc server")
 		p.grpcServer.Stop()
@@ -185,11 +312,26 @@ func getOpenAPIHandler() http.Handler {
 }
 
 func httpResponseModifier(ctx context.Context, w http.ResponseWriter, p proto.Message) error {
+	// set pagination headers, before any status code is written
+	if response, ok := p.(*pbTodoList.GetTasksResponse); ok {
+		setPaginationHeaders(ctx, w, response)
+	}
+
 	md, ok := runtime.ServerMetadataFromContext(ctx)
 	if !ok {
 		return nil
 	}
 
+	// the request id is already in the response, it is set by the logging handler
+	removeRequestIDMetadata(w, md)
+
+	// set the etag of the resource as a plain http header
+	if vals := md.HeaderMD.Get(tools.EtagHeader); len(vals) > 0 {
+		delete(md.HeaderMD, tools.EtagHeader)
+		delete(w.Header(), "Grpc-Metadata-Etag")
+		w.Header().Set("ETag", vals[0])
+	}
+
 	// set http status code
 	if vals := md.HeaderMD.Get("x-http-code"); len(vals) > 0 {
 		code, err := strconv.Atoi(vals[0])
@@ -202,7 +344,7 @@ func httpResponseModifier(ctx context.Context, w http.ResponseWriter, p proto.Me
 		delete(w.Header(), "Grpc-Metadata-X-Http-Code")
 		w.WriteHeader(code)
 
-		if code == http.StatusNoContent {
+		if code == http.StatusNoContent || code == http.StatusNotModified {
 			w.Write(nil)
 		}
 	}
@@ -210,6 +352,102 @@ func httpResponseModifier(ctx context.Context, w http.ResponseWriter, p proto.Me
 	return nil
 }
 
+// newClientCredentials trusts the certificate of the gRPC server, and presents the reloadable client certificate of the
+// gateway when there is one, it is reloaded until ctx is done
+func (p *app) newClientCredentials(ctx context.Context) (credentials.TransportCredentials, error) {
+	if len(p.config.PaymentGatewayGRPCClientCert) == 0 && len(p.config.PaymentGatewayGRPCClientKey) == 0 {
+		return credentials.NewClientTLSFromFile(p.config.PaymentGatewayGRPCCert, "")
+	}
+
+	if len(p.config.PaymentGatewayGRPCClientCert) == 0 || len(p.config.PaymentGatewayGRPCClientKey) == 0 {
+		return nil, errors.New("TODOLIST_GRPC_CLIENT_CERT and TODOLIST_GRPC_CLIENT_KEY are required together")
+	}
+
+	rootCAs, err := auth.LoadCertPool(p.config.PaymentGatewayGRPCCert)
+	if err != nil {
+		return nil, err
+	}
+
+	clientCertificates, err := certificate.NewManager(p.config.PaymentGatewayGRPCClientCert, p.config.PaymentGatewayGRPCClientKey, p.config.CertReloadInterval)
+	if err != nil {
+		return nil, err
+	}
+	go clientCertificates.Run(ctx)
+
+	return credentials.NewTLS(&tls.Config{
+		RootCAs:              rootCAs,
+		GetClientCertificate: clientCertificates.GetClientCertificate,
+		MinVersion:           tls.VersionTLS12,
+	}), nil
+}
+
+// removeRequestIDMetadata removes the request id sent back by the gRPC server, the response already has it
+func removeRequestIDMetadata(w http.ResponseWriter, md runtime.ServerMetadata) {
+	delete(md.HeaderMD, logging.RequestIDHeader)
+	delete(w.Header(), "Grpc-Metadata-X-Request-Id")
+}
+
+// incomingHeaderMatcher forwards the conditional request headers, the api key and the request id as plain grpc
+// metadata. The gateway always forwards the Authorization header as the authorization metadata, so the token is not
+// copied again with a prefix.
+func incomingHeaderMatcher(key string) (string, bool) {
+	switch strings.ToLower(key) {
+	case tools.IfMatchHeader, tools.IfNoneMatchHeader, auth.ApiKeyHeader, logging.RequestIDHeader:
+		return strings.ToLower(key), true
+	case auth.AuthorizationHeader:
+		return "", false
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// setPaginationHeaders adds X-Total-Count and the RFC 5988 Link header to a list response
+func setPaginationHeaders(ctx context.Context, w http.ResponseWriter, response *pbTodoList.GetTasksResponse) {
+	w.Header().Set("X-Total-Count", strconv.FormatInt(response.GetTotalSize(), 10))
+
+	requestURL, ok := ctx.Value(requestURLKey{}).(*url.URL)
+	if !ok {
+		return
+	}
+
+	var links []string
+
+	addLink := func(rel string, key string, value string) {
+		link := *requestURL
+		query := link.Query()
+		query.Set(key, value)
+		link.RawQuery = query.Encode()
+		links = append(links, fmt.Sprintf("<%s>; rel=\"%s\"", link.String(), rel))
+	}
+
+	if len(response.GetNextPageToken()) > 0 {
+		addLink("next", "page_token", response.GetNextPageToken())
+	} else if response.GetPage() > 0 && response.GetPageSize() > 0 {
+		var (
+			page     int64 = int64(response.GetPage())
+			pageSize int64 = int64(response.GetPageSize())
+			lastPage int64 = (response.GetTotalSize() + pageSize - 1) / pageSize
+		)
+
+		if lastPage < 1 {
+			lastPage = 1
+		}
+
+		addLink("first", "page", "1")
+		if page > 1 {
+			addLink("prev", "page", strconv.FormatInt(page-1, 10))
+		}
+		if response.GetHasMore() {
+			addLink("next", "page", strconv.FormatInt(page+1, 10))
+		}
+		addLink("last", "page", strconv.FormatInt(lastPage, 10))
+	}
+
+	if len(links) > 0 {
+		w.Header().Set("Link", strings.Join(links, ", "))
+	}
+}
+
 func handlerError(ctx context.Context, n *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
 	grpcCode := status.Code(err)
 	var statusCode int = runtime.HTTPStatusFromCode(grpcCode)
@@ -220,6 +458,8 @@ func handlerError(ctx context.Context, n *runtime.ServeMux, marshaler runtime.Ma
 		return
 	}
 
+	removeRequestIDMetadata(w, md)
+
 	// set http status code
 	if vals := md.HeaderMD.Get("x-http-code"); len(vals) > 0 {
 		code, errConvert := strconv.Atoi(vals[0])
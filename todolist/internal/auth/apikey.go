@@ -0,0 +1,96 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+
+	grpcAuth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// ApiKeyHeader is the metadata key of the api keys, the service to service callers send them instead of a token
+	ApiKeyHeader string = "x-api-key"
+
+	// ScopeReadTasks reads the tasks, their comments, labels, reminders, members and events
+	ScopeReadTasks string = "read:tasks"
+	// ScopeWriteTasks changes the tasks and everything under them, and reads them too
+	ScopeWriteTasks string = "write:tasks"
+	// ScopeAdmin is every scope, it also manages the api keys and the webhooks
+	ScopeAdmin string = "admin"
+
+	apiKeyPrefix string = "tdl_"
+	// ApiKeyPrefixLength is the length of the start of a secret that is kept to tell the keys apart
+	ApiKeyPrefixLength int = len(apiKeyPrefix) + 8
+)
+
+var Scopes map[string]bool = map[string]bool{
+	ScopeReadTasks:  true,
+	ScopeWriteTasks: true,
+	ScopeAdmin:      true,
+}
+
+// UserScopes are the scopes of the callers authenticated by a token or a certificate, they are admin of their own
+// tasks, api keys and webhooks
+func UserScopes() []string {
+	return []string{ScopeAdmin}
+}
+
+// ErrUnknownApiKey is returned by the key stores for the keys that do not exist or were revoked
+var ErrUnknownApiKey = errors.New("unknown api key")
+
+var (
+	ErrStatusInvalidApiKey     *status.Status = status.New(codes.Unauthenticated, "invalid api key")
+	ErrStatusInsufficientScope *status.Status = status.New(codes.PermissionDenied, "insufficient scope")
+	ErrStatusKeysUnavailable   *status.Status = status.New(codes.Unavailable, "cannot authenticate api key")
+)
+
+// KeyStore finds the api keys by the hash of their secret
+type KeyStore interface {
+	// UseApiKey returns the identity of the api key and records it was used, ErrUnknownApiKey when it is not found
+	UseApiKey(ctx context.Context, keyHash string) (*Identity, error)
+}
+
+// NewApiKey returns a new random secret for an api key
+func NewApiKey() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	return apiKeyPrefix + hex.EncodeToString(secret), nil
+}
+
+// HashApiKey returns the hash of the secret of an api key, as it is stored
+func HashApiKey(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// ApiKeyAuthFunc authenticates the api key of the x-api-key metadata with the keys, the callers without one are
+// authenticated by next
+func ApiKeyAuthFunc(keys KeyStore, next grpcAuth.AuthFunc) grpcAuth.AuthFunc {
+	return func(ctx context.Context) (context.Context, error) {
+		secret := metautils.ExtractIncoming(ctx).Get(ApiKeyHeader)
+		if len(secret) == 0 {
+			return next(ctx)
+		}
+
+		identity, err := keys.UseApiKey(ctx, HashApiKey(secret))
+		if err != nil {
+			if errors.Is(err, ErrUnknownApiKey) {
+				return nil, ErrStatusInvalidApiKey.Err()
+			}
+			zap.S().Errorf("cannot authenticate api key, error: %v", err)
+			return nil, ErrStatusKeysUnavailable.Err()
+		}
+
+		return NewContext(ctx, identity), nil
+	}
+}
@@ -0,0 +1,130 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/overridesh/sgg-todolist-service/internal/auth"
+)
+
+// keyStore keeps the identities of the api keys by their hash, err is returned for any other key
+type keyStore struct {
+	identities map[string]*auth.Identity
+	err        error
+}
+
+func (k *keyStore) UseApiKey(ctx context.Context, keyHash string) (*auth.Identity, error) {
+	if identity, ok := k.identities[keyHash]; ok {
+		return identity, nil
+	}
+	return nil, k.err
+}
+
+func TestApiKeyAuthFunc(t *testing.T) {
+	secret, err := auth.NewApiKey()
+	if err != nil {
+		t.Fatalf("expect no error, but got %v", err)
+	}
+
+	keys := &keyStore{
+		identities: map[string]*auth.Identity{
+			auth.HashApiKey(secret): {Subject: "user-1", TenantId: "tenant-1", Scopes: []string{auth.ScopeReadTasks}},
+		},
+		err: auth.ErrUnknownApiKey,
+	}
+	authFunc := auth.ApiKeyAuthFunc(keys, auth.DefaultAuthFunc)
+
+	t.Run("ApiKeyAuthFunc_Identity", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(auth.ApiKeyHeader, secret))
+
+		ctx, err := authFunc(ctx)
+		if err != nil {
+			t.Fatalf("expect no error, but got %v", err)
+		}
+
+		if identity, ok := auth.FromContext(ctx); !ok || identity.Subject != "user-1" || len(identity.Scopes) != 1 {
+			t.Errorf("expect the identity of the api key, but got %v", identity)
+		}
+	})
+
+	t.Run("ApiKeyAuthFunc_WithoutApiKey", func(t *testing.T) {
+		ctx, err := authFunc(context.Background())
+		if err != nil {
+			t.Fatalf("expect no error, but got %v", err)
+		}
+
+		if identity, ok := auth.FromContext(ctx); !ok || identity.Subject != auth.DefaultSubject {
+			t.Errorf("expect the default identity, but got %v", identity)
+		}
+	})
+
+	t.Run("ApiKeyAuthFunc_UnknownApiKey", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(auth.ApiKeyHeader, "tdl_unknown"))
+
+		_, err := authFunc(ctx)
+		if er, ok := status.FromError(err); !ok || er.Message() != auth.ErrStatusInvalidApiKey.Message() {
+			t.Errorf("expect %v, but got %v", auth.ErrStatusInvalidApiKey.Message(), err)
+		}
+	})
+
+	t.Run("ApiKeyAuthFunc_KeysUnavailable", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(auth.ApiKeyHeader, "tdl_unknown"))
+
+		_, err := auth.ApiKeyAuthFunc(&keyStore{err: errors.New("connection refused")}, auth.DefaultAuthFunc)(ctx)
+		if status.Code(err) != codes.Unavailable {
+			t.Errorf("expect %v, but got %v", codes.Unavailable, err)
+		}
+	})
+}
+
+func TestNewApiKey(t *testing.T) {
+	first, err := auth.NewApiKey()
+	if err != nil {
+		t.Fatalf("expect no error, but got %v", err)
+	}
+
+	second, err := auth.NewApiKey()
+	if err != nil {
+		t.Fatalf("expect no error, but got %v", err)
+	}
+
+	if first == second || !strings.HasPrefix(first, "tdl_") || len(first) < auth.ApiKeyPrefixLength {
+		t.Errorf("expect two different api keys, but got %s and %s", first, second)
+	}
+
+	if auth.HashApiKey(first) == first || auth.HashApiKey(first) != auth.HashApiKey(first) {
+		t.Errorf("expect a stable hash of the api key")
+	}
+}
+
+func TestHasScope(t *testing.T) {
+	tests := []struct {
+		name   string
+		scopes []string
+		scope  string
+		expect bool
+	}{
+		{name: "HasScope_User", scopes: auth.UserScopes(), scope: auth.ScopeAdmin, expect: true},
+		{name: "HasScope_None", scopes: nil, scope: auth.ScopeReadTasks, expect: false},
+		{name: "HasScope_Granted", scopes: []string{auth.ScopeReadTasks}, scope: auth.ScopeReadTasks, expect: true},
+		{name: "HasScope_NotGranted", scopes: []string{auth.ScopeReadTasks}, scope: auth.ScopeWriteTasks, expect: false},
+		{name: "HasScope_WriteReads", scopes: []string{auth.ScopeWriteTasks}, scope: auth.ScopeReadTasks, expect: true},
+		{name: "HasScope_WriteIsNotAdmin", scopes: []string{auth.ScopeWriteTasks}, scope: auth.ScopeAdmin, expect: false},
+		{name: "HasScope_Admin", scopes: []string{auth.ScopeAdmin}, scope: auth.ScopeWriteTasks, expect: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identity := auth.Identity{Subject: "user-1", TenantId: "tenant-1", Scopes: tt.scopes}
+			if identity.HasScope(tt.scope) != tt.expect {
+				t.Errorf("expect %v, but got %v", tt.expect, !tt.expect)
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package auth
+
+import (
+	"context"
+	"crypto/rsa"
+	"errors"
+
+	"github.com/golang-jwt/jwt/v4"
+	grpcAuth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// AuthorizationHeader is the metadata key of the token, "Bearer <token>"
+	AuthorizationHeader string = "authorization"
+
+	scheme string = "bearer"
+
+	// TenantClaim is the claim of the tokens with the tenant of the caller, the tokens without it are of DefaultTenant
+	TenantClaim string = "tenant_id"
+	// DefaultTenant is the tenant of the tasks written before the tenants, and of the callers without one
+	DefaultTenant string = "default"
+	// DefaultSubject is the owner of the tasks written before the owners, and the caller when auth is disabled
+	DefaultSubject string = "default"
+)
+
+var (
+	ErrUnknownKey        = errors.New("unknown signing key")
+	ErrInvalidIssuer     = errors.New("invalid issuer")
+	ErrInvalidAudience   = errors.New("invalid audience")
+	ErrSubjectIsRequired = errors.New("subject is required")
+	ErrExpiryIsRequired  = errors.New("expiration time is required")
+)
+
+var ErrStatusInvalidToken *status.Status = status.New(codes.Unauthenticated, "invalid token")
+
+// Identity is the authenticated caller, it only reaches the tasks of its subject in its tenant and can only use its
+// scopes. The api keys have the scopes they were created with, the callers authenticated by a token or a certificate
+// have UserScopes.
+type Identity struct {
+	Subject  string
+	TenantId string
+	Scopes   []string
+}
+
+// HasScope tells if the caller can use the scope, admin is every scope and write:tasks also reads. A caller without
+// scopes can use none.
+func (i *Identity) HasScope(scope string) bool {
+	for _, granted := range i.Scopes {
+		if granted == scope || granted == ScopeAdmin || (granted == ScopeWriteTasks && scope == ScopeReadTasks) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// claims of the tokens, the tenant is a private claim
+type claims struct {
+	jwt.RegisteredClaims
+	TenantId string `json:"tenant_id"`
+}
+
+type identityKey struct{}
+
+// NewContext returns a context that carries the identity of the caller
+func NewContext(ctx context.Context, identity *Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+// FromContext returns the identity of the caller, if it was authenticated
+func FromContext(ctx context.Context) (*Identity, bool) {
+	identity, ok := ctx.Value(identityKey{}).(*Identity)
+	return identity, ok
+}
+
+// Authenticator validates the JWTs signed with HS256 by the secret, or with RS256 by one of the keys. The issuer and
+// the audience are only checked when they are set.
+type Authenticator struct {
+	secret   []byte
+	keys     map[string]*rsa.PublicKey
+	issuer   string
+	audience string
+	parser   *jwt.Parser
+}
+
+// NewAuthenticator initializes an authenticator, the keys are the RS256 keys of a JWKS by their kid
+func NewAuthenticator(secret string, keys map[string]*rsa.PublicKey, issuer string, audience string) *Authenticator {
+	var methods []string
+	if len(secret) > 0 {
+		methods = append(methods, jwt.SigningMethodHS256.Alg())
+	}
+	if len(keys) > 0 {
+		methods = append(methods, jwt.SigningMethodRS256.Alg())
+	}
+
+	return &Authenticator{
+		secret:   []byte(secret),
+		keys:     keys,
+		issuer:   issuer,
+		audience: audience,
+		parser:   jwt.NewParser(jwt.WithValidMethods(methods)),
+	}
+}
+
+// Authenticate validates a token and returns the identity of its subject
+func (a *Authenticator) Authenticate(token string) (*Identity, error) {
+	var claims claims
+
+	if _, err := a.parser.ParseWithClaims(token, &claims, a.key); err != nil {
+		return nil, err
+	}
+
+	// The parser only checks the expiration time of the tokens that have one, a token without it would never expire
+	if claims.ExpiresAt == nil {
+		return nil, ErrExpiryIsRequired
+	}
+
+	if len(a.issuer) > 0 && !claims.VerifyIssuer(a.issuer, true) {
+		return nil, ErrInvalidIssuer
+	}
+
+	if len(a.audience) > 0 && !claims.VerifyAudience(a.audience, true) {
+		return nil, ErrInvalidAudience
+	}
+
+	if len(claims.Subject) == 0 {
+		return nil, ErrSubjectIsRequired
+	}
+
+	identity := Identity{
+		Subject:  claims.Subject,
+		TenantId: claims.TenantId,
+		Scopes:   UserScopes(),
+	}
+
+	if len(identity.TenantId) == 0 {
+		identity.TenantId = DefaultTenant
+	}
+
+	return &identity, nil
+}
+
+// AuthFunc authenticates the bearer token of the authorization metadata, and puts the identity in the context
+func (a *Authenticator) AuthFunc(ctx context.Context) (context.Context, error) {
+	token, err := grpcAuth.AuthFromMD(ctx, scheme)
+	if err != nil {
+		return nil, err
+	}
+
+	identity, err := a.Authenticate(token)
+	if err != nil {
+		zap.S().Debugf("cannot authenticate token, error: %v", err)
+		return nil, ErrStatusInvalidToken.Err()
+	}
+
+	return NewContext(ctx, identity), nil
+}
+
+// DefaultAuthFunc accepts any caller as the default identity, it is used when the authentication is disabled
+func DefaultAuthFunc(ctx context.Context) (context.Context, error) {
+	return NewContext(ctx, &Identity{Subject: DefaultSubject, TenantId: DefaultTenant, Scopes: UserScopes()}), nil
+}
+
+// key returns the key that verifies the signature of a token, the RS256 tokens name it with their kid
+func (a *Authenticator) key(token *jwt.Token) (interface{}, error) {
+	switch token.Method.Alg() {
+	case jwt.SigningMethodHS256.Alg():
+		if len(a.secret) > 0 {
+			return a.secret, nil
+		}
+	case jwt.SigningMethodRS256.Alg():
+		kid, _ := token.Header["kid"].(string)
+		if key, ok := a.keys[kid]; ok {
+			return key, nil
+		}
+
+		// A JWKS with a single key does not need the tokens to name it
+		if len(kid) == 0 && len(a.keys) == 1 {
+			for _, key := range a.keys {
+				return key, nil
+			}
+		}
+	}
+
+	return nil, ErrUnknownKey
+}
@@ -0,0 +1,213 @@
+package auth_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/overridesh/sgg-todolist-service/internal/auth"
+)
+
+const secret string = "a-long-random-secret"
+
+func sign(t *testing.T, method jwt.SigningMethod, key interface{}, kid string, claims jwt.Claims) string {
+	token := jwt.NewWithClaims(method, claims)
+	if len(kid) > 0 {
+		token.Header["kid"] = kid
+	}
+
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("cannot sign token, error: %v", err)
+	}
+	return signed
+}
+
+func validClaims() jwt.RegisteredClaims {
+	return jwt.RegisteredClaims{
+		Subject:   "user-1",
+		Issuer:    "https://auth.example.com",
+		Audience:  jwt.ClaimStrings{"todolist"},
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}
+}
+
+// jwks returns a JWKS with the public key of a private key
+func jwks(t *testing.T, kid string, key *rsa.PrivateKey) []byte {
+	content, err := json.Marshal(map[string]interface{}{
+		"keys": []map[string]string{
+			{"kty": "EC", "kid": "other"},
+			{
+				"kty": "RSA",
+				"kid": kid,
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return content
+}
+
+func TestAuthenticate(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keys, err := auth.ParseJWKS(jwks(t, "key-1", privateKey))
+	if err != nil {
+		t.Fatalf("expect no error, but got %v", err)
+	}
+
+	if len(keys) != 1 || keys["key-1"].N.Cmp(privateKey.N) != 0 || keys["key-1"].E != privateKey.E {
+		t.Fatalf("expect the rsa key of the jwks, but got %v", keys)
+	}
+
+	authenticator := auth.NewAuthenticator(secret, keys, "https://auth.example.com", "todolist")
+
+	expired := validClaims()
+	expired.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+
+	otherIssuer := validClaims()
+	otherIssuer.Issuer = "https://evil.example.com"
+
+	otherAudience := validClaims()
+	otherAudience.Audience = jwt.ClaimStrings{"billing"}
+
+	withoutSubject := validClaims()
+	withoutSubject.Subject = ""
+
+	withoutExpiry := validClaims()
+	withoutExpiry.ExpiresAt = nil
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		token  string
+		expect bool
+	}{
+		{name: "Authenticate_HS256", token: sign(t, jwt.SigningMethodHS256, []byte(secret), "", validClaims()), expect: true},
+		{name: "Authenticate_RS256", token: sign(t, jwt.SigningMethodRS256, privateKey, "key-1", validClaims()), expect: true},
+		{name: "Authenticate_RS256WithoutKid", token: sign(t, jwt.SigningMethodRS256, privateKey, "", validClaims()), expect: true},
+		{name: "Authenticate_WrongSecret", token: sign(t, jwt.SigningMethodHS256, []byte("wrong"), "", validClaims())},
+		{name: "Authenticate_UnknownKid", token: sign(t, jwt.SigningMethodRS256, privateKey, "key-2", validClaims())},
+		{name: "Authenticate_WrongKey", token: sign(t, jwt.SigningMethodRS256, otherKey, "key-1", validClaims())},
+		{name: "Authenticate_UnexpectedMethod", token: sign(t, jwt.SigningMethodHS512, []byte(secret), "", validClaims())},
+		{name: "Authenticate_Expired", token: sign(t, jwt.SigningMethodHS256, []byte(secret), "", expired)},
+		{name: "Authenticate_ExpiryIsRequired", token: sign(t, jwt.SigningMethodHS256, []byte(secret), "", withoutExpiry)},
+		{name: "Authenticate_InvalidIssuer", token: sign(t, jwt.SigningMethodHS256, []byte(secret), "", otherIssuer)},
+		{name: "Authenticate_InvalidAudience", token: sign(t, jwt.SigningMethodHS256, []byte(secret), "", otherAudience)},
+		{name: "Authenticate_SubjectIsRequired", token: sign(t, jwt.SigningMethodHS256, []byte(secret), "", withoutSubject)},
+		{name: "Authenticate_Malformed", token: "not-a-token"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identity, err := authenticator.Authenticate(tt.token)
+			if tt.expect {
+				if err != nil {
+					t.Fatalf("expect no error, but got %v", err)
+				}
+				if identity.Subject != "user-1" {
+					t.Errorf("expect subject user-1, but got %s", identity.Subject)
+				}
+				if !identity.HasScope(auth.ScopeAdmin) {
+					t.Errorf("expect the scopes of a user, but got %v", identity.Scopes)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Errorf("expect an error, but got identity %v", identity)
+			}
+		})
+	}
+
+	t.Run("Authenticate_HS256Disabled", func(t *testing.T) {
+		authenticator := auth.NewAuthenticator("", keys, "", "")
+		if _, err := authenticator.Authenticate(sign(t, jwt.SigningMethodHS256, []byte(""), "", validClaims())); err == nil {
+			t.Error("expect an error")
+		}
+	})
+}
+
+func TestAuthenticateTenant(t *testing.T) {
+	authenticator := auth.NewAuthenticator(secret, nil, "", "")
+
+	t.Run("Authenticate_Tenant", func(t *testing.T) {
+		claims := jwt.MapClaims{"sub": "user-1", "exp": time.Now().Add(time.Hour).Unix(), auth.TenantClaim: "tenant-1"}
+
+		identity, err := authenticator.Authenticate(sign(t, jwt.SigningMethodHS256, []byte(secret), "", claims))
+		if err != nil {
+			t.Fatalf("expect no error, but got %v", err)
+		}
+
+		if identity.TenantId != "tenant-1" {
+			t.Errorf("expect tenant tenant-1, but got %s", identity.TenantId)
+		}
+	})
+
+	t.Run("Authenticate_DefaultTenant", func(t *testing.T) {
+		identity, err := authenticator.Authenticate(sign(t, jwt.SigningMethodHS256, []byte(secret), "", validClaims()))
+		if err != nil {
+			t.Fatalf("expect no error, but got %v", err)
+		}
+
+		if identity.TenantId != auth.DefaultTenant {
+			t.Errorf("expect tenant %s, but got %s", auth.DefaultTenant, identity.TenantId)
+		}
+	})
+}
+
+func TestAuthFunc(t *testing.T) {
+	authenticator := auth.NewAuthenticator(secret, nil, "", "")
+
+	t.Run("AuthFunc_Identity", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+			auth.AuthorizationHeader, "Bearer "+sign(t, jwt.SigningMethodHS256, []byte(secret), "", validClaims()),
+		))
+
+		ctx, err := authenticator.AuthFunc(ctx)
+		if err != nil {
+			t.Fatalf("expect no error, but got %v", err)
+		}
+
+		if identity, ok := auth.FromContext(ctx); !ok || identity.Subject != "user-1" {
+			t.Errorf("expect the identity of user-1, but got %v", identity)
+		}
+	})
+
+	t.Run("AuthFunc_MissingToken", func(t *testing.T) {
+		_, err := authenticator.AuthFunc(context.Background())
+		if status.Code(err) != codes.Unauthenticated {
+			t.Errorf("expect %v, but got %v", codes.Unauthenticated, err)
+		}
+	})
+
+	t.Run("AuthFunc_InvalidToken", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(auth.AuthorizationHeader, "Bearer not-a-token"))
+
+		_, err := authenticator.AuthFunc(ctx)
+		if er, ok := status.FromError(err); !ok || er.Message() != auth.ErrStatusInvalidToken.Message() {
+			t.Errorf("expect %v, but got %v", auth.ErrStatusInvalidToken.Message(), err)
+		}
+	})
+}
@@ -0,0 +1,107 @@
+package auth
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"io/ioutil"
+
+	grpcAuth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrNoCertificates       = errors.New("no certificates")
+	ErrCertificateWithoutId = errors.New("certificate has no uri, dns name or common name")
+)
+
+var ErrStatusInvalidCertificate *status.Status = status.New(codes.Unauthenticated, "invalid client certificate")
+
+// LoadCertPool reads the PEM certificates of a CA bundle
+func LoadCertPool(path string) (*x509.CertPool, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(content) {
+		return nil, ErrNoCertificates
+	}
+
+	return pool, nil
+}
+
+// CertificateIdentity returns the identity of a client certificate. Its first URI SAN, DNS SAN or its common name is
+// the subject, and its organization is the tenant.
+func CertificateIdentity(cert *x509.Certificate) (*Identity, error) {
+	identity := Identity{
+		TenantId: DefaultTenant,
+		Scopes:   UserScopes(),
+	}
+
+	switch {
+	case len(cert.URIs) > 0:
+		identity.Subject = cert.URIs[0].String()
+	case len(cert.DNSNames) > 0:
+		identity.Subject = cert.DNSNames[0]
+	default:
+		identity.Subject = cert.Subject.CommonName
+	}
+
+	if len(identity.Subject) == 0 {
+		return nil, ErrCertificateWithoutId
+	}
+
+	if len(cert.Subject.Organization) > 0 && len(cert.Subject.Organization[0]) > 0 {
+		identity.TenantId = cert.Subject.Organization[0]
+	}
+
+	return &identity, nil
+}
+
+// CertificateAuthFunc authenticates the callers by their verified client certificate. The proxies, named by the subject
+// of their certificate, forward the requests of other callers, so they and the callers without a certificate are
+// authenticated by next.
+func CertificateAuthFunc(proxies []string, next grpcAuth.AuthFunc) grpcAuth.AuthFunc {
+	isProxy := map[string]bool{}
+	for _, proxy := range proxies {
+		isProxy[proxy] = true
+	}
+
+	return func(ctx context.Context) (context.Context, error) {
+		cert, ok := peerCertificate(ctx)
+		if !ok {
+			return next(ctx)
+		}
+
+		identity, err := CertificateIdentity(cert)
+		if err != nil {
+			return nil, ErrStatusInvalidCertificate.Err()
+		}
+
+		if isProxy[identity.Subject] {
+			return next(ctx)
+		}
+
+		return NewContext(ctx, identity), nil
+	}
+}
+
+// peerCertificate returns the verified certificate of the client of a request, if it sent one
+func peerCertificate(ctx context.Context) (*x509.Certificate, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, false
+	}
+
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return nil, false
+	}
+
+	return info.State.VerifiedChains[0][0], true
+}
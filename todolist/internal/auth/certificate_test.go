@@ -0,0 +1,188 @@
+package auth_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/url"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/overridesh/sgg-todolist-service/internal/auth"
+)
+
+// certificate returns a self signed certificate with the names
+func certificate(t *testing.T, subject pkix.Name, dnsNames []string, uris ...string) *x509.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      subject,
+		DNSNames:     dnsNames,
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	for _, uri := range uris {
+		parsed, err := url.Parse(uri)
+		if err != nil {
+			t.Fatal(err)
+		}
+		template.URIs = append(template.URIs, parsed)
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert
+}
+
+// peerContext returns the context of a request of a client that sent the verified certificate
+func peerContext(cert *x509.Certificate) context.Context {
+	return peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{
+			State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}},
+		},
+	})
+}
+
+func TestCertificateIdentity(t *testing.T) {
+	tests := []struct {
+		name    string
+		cert    *x509.Certificate
+		subject string
+		tenant  string
+	}{
+		{
+			name:    "CertificateIdentity_URI",
+			cert:    certificate(t, pkix.Name{CommonName: "ci", Organization: []string{"tenant-1"}}, []string{"ci.internal"}, "spiffe://example.com/ci"),
+			subject: "spiffe://example.com/ci",
+			tenant:  "tenant-1",
+		},
+		{
+			name:    "CertificateIdentity_DNSName",
+			cert:    certificate(t, pkix.Name{CommonName: "ci"}, []string{"ci.internal"}),
+			subject: "ci.internal",
+			tenant:  auth.DefaultTenant,
+		},
+		{
+			name:    "CertificateIdentity_CommonName",
+			cert:    certificate(t, pkix.Name{CommonName: "ci"}, nil),
+			subject: "ci",
+			tenant:  auth.DefaultTenant,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identity, err := auth.CertificateIdentity(tt.cert)
+			if err != nil {
+				t.Fatalf("expect no error, but got %v", err)
+			}
+
+			if identity.Subject != tt.subject || identity.TenantId != tt.tenant {
+				t.Errorf("expect %s of %s, but got %v", tt.subject, tt.tenant, identity)
+			}
+
+			if !identity.HasScope(auth.ScopeAdmin) {
+				t.Errorf("expect the scopes of a user, but got %v", identity.Scopes)
+			}
+		})
+	}
+
+	t.Run("CertificateIdentity_WithoutId", func(t *testing.T) {
+		if _, err := auth.CertificateIdentity(certificate(t, pkix.Name{}, nil)); err != auth.ErrCertificateWithoutId {
+			t.Errorf("expect %v, but got %v", auth.ErrCertificateWithoutId, err)
+		}
+	})
+}
+
+func TestCertificateAuthFunc(t *testing.T) {
+	authFunc := auth.CertificateAuthFunc([]string{"todolist-gateway"}, auth.DefaultAuthFunc)
+
+	t.Run("CertificateAuthFunc_Service", func(t *testing.T) {
+		ctx, err := authFunc(peerContext(certificate(t, pkix.Name{CommonName: "ci"}, nil)))
+		if err != nil {
+			t.Fatalf("expect no error, but got %v", err)
+		}
+
+		if identity, ok := auth.FromContext(ctx); !ok || identity.Subject != "ci" {
+			t.Errorf("expect the identity of the certificate, but got %v", identity)
+		}
+	})
+
+	t.Run("CertificateAuthFunc_Proxy", func(t *testing.T) {
+		ctx, err := authFunc(peerContext(certificate(t, pkix.Name{CommonName: "gateway"}, []string{"todolist-gateway"})))
+		if err != nil {
+			t.Fatalf("expect no error, but got %v", err)
+		}
+
+		if identity, ok := auth.FromContext(ctx); !ok || identity.Subject != auth.DefaultSubject {
+			t.Errorf("expect the caller of the proxy, but got %v", identity)
+		}
+	})
+
+	t.Run("CertificateAuthFunc_WithoutCertificate", func(t *testing.T) {
+		ctx, err := authFunc(context.Background())
+		if err != nil {
+			t.Fatalf("expect no error, but got %v", err)
+		}
+
+		if identity, ok := auth.FromContext(ctx); !ok || identity.Subject != auth.DefaultSubject {
+			t.Errorf("expect the default identity, but got %v", identity)
+		}
+	})
+
+	t.Run("CertificateAuthFunc_WithoutId", func(t *testing.T) {
+		_, err := authFunc(peerContext(certificate(t, pkix.Name{}, nil)))
+		if er, ok := status.FromError(err); !ok || er.Message() != auth.ErrStatusInvalidCertificate.Message() {
+			t.Errorf("expect %v, but got %v", auth.ErrStatusInvalidCertificate.Message(), err)
+		}
+	})
+}
+
+func TestLoadCertPool(t *testing.T) {
+	dir := t.TempDir()
+
+	bundle := filepath.Join(dir, "ca.pem")
+	if err := ioutil.WriteFile(bundle, pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: certificate(t, pkix.Name{CommonName: "ca"}, nil).Raw,
+	}), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := auth.LoadCertPool(bundle); err != nil {
+		t.Errorf("expect no error, but got %v", err)
+	}
+
+	empty := filepath.Join(dir, "empty.pem")
+	if err := ioutil.WriteFile(empty, []byte("not a certificate"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := auth.LoadCertPool(empty); err != auth.ErrNoCertificates {
+		t.Errorf("expect %v, but got %v", auth.ErrNoCertificates, err)
+	}
+}
@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"math/big"
+)
+
+var ErrInvalidJWK = errors.New("invalid jwk")
+
+// jwk is an RSA key of a JWKS as described in RFC 7517
+type jwk struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// LoadJWKS reads the RSA signing keys of a JWKS file by their kid, the other keys are left out
+func LoadJWKS(path string) (map[string]*rsa.PublicKey, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseJWKS(content)
+}
+
+// ParseJWKS reads the RSA signing keys of a JWKS by their kid, the other keys are left out
+func ParseJWKS(content []byte) (map[string]*rsa.PublicKey, error) {
+	var jwks struct {
+		Keys []jwk `json:"keys"`
+	}
+
+	if err := json.Unmarshal(content, &jwks); err != nil {
+		return nil, err
+	}
+
+	var keys map[string]*rsa.PublicKey = map[string]*rsa.PublicKey{}
+
+	for _, key := range jwks.Keys {
+		if key.Kty != "RSA" || (len(key.Use) > 0 && key.Use != "sig") {
+			continue
+		}
+
+		n, err := base64.RawURLEncoding.DecodeString(key.N)
+		if err != nil {
+			return nil, ErrInvalidJWK
+		}
+
+		e, err := base64.RawURLEncoding.DecodeString(key.E)
+		if err != nil || len(e) == 0 || len(e) > 4 {
+			return nil, ErrInvalidJWK
+		}
+
+		keys[key.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+
+	return keys, nil
+}
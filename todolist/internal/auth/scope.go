@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// ScopeFunc returns the scope needed to call a method, none when it is empty
+type ScopeFunc func(fullMethod string) string
+
+// UnaryScopeInterceptor rejects the callers without the scope of the method, it runs after the authentication
+func UnaryScopeInterceptor(scopeOf ScopeFunc) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := checkScope(ctx, scopeOf(info.FullMethod)); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamScopeInterceptor rejects the callers without the scope of the method, it runs after the authentication
+func StreamScopeInterceptor(scopeOf ScopeFunc) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := checkScope(stream.Context(), scopeOf(info.FullMethod)); err != nil {
+			return err
+		}
+		return handler(srv, stream)
+	}
+}
+
+func checkScope(ctx context.Context, scope string) error {
+	if len(scope) == 0 {
+		return nil
+	}
+
+	if identity, ok := FromContext(ctx); !ok || !identity.HasScope(scope) {
+		return ErrStatusInsufficientScope.Err()
+	}
+
+	return nil
+}
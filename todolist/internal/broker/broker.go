@@ -0,0 +1,247 @@
+package broker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+	"github.com/overridesh/sgg-todolist-service/internal/repository"
+)
+
+// Channel is the postgres channel notified with the sequence of every event written to the outbox
+const Channel string = "events"
+
+const (
+	// pingInterval checks the connection of the listener when there are no notifications for a while
+	pingInterval time.Duration = 90 * time.Second
+	// catchUpLimit is how many events are read at a time
+	catchUpLimit uint64 = 1000
+	// pendingInterval is how often the events are read again while some of them wait for an earlier transaction, no
+	// notification tells when that transaction ends
+	pendingInterval time.Duration = 250 * time.Millisecond
+)
+
+var (
+	ErrSlowSubscription = errors.New("subscription fell behind the events")
+	ErrBrokerStopped    = errors.New("broker stopped")
+)
+
+// Listener is the part of *pq.Listener used by the broker
+type Listener interface {
+	Listen(channel string) error
+	NotificationChannel() <-chan *pq.Notification
+	Ping() error
+}
+
+// Subscriber hands out subscriptions to the events of the outbox
+type Subscriber interface {
+	Subscribe() Subscription
+}
+
+// Subscription receives the events written after it was made. Events is closed when the subscription ends, then Err
+// tells why it ended.
+type Subscription interface {
+	Events() <-chan *model.Event
+	Err() error
+	Close()
+}
+
+// Broker fans out the events of the outbox to its subscriptions, in the order of the outbox. Every replica listens to
+// the notifications of postgres, so the subscriptions get the events written by any of them.
+type Broker struct {
+	eventRepository repository.EventRepository
+	listener        Listener
+	bufferSize      int
+
+	mu            sync.Mutex
+	subscriptions map[*subscription]struct{}
+	lastSequence  int64
+	// stopped is set once Run returns, there are no events for the subscriptions anymore
+	stopped bool
+}
+
+type subscription struct {
+	broker *Broker
+	events chan *model.Event
+	err    error
+}
+
+// NewBroker initializes a broker whose subscriptions hold up to bufferSize events, the ones that fall behind are ended
+func NewBroker(eventRepository repository.EventRepository, listener Listener, bufferSize int) *Broker {
+	return &Broker{
+		eventRepository: eventRepository,
+		listener:        listener,
+		bufferSize:      bufferSize,
+		subscriptions:   map[*subscription]struct{}{},
+	}
+}
+
+// Subscribe starts a subscription to the events, it must be closed once it is not used anymore. Once the broker is
+// stopped, the subscription is already ended with ErrBrokerStopped.
+func (b *Broker) Subscribe() Subscription {
+	sub := &subscription{
+		broker: b,
+		events: make(chan *model.Event, b.bufferSize),
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.subscriptions[sub] = struct{}{}
+	if b.stopped {
+		b.end(sub, ErrBrokerStopped)
+	}
+
+	return sub
+}
+
+// Run listens to the notifications and dispatches the events written from then on until the context is done or the
+// listener cannot listen, then every subscription is ended and so are the ones made later
+func (b *Broker) Run(ctx context.Context) {
+	defer b.stop()
+
+	if err := b.listener.Listen(Channel); err != nil {
+		zap.S().Errorf("cannot listen to %s, error: %v", Channel, err)
+		return
+	}
+
+	lastSequence, err := b.eventRepository.GetLastSequence(ctx)
+	if err != nil {
+		zap.S().Errorf("cannot get the last event, error: %v", err)
+		return
+	}
+	b.lastSequence = lastSequence
+
+	ping := time.NewTicker(pingInterval)
+	defer ping.Stop()
+
+	var retry <-chan time.Time
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ping.C:
+			go func() {
+				if err := b.listener.Ping(); err != nil {
+					zap.S().Warnf("cannot ping listener, error: %v", err)
+				}
+			}()
+			continue
+		case <-b.listener.NotificationChannel():
+			b.drain()
+		case <-retry:
+		}
+
+		pending, err := b.dispatch(ctx)
+		if err != nil {
+			zap.S().Errorf("cannot dispatch events, error: %v", err)
+		}
+
+		retry = nil
+		if pending {
+			retry = time.After(pendingInterval)
+		}
+	}
+}
+
+// drain drops the notifications that are already waiting, one dispatch reads all of their events. A nil notification
+// means the listener reconnected and could have missed some, which the dispatch reads too.
+func (b *Broker) drain() {
+	for {
+		select {
+		case <-b.listener.NotificationChannel():
+		default:
+			return
+		}
+	}
+}
+
+// dispatch sends the events after the last one dispatched to the subscriptions, catchUpLimit at a time until none
+// are left. It tells if some events were not sent yet because they wait for an earlier transaction, they are looked
+// for first so the ones that settle in the meantime are sent anyway.
+func (b *Broker) dispatch(ctx context.Context) (bool, error) {
+	pending, err := b.eventRepository.HasPendingEvents(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	for {
+		events, err := b.eventRepository.GetEventsAfter(ctx, b.lastSequence, catchUpLimit)
+		if err != nil {
+			return false, err
+		}
+
+		b.send(events)
+
+		if uint64(len(events)) < catchUpLimit {
+			return pending, nil
+		}
+	}
+}
+
+// send broadcasts the events in their order and keeps the last one dispatched
+func (b *Broker) send(events []*model.Event) {
+	for _, event := range events {
+		b.lastSequence = event.Sequence
+		b.broadcast(event)
+	}
+}
+
+// broadcast sends an event to every subscription, ending the ones that have no room left for it
+func (b *Broker) broadcast(event *model.Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for sub := range b.subscriptions {
+		select {
+		case sub.events <- event:
+		default:
+			b.end(sub, ErrSlowSubscription)
+		}
+	}
+}
+
+func (b *Broker) stop() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.stopped = true
+	for sub := range b.subscriptions {
+		b.end(sub, ErrBrokerStopped)
+	}
+}
+
+// end removes a subscription and closes its events, the lock must be held
+func (b *Broker) end(sub *subscription, err error) {
+	if _, ok := b.subscriptions[sub]; !ok {
+		return
+	}
+
+	delete(b.subscriptions, sub)
+	sub.err = err
+	close(sub.events)
+}
+
+func (s *subscription) Events() <-chan *model.Event {
+	return s.events
+}
+
+func (s *subscription) Err() error {
+	s.broker.mu.Lock()
+	defer s.broker.mu.Unlock()
+
+	return s.err
+}
+
+func (s *subscription) Close() {
+	s.broker.mu.Lock()
+	defer s.broker.mu.Unlock()
+
+	s.broker.end(s, nil)
+}
@@ -0,0 +1,264 @@
+package broker_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/overridesh/sgg-todolist-service/internal/broker"
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+	mockRepository "github.com/overridesh/sgg-todolist-service/pkg/mock"
+)
+
+// fakeListener is a listener whose notifications are sent by the test
+type fakeListener struct {
+	notifications chan *pq.Notification
+	listenErr     error
+}
+
+func newFakeListener(notifications ...*pq.Notification) *fakeListener {
+	listener := &fakeListener{notifications: make(chan *pq.Notification, 10)}
+	for _, notification := range notifications {
+		listener.notifications <- notification
+	}
+	return listener
+}
+
+func (l *fakeListener) Listen(channel string) error {
+	return l.listenErr
+}
+
+func (l *fakeListener) NotificationChannel() <-chan *pq.Notification {
+	return l.notifications
+}
+
+func (l *fakeListener) Ping() error {
+	return nil
+}
+
+func newEvent(sequence int64) *model.Event {
+	return &model.Event{Id: uuid.NewV4(), Sequence: sequence, Type: model.EventTaskCreated, TaskId: uuid.NewV4()}
+}
+
+// run the broker until stop is called, which waits for it to end
+func run(b *broker.Broker) (stop func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		b.Run(ctx)
+		close(done)
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+func receive(t *testing.T, sub broker.Subscription) *model.Event {
+	select {
+	case event, ok := <-sub.Events():
+		if !ok {
+			t.Fatalf("expect an event, but the subscription ended with %v", sub.Err())
+		}
+		return event
+	case <-time.After(time.Second):
+		t.Fatal("expect an event")
+	}
+	return nil
+}
+
+// newEventRepository returns a repository whose last event is lastSequence, without pending events
+func newEventRepository(lastSequence int64) *mockRepository.EventRepository {
+	eventRepository := new(mockRepository.EventRepository)
+	eventRepository.On("GetLastSequence", mock.Anything).Return(lastSequence, nil)
+	eventRepository.On("HasPendingEvents", mock.Anything).Return(false, nil)
+	return eventRepository
+}
+
+func TestBrokerRun(t *testing.T) {
+	t.Run("Run_PendingNotificationsTogether", func(t *testing.T) {
+		events := []*model.Event{newEvent(1), newEvent(2), newEvent(3)}
+
+		eventRepository := newEventRepository(0)
+		eventRepository.On("GetEventsAfter", mock.Anything, int64(0), uint64(1000)).Return(events, nil)
+
+		b := broker.NewBroker(eventRepository, newFakeListener(
+			&pq.Notification{Extra: "1"},
+			&pq.Notification{Extra: "2"},
+			&pq.Notification{Extra: "3"},
+		), 10)
+		sub := b.Subscribe()
+		defer sub.Close()
+
+		stop := run(b)
+		defer stop()
+
+		for _, expect := range events {
+			if event := receive(t, sub); event != expect {
+				t.Errorf("expect event %d, but got %d", expect.Sequence, event.Sequence)
+			}
+		}
+
+		eventRepository.AssertNumberOfCalls(t, "GetEventsAfter", 1)
+	})
+
+	t.Run("Run_AfterLastEvent", func(t *testing.T) {
+		eventRepository := newEventRepository(5)
+		eventRepository.On("GetEventsAfter", mock.Anything, int64(5), mock.Anything).Return([]*model.Event{newEvent(6)}, nil).Once()
+		eventRepository.On("GetEventsAfter", mock.Anything, int64(6), mock.Anything).Return([]*model.Event{newEvent(7)}, nil).Once()
+
+		listener := newFakeListener(&pq.Notification{Extra: "6"})
+
+		b := broker.NewBroker(eventRepository, listener, 10)
+		sub := b.Subscribe()
+		defer sub.Close()
+
+		stop := run(b)
+		defer stop()
+
+		// The events written before the broker started are not dispatched
+		if event := receive(t, sub); event.Sequence != 6 {
+			t.Errorf("expect event 6, but got %d", event.Sequence)
+		}
+
+		// A nil notification is how the listener tells it reconnected, the events after the last one are read back
+		listener.notifications <- nil
+
+		if event := receive(t, sub); event.Sequence != 7 {
+			t.Errorf("expect event 7, but got %d", event.Sequence)
+		}
+	})
+
+	t.Run("Run_CatchUpUntilDrained", func(t *testing.T) {
+		// The first page is full, so the next one is read
+		page := make([]*model.Event, 1000)
+		for i := range page {
+			page[i] = newEvent(int64(i + 1))
+		}
+
+		eventRepository := newEventRepository(0)
+		eventRepository.On("GetEventsAfter", mock.Anything, int64(0), uint64(1000)).Return(page, nil)
+		eventRepository.On("GetEventsAfter", mock.Anything, int64(1000), uint64(1000)).Return([]*model.Event{newEvent(1001)}, nil)
+
+		listener := newFakeListener(nil)
+
+		b := broker.NewBroker(eventRepository, listener, 2000)
+		sub := b.Subscribe()
+		defer sub.Close()
+
+		stop := run(b)
+		defer stop()
+
+		for expect := int64(1); expect <= 1001; expect++ {
+			if event := receive(t, sub); event.Sequence != expect {
+				t.Fatalf("expect event %d, but got %d", expect, event.Sequence)
+			}
+		}
+
+		eventRepository.AssertNumberOfCalls(t, "GetEventsAfter", 2)
+	})
+
+	t.Run("Run_PendingEvents", func(t *testing.T) {
+		eventRepository := new(mockRepository.EventRepository)
+		eventRepository.On("GetLastSequence", mock.Anything).Return(int64(0), nil)
+		eventRepository.On("HasPendingEvents", mock.Anything).Return(true, nil).Once()
+		eventRepository.On("HasPendingEvents", mock.Anything).Return(false, nil)
+		eventRepository.On("GetEventsAfter", mock.Anything, int64(0), mock.Anything).Return([]*model.Event{}, nil).Once()
+		eventRepository.On("GetEventsAfter", mock.Anything, int64(0), mock.Anything).Return([]*model.Event{newEvent(1)}, nil).Once()
+
+		b := broker.NewBroker(eventRepository, newFakeListener(&pq.Notification{Extra: "1"}), 10)
+		sub := b.Subscribe()
+		defer sub.Close()
+
+		stop := run(b)
+		defer stop()
+
+		// The event waits for an earlier transaction, it is read again without another notification
+		if event := receive(t, sub); event.Sequence != 1 {
+			t.Errorf("expect event 1, but got %d", event.Sequence)
+		}
+	})
+
+	t.Run("Run_SlowSubscription", func(t *testing.T) {
+		eventRepository := newEventRepository(0)
+		eventRepository.On("GetEventsAfter", mock.Anything, int64(0), mock.Anything).Return([]*model.Event{newEvent(1), newEvent(2)}, nil)
+
+		b := broker.NewBroker(eventRepository, newFakeListener(
+			&pq.Notification{Extra: "1"},
+			&pq.Notification{Extra: "2"},
+		), 1)
+		sub := b.Subscribe()
+
+		stop := run(b)
+		defer stop()
+
+		// Nothing is read until the subscription is ended, the event that had room is still received
+		for deadline := time.Now().Add(time.Second); sub.Err() == nil; time.Sleep(time.Millisecond) {
+			if time.Now().After(deadline) {
+				t.Fatal("expect the subscription to be ended")
+			}
+		}
+
+		if event := receive(t, sub); event.Sequence != 1 {
+			t.Errorf("expect event 1, but got %d", event.Sequence)
+		}
+
+		if _, ok := <-sub.Events(); ok {
+			t.Error("expect the subscription to be ended")
+		}
+
+		if sub.Err() != broker.ErrSlowSubscription {
+			t.Errorf("expect %v, but got %v", broker.ErrSlowSubscription, sub.Err())
+		}
+
+		// Closing an ended subscription does nothing
+		sub.Close()
+	})
+
+	t.Run("Run_Stopped", func(t *testing.T) {
+		b := broker.NewBroker(newEventRepository(0), newFakeListener(), 10)
+		sub := b.Subscribe()
+
+		stop := run(b)
+		stop()
+
+		if _, ok := <-sub.Events(); ok {
+			t.Error("expect the subscription to be ended")
+		}
+
+		if sub.Err() != broker.ErrBrokerStopped {
+			t.Errorf("expect %v, but got %v", broker.ErrBrokerStopped, sub.Err())
+		}
+	})
+
+	t.Run("Run_ListenFailed", func(t *testing.T) {
+		listener := newFakeListener()
+		listener.listenErr = errors.New("connection refused")
+
+		b := broker.NewBroker(new(mockRepository.EventRepository), listener, 10)
+		sub := b.Subscribe()
+
+		// Run returns by itself when it cannot listen
+		b.Run(context.Background())
+
+		// The subscriptions made after it stopped are ended too, instead of waiting for events that never come
+		for _, sub := range []broker.Subscription{sub, b.Subscribe()} {
+			if _, ok := <-sub.Events(); ok {
+				t.Error("expect the subscription to be ended")
+			}
+
+			if sub.Err() != broker.ErrBrokerStopped {
+				t.Errorf("expect %v, but got %v", broker.ErrBrokerStopped, sub.Err())
+			}
+
+			sub.Close()
+		}
+	})
+}
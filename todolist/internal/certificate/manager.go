@@ -0,0 +1,137 @@
+package certificate
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"math"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Manager serves a certificate and its key from their files, and reloads them when they change. The certificate is
+// swapped atomically, so the handshakes in progress keep the one they started with.
+type Manager struct {
+	certFile string
+	keyFile  string
+	interval time.Duration
+
+	certificate atomic.Value
+	// stamps of the files the current certificate was loaded from
+	stamps [2]stamp
+}
+
+// stamp tells when a file changed
+type stamp struct {
+	modTime time.Time
+	size    int64
+}
+
+// NewManager loads the certificate of the files, they are checked for changes every interval once it runs
+func NewManager(certFile string, keyFile string, interval time.Duration) (*Manager, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("the reload interval of certificate %s must be positive, got %s", certFile, interval)
+	}
+
+	m := &Manager{
+		certFile: certFile,
+		keyFile:  keyFile,
+		interval: interval,
+	}
+
+	if err := m.Reload(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// GetCertificate returns the certificate to a server, it is the GetCertificate of its tls.Config
+func (m *Manager) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return m.certificate.Load().(*tls.Certificate), nil
+}
+
+// GetClientCertificate returns the certificate to a client, it is the GetClientCertificate of its tls.Config
+func (m *Manager) GetClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	return m.certificate.Load().(*tls.Certificate), nil
+}
+
+// NotAfter returns when the certificate expires
+func (m *Manager) NotAfter() time.Time {
+	return m.certificate.Load().(*tls.Certificate).Leaf.NotAfter
+}
+
+// Reload loads the certificate of the files, the current one is kept when they cannot be loaded
+func (m *Manager) Reload() error {
+	stamps, err := m.stat()
+	if err != nil {
+		return err
+	}
+
+	certificate, err := tls.LoadX509KeyPair(m.certFile, m.keyFile)
+	if err != nil {
+		return err
+	}
+
+	if certificate.Leaf, err = x509.ParseCertificate(certificate.Certificate[0]); err != nil {
+		return err
+	}
+
+	m.certificate.Store(&certificate)
+	m.stamps = stamps
+
+	zap.S().Infof("loaded certificate %s of %s, it expires at %s", m.certFile, certificate.Leaf.Subject, certificate.Leaf.NotAfter.Format(time.RFC3339))
+	return nil
+}
+
+// Run reloads the certificate every interval when its files changed, until the context is done. A certificate is
+// usually rotated by writing its files one after the other, a pair that does not match is retried the next time.
+func (m *Manager) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		stamps, err := m.stat()
+		if err != nil {
+			zap.S().Errorf("cannot check certificate %s, error: %v", m.certFile, err)
+			continue
+		}
+
+		if stamps == m.stamps {
+			continue
+		}
+
+		if err := m.Reload(); err != nil {
+			zap.S().Errorf("cannot reload certificate %s, error: %v", m.certFile, err)
+		}
+	}
+}
+
+func (m *Manager) stat() ([2]stamp, error) {
+	var stamps [2]stamp
+
+	for i, file := range []string{m.certFile, m.keyFile} {
+		info, err := os.Stat(file)
+		if err != nil {
+			return stamps, err
+		}
+		stamps[i] = stamp{modTime: info.ModTime(), size: info.Size()}
+	}
+
+	return stamps, nil
+}
+
+// DaysUntilExpiry returns the whole days left until a certificate expires, negative once it expired
+func DaysUntilExpiry(notAfter time.Time, now time.Time) int32 {
+	return int32(math.Floor(notAfter.Sub(now).Hours() / 24))
+}
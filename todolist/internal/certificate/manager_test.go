@@ -0,0 +1,146 @@
+package certificate
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeCertificate writes a self signed certificate that expires at notAfter and its key, their change time is at
+func writeCertificate(t *testing.T, certFile string, keyFile string, notAfter time.Time, at time.Time) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, file := range []string{certFile, keyFile} {
+		if err := os.Chtimes(file, at, at); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestManager(t *testing.T) {
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "server.crt")
+	keyFile := filepath.Join(dir, "server.key")
+
+	firstExpiry := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+	writeCertificate(t, certFile, keyFile, firstExpiry, time.Now().Add(-time.Hour))
+
+	if _, err := NewManager(certFile, keyFile, 0); err == nil {
+		t.Errorf("expect an error for an interval that is not positive")
+	}
+
+	manager, err := NewManager(certFile, keyFile, 10*time.Millisecond)
+	if err != nil {
+		t.Fatalf("expect no error, but got %v", err)
+	}
+
+	t.Run("Manager_Load", func(t *testing.T) {
+		if !manager.NotAfter().Equal(firstExpiry) {
+			t.Errorf("expect %v, but got %v", firstExpiry, manager.NotAfter())
+		}
+
+		certificate, err := manager.GetCertificate(nil)
+		if err != nil || certificate.Leaf == nil {
+			t.Errorf("expect the certificate, but got %v, %v", certificate, err)
+		}
+	})
+
+	t.Run("Manager_KeepMismatchedPair", func(t *testing.T) {
+		otherDir := t.TempDir()
+		otherKey := filepath.Join(otherDir, "other.key")
+		writeCertificate(t, filepath.Join(otherDir, "other.crt"), otherKey, time.Now().Add(time.Hour), time.Now())
+
+		content, err := ioutil.ReadFile(otherKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(keyFile, content, 0600); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := manager.Reload(); err == nil {
+			t.Errorf("expect an error for a key of another certificate")
+		}
+
+		if !manager.NotAfter().Equal(firstExpiry) {
+			t.Errorf("expect the current certificate to be kept, but got %v", manager.NotAfter())
+		}
+	})
+
+	t.Run("Manager_ReloadRotated", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		go manager.Run(ctx)
+
+		secondExpiry := time.Now().Add(48 * time.Hour).Truncate(time.Second)
+		writeCertificate(t, certFile, keyFile, secondExpiry, time.Now())
+
+		deadline := time.Now().Add(5 * time.Second)
+		for !manager.NotAfter().Equal(secondExpiry) {
+			if time.Now().After(deadline) {
+				t.Fatalf("expect the rotated certificate, but got %v", manager.NotAfter())
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	})
+}
+
+func TestDaysUntilExpiry(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name     string
+		notAfter time.Time
+		expect   int32
+	}{
+		{name: "DaysUntilExpiry_Days", notAfter: now.Add(30*24*time.Hour + time.Hour), expect: 30},
+		{name: "DaysUntilExpiry_LessThanADay", notAfter: now.Add(time.Hour), expect: 0},
+		{name: "DaysUntilExpiry_Expired", notAfter: now.Add(-time.Hour), expect: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if days := DaysUntilExpiry(tt.notAfter, now); days != tt.expect {
+				t.Errorf("expect %d, but got %d", tt.expect, days)
+			}
+		})
+	}
+}
@@ -2,23 +2,50 @@ package healthcheck
 
 import (
 	"context"
+	"time"
 
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	"github.com/overridesh/sgg-todolist-service/internal/certificate"
 	pbPaymentGateway "github.com/overridesh/sgg-todolist-service/proto"
+	"github.com/overridesh/sgg-todolist-service/tools"
 )
 
+// Certificate is the TLS certificate of the service
+type Certificate interface {
+	NotAfter() time.Time
+}
+
 // Backend implements the protobuf interface
-type healthcheck struct{}
+type healthcheck struct {
+	certificate Certificate
+}
 
-// New initializes a new Healthcheck struct.
-func NewGRPC() pbPaymentGateway.HealthcheckServiceServer {
-	return &healthcheck{}
+// New initializes a new Healthcheck struct, the certificate is left out of the health when it is nil.
+func NewGRPC(certificate Certificate) pbPaymentGateway.HealthcheckServiceServer {
+	return &healthcheck{
+		certificate: certificate,
+	}
 }
 
 // Healthcheck
 func (b *healthcheck) GetHealthcheck(ctx context.Context, _ *emptypb.Empty) (*pbPaymentGateway.GetHealthcheckResponse, error) {
-	return &pbPaymentGateway.GetHealthcheckResponse{
+	response := pbPaymentGateway.GetHealthcheckResponse{
 		Ok: true,
-	}, nil
+	}
+
+	if b.certificate != nil {
+		notAfter := b.certificate.NotAfter()
+		response.Certificate = &pbPaymentGateway.CertificateHealth{
+			NotAfter:        tools.FormatDate(notAfter),
+			DaysUntilExpiry: certificate.DaysUntilExpiry(notAfter, time.Now()),
+		}
+	}
+
+	return &response, nil
+}
+
+// AuthFuncOverride lets any caller check the health, also when the authentication is enabled
+func (b *healthcheck) AuthFuncOverride(ctx context.Context, fullMethodName string) (context.Context, error) {
+	return ctx, nil
 }
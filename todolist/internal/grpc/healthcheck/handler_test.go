@@ -5,6 +5,7 @@ import (
 	"log"
 	"net"
 	"testing"
+	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -15,12 +16,12 @@ import (
 	pbPaymentGateway "github.com/overridesh/sgg-todolist-service/proto"
 )
 
-func dialer() func(context.Context, string) (net.Conn, error) {
+func dialer(certificate Certificate) func(context.Context, string) (net.Conn, error) {
 	listener := bufconn.Listen(1024 * 1024)
 
 	server := grpc.NewServer()
 
-	pbPaymentGateway.RegisterHealthcheckServiceServer(server, NewGRPC())
+	pbPaymentGateway.RegisterHealthcheckServiceServer(server, NewGRPC(certificate))
 
 	go func() {
 		if err := server.Serve(listener); err != nil {
@@ -52,7 +53,7 @@ func TestGetHealthcheck(t *testing.T) {
 
 	ctx := context.Background()
 
-	conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer()))
+	conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(nil)))
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -83,3 +84,27 @@ func TestGetHealthcheck(t *testing.T) {
 		})
 	}
 }
+
+// expiringCertificate expires at a fixed time
+type expiringCertificate time.Time
+
+func (c expiringCertificate) NotAfter() time.Time {
+	return time.Time(c)
+}
+
+func TestGetHealthcheckCertificate(t *testing.T) {
+	conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(expiringCertificate(time.Now().Add(10*24*time.Hour+time.Hour)))))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer conn.Close()
+
+	response, err := pbPaymentGateway.NewHealthcheckServiceClient(conn).GetHealthcheck(context.Background(), &emptypb.Empty{})
+	if err != nil {
+		t.Fatalf("expect no error, but got %v", err)
+	}
+
+	if response.GetCertificate().GetDaysUntilExpiry() != 10 || response.GetCertificate().GetNotAfter() == "" {
+		t.Errorf("expect the certificate to expire in 10 days, but got %v", response.GetCertificate())
+	}
+}
@@ -5,32 +5,48 @@ import (
 	"log"
 	"net"
 
+	grpcAuth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/test/bufconn"
 
-	"github.com/overridesh/sgg-todolist-service/internal/repository"
+	"github.com/overridesh/sgg-todolist-service/internal/auth"
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+	mockRepository "github.com/overridesh/sgg-todolist-service/pkg/mock"
 	pbTodoList "github.com/overridesh/sgg-todolist-service/proto"
 )
 
 // dialer func for test grpc server
-func dialer(
-	taskRepository repository.TaskRepository,
-	commentRepository repository.CommentRepository,
-	labelRepository repository.LabelRepository,
-) func(context.Context, string) (net.Conn, error) {
+func dialer(deps Dependencies) func(context.Context, string) (net.Conn, error) {
 	listener := bufconn.Listen(1024 * 1024)
 
-	server := grpc.NewServer()
+	// The tests that do not care about sharing are run by the owner of every task
+	if deps.MemberRepository == nil {
+		members := new(mockRepository.MemberRepository)
+		members.On("GetTaskAccess", mock.Anything, mock.Anything).Return(&model.TaskAccess{
+			OwnerId: auth.DefaultSubject,
+			Role:    model.RoleOwner,
+		}, nil)
+		members.On("GetDeletedTaskAccess", mock.Anything, mock.Anything).Return(&model.TaskAccess{
+			OwnerId: auth.DefaultSubject,
+			Role:    model.RoleOwner,
+		}, nil)
+		deps.MemberRepository = members
+	}
+
+	// The callers are the default identity, as when the authentication is disabled, or the identity of their api key
+	var authFunc grpcAuth.AuthFunc = auth.DefaultAuthFunc
+	if deps.ApiKeyRepository != nil {
+		authFunc = auth.ApiKeyAuthFunc(deps.ApiKeyRepository, authFunc)
+	}
 
-	pbTodoList.RegisterTodoListServiceServer(
-		server,
-		NewGRPC(
-			taskRepository,
-			commentRepository,
-			labelRepository,
-		),
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(grpcAuth.UnaryServerInterceptor(authFunc), auth.UnaryScopeInterceptor(MethodScope)),
+		grpc.ChainStreamInterceptor(grpcAuth.StreamServerInterceptor(authFunc), auth.StreamScopeInterceptor(MethodScope)),
 	)
 
+	pbTodoList.RegisterTodoListServiceServer(server, NewGRPC(deps))
+
 	go func() {
 		if err := server.Serve(listener); err != nil {
 			log.Fatal(err)
@@ -0,0 +1,174 @@
+package todolist
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/overridesh/sgg-todolist-service/internal/auth"
+	"github.com/overridesh/sgg-todolist-service/internal/logging"
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+	"github.com/overridesh/sgg-todolist-service/internal/repository"
+	pbTodoList "github.com/overridesh/sgg-todolist-service/proto"
+	"github.com/overridesh/sgg-todolist-service/tools"
+)
+
+// methodScopes are the scopes the api keys need to call the methods of the service, the methods that are not here
+// need admin
+var methodScopes map[string]string = map[string]string{
+	"GetTask":            auth.ScopeReadTasks,
+	"GetTasks":           auth.ScopeReadTasks,
+	"SearchTasks":        auth.ScopeReadTasks,
+	"GetSubtasks":        auth.ScopeReadTasks,
+	"GetTaskOccurrences": auth.ScopeReadTasks,
+	"ListDeletedTasks":   auth.ScopeReadTasks,
+	"GetComments":        auth.ScopeReadTasks,
+	"GetLabels":          auth.ScopeReadTasks,
+	"ListReminders":      auth.ScopeReadTasks,
+	"ListTaskMembers":    auth.ScopeReadTasks,
+	"WatchTasks":         auth.ScopeReadTasks,
+	"CreateTask":         auth.ScopeWriteTasks,
+	"UpdateTask":         auth.ScopeWriteTasks,
+	"UpdateTaskStatus":   auth.ScopeWriteTasks,
+	"DeleteTask":         auth.ScopeWriteTasks,
+	"MoveTask":           auth.ScopeWriteTasks,
+	"RestoreTask":        auth.ScopeWriteTasks,
+	"PurgeTask":          auth.ScopeWriteTasks,
+	"CreateComment":      auth.ScopeWriteTasks,
+	"DeleteComment":      auth.ScopeWriteTasks,
+	"CreateLabel":        auth.ScopeWriteTasks,
+	"DeleteLabel":        auth.ScopeWriteTasks,
+	"CreateReminder":     auth.ScopeWriteTasks,
+	"DeleteReminder":     auth.ScopeWriteTasks,
+	"ShareTask":          auth.ScopeWriteTasks,
+	"UnshareTask":        auth.ScopeWriteTasks,
+}
+
+// MethodScope returns the scope needed to call a method of the service, the methods of other services need none
+func MethodScope(fullMethod string) string {
+	method := strings.TrimPrefix(fullMethod, "/"+pbTodoList.TodoListService_ServiceDesc.ServiceName+"/")
+	if method == fullMethod {
+		return ""
+	}
+
+	if scope, ok := methodScopes[method]; ok {
+		return scope
+	}
+
+	return auth.ScopeAdmin
+}
+
+func (svc *todoListGRPC) ListApiKeys(ctx context.Context, in *pbTodoList.ListApiKeysRequest) (*pbTodoList.ListApiKeysResponse, error) {
+	if err := svc.authorizeCaller(ctx); err != nil {
+		return nil, err
+	}
+
+	apiKeys, err := svc.apiKeyRepository.GetApiKeys(ctx)
+	if err != nil {
+		logging.FromContext(ctx).Error("cannot get api keys", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	response := pbTodoList.ListApiKeysResponse{
+		ApiKeys: []*pbTodoList.ApiKey{},
+	}
+
+	for _, apiKey := range apiKeys {
+		response.ApiKeys = append(response.ApiKeys, getApiKeyResponse(apiKey))
+	}
+
+	return &response, nil
+}
+
+func (svc *todoListGRPC) CreateApiKey(ctx context.Context, in *pbTodoList.CreateApiKeyRequest) (*pbTodoList.CreateApiKeyResponse, error) {
+	if err := svc.authorizeCaller(ctx); err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(in.GetName())
+	if len(name) == 0 {
+		return nil, ErrStatusApiKeyNameRequired.Err()
+	}
+
+	if len(in.GetScopes()) == 0 {
+		return nil, ErrStatusInvalidApiKeyScopes.Err()
+	}
+
+	for _, scope := range in.GetScopes() {
+		if !auth.Scopes[scope] {
+			return nil, ErrStatusInvalidApiKeyScopes.Err()
+		}
+	}
+
+	secret, err := auth.NewApiKey()
+	if err != nil {
+		logging.FromContext(ctx).Error("cannot generate api key", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	apiKey, err := svc.apiKeyRepository.CreateApiKey(ctx, model.ApiKey{
+		Name:    name,
+		Prefix:  secret[:auth.ApiKeyPrefixLength],
+		KeyHash: auth.HashApiKey(secret),
+		Scopes:  in.GetScopes(),
+	})
+	if err != nil {
+		logging.FromContext(ctx).Error("cannot create api key", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	if err := tools.SetStatusCode(ctx, http.StatusCreated); err != nil {
+		logging.FromContext(ctx).Error("cannot set new status_code", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	return &pbTodoList.CreateApiKeyResponse{
+		ApiKey: getApiKeyResponse(apiKey),
+		Secret: secret,
+	}, nil
+}
+
+func (svc *todoListGRPC) RevokeApiKey(ctx context.Context, in *pbTodoList.RevokeApiKeyRequest) (*emptypb.Empty, error) {
+	if err := svc.authorizeCaller(ctx); err != nil {
+		return nil, err
+	}
+
+	apiKeyId, err := tools.GetValidUUID(in.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := svc.apiKeyRepository.RevokeApiKey(ctx, apiKeyId); err != nil {
+		if err == repository.ErrApiKeyNotFound {
+			return nil, ErrStatusApiKeyNotFound.Err()
+		}
+		logging.FromContext(ctx).Error("cannot revoke api key", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	if err := tools.SetStatusCode(ctx, http.StatusNoContent); err != nil {
+		logging.FromContext(ctx).Error("cannot set new status_code", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func getApiKeyResponse(apiKey *model.ApiKey) *pbTodoList.ApiKey {
+	response := pbTodoList.ApiKey{
+		Id:        apiKey.Id.String(),
+		Name:      apiKey.Name,
+		Scopes:    apiKey.Scopes,
+		Prefix:    apiKey.Prefix,
+		CreatedAt: tools.FormatDate(apiKey.CreatedAt),
+	}
+
+	if apiKey.LastUsedAt.Valid {
+		response.LastUsedAt = tools.FormatDate(apiKey.LastUsedAt.Time)
+	}
+
+	return &response
+}
@@ -0,0 +1,274 @@
+package todolist
+
+import (
+	"context"
+	"log"
+	"strings"
+	"testing"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/overridesh/sgg-todolist-service/internal/auth"
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+	"github.com/overridesh/sgg-todolist-service/internal/repository"
+	mockRepository "github.com/overridesh/sgg-todolist-service/pkg/mock"
+	pbTodoList "github.com/overridesh/sgg-todolist-service/proto"
+)
+
+// withApiKey returns the api keys of the test server, the key "tdl_test" has the scopes
+func withApiKey(scopes ...string) *mockRepository.ApiKeyRepository {
+	apiKeyRepository := new(mockRepository.ApiKeyRepository)
+	apiKeyRepository.On("UseApiKey", mock.Anything, auth.HashApiKey("tdl_test")).Return(&auth.Identity{
+		Subject:  auth.DefaultSubject,
+		TenantId: auth.DefaultTenant,
+		Scopes:   scopes,
+	}, nil)
+	apiKeyRepository.On("UseApiKey", mock.Anything, mock.Anything).Return(nil, auth.ErrUnknownApiKey)
+	return apiKeyRepository
+}
+
+// apiKeyContext returns a context that sends the api key
+func apiKeyContext(secret string) context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), auth.ApiKeyHeader, secret)
+}
+
+func TestCreateApiKey(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  func() (*pbTodoList.CreateApiKeyResponse, error)
+		output *status.Status
+	}{
+		{
+			name: "CreateApiKey_ErrStatusApiKeyNameRequired",
+			input: func() (*pbTodoList.CreateApiKeyResponse, error) {
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.CreateApiKey(context.Background(), &pbTodoList.CreateApiKeyRequest{
+					Name:   " ",
+					Scopes: []string{auth.ScopeReadTasks},
+				})
+			},
+			output: ErrStatusApiKeyNameRequired,
+		},
+		{
+			name: "CreateApiKey_ErrStatusInvalidApiKeyScopes",
+			input: func() (*pbTodoList.CreateApiKeyResponse, error) {
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.CreateApiKey(context.Background(), &pbTodoList.CreateApiKeyRequest{
+					Name:   "ci",
+					Scopes: []string{auth.ScopeReadTasks, "delete:everything"},
+				})
+			},
+			output: ErrStatusInvalidApiKeyScopes,
+		},
+		{
+			name: "CreateApiKey_ErrStatusInsufficientScope",
+			input: func() (*pbTodoList.CreateApiKeyResponse, error) {
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{ApiKeyRepository: withApiKey(auth.ScopeWriteTasks)})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.CreateApiKey(apiKeyContext("tdl_test"), &pbTodoList.CreateApiKeyRequest{
+					Name:   "ci",
+					Scopes: []string{auth.ScopeAdmin},
+				})
+			},
+			output: auth.ErrStatusInsufficientScope,
+		},
+		{
+			name: "CreateApiKey_Success",
+			input: func() (*pbTodoList.CreateApiKeyResponse, error) {
+				var created model.ApiKey
+
+				apiKeyRepository := new(mockRepository.ApiKeyRepository)
+				apiKeyRepository.On("CreateApiKey", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					created = args.Get(1).(model.ApiKey)
+				}).Return(func(_ context.Context, apiKey model.ApiKey) *model.ApiKey {
+					apiKey.Id = uuid.NewV4()
+					apiKey.CreatedAt = time.Now()
+					return &apiKey
+				}, nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{ApiKeyRepository: apiKeyRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				response, err := client.CreateApiKey(context.Background(), &pbTodoList.CreateApiKeyRequest{
+					Name:   " ci ",
+					Scopes: []string{auth.ScopeReadTasks, auth.ScopeWriteTasks},
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				// Only the hash of the secret is stored, the secret is only in the response
+				if created.Name != "ci" || created.KeyHash != auth.HashApiKey(response.GetSecret()) || !strings.HasPrefix(response.GetSecret(), created.Prefix) {
+					t.Errorf("expect the hash of the secret to be stored, but got %v", created)
+				}
+
+				if response.GetApiKey().GetPrefix() != created.Prefix || len(response.GetApiKey().GetScopes()) != 2 || response.GetApiKey().GetLastUsedAt() != "" {
+					t.Errorf("expect the created api key, but got %v", response)
+				}
+
+				return response, nil
+			},
+			output: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.input()
+			if err != nil {
+				if tt.output == nil {
+					t.Fatalf("expect no error, but got %v", err)
+				}
+				if er, ok := status.FromError(err); ok {
+					if er.Code() != tt.output.Code() {
+						t.Errorf("error code: expected %v, received %v", tt.output.Code(), er.Code())
+					}
+					if er.Message() != tt.output.Message() {
+						t.Errorf("error message: expected %v, received %v", tt.output.Message(), er.Message())
+					}
+				}
+			} else if tt.output != nil {
+				t.Errorf("expect error %v, but got nil", tt.output.Message())
+			}
+		})
+	}
+}
+
+func TestRevokeApiKey(t *testing.T) {
+	t.Run("RevokeApiKey_ErrStatusApiKeyNotFound", func(t *testing.T) {
+		apiKeyId := uuid.NewV4()
+
+		apiKeyRepository := new(mockRepository.ApiKeyRepository)
+		apiKeyRepository.On("RevokeApiKey", mock.Anything, apiKeyId).Return(repository.ErrApiKeyNotFound)
+
+		conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{ApiKeyRepository: apiKeyRepository})))
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer conn.Close()
+
+		_, err = pbTodoList.NewTodoListServiceClient(conn).RevokeApiKey(context.Background(), &pbTodoList.RevokeApiKeyRequest{
+			Id: apiKeyId.String(),
+		})
+		if er, ok := status.FromError(err); !ok || er.Message() != ErrStatusApiKeyNotFound.Message() {
+			t.Errorf("expect %v, but got %v", ErrStatusApiKeyNotFound.Message(), err)
+		}
+	})
+}
+
+func TestApiKeyScopes(t *testing.T) {
+	t.Run("Scopes_InvalidApiKey", func(t *testing.T) {
+		conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{ApiKeyRepository: withApiKey(auth.ScopeAdmin)})))
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer conn.Close()
+
+		_, err = pbTodoList.NewTodoListServiceClient(conn).ListApiKeys(apiKeyContext("tdl_revoked"), &pbTodoList.ListApiKeysRequest{})
+		if er, ok := status.FromError(err); !ok || er.Message() != auth.ErrStatusInvalidApiKey.Message() {
+			t.Errorf("expect %v, but got %v", auth.ErrStatusInvalidApiKey.Message(), err)
+		}
+	})
+
+	t.Run("Scopes_ReadCannotWrite", func(t *testing.T) {
+		conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{ApiKeyRepository: withApiKey(auth.ScopeReadTasks)})))
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer conn.Close()
+
+		_, err = pbTodoList.NewTodoListServiceClient(conn).DeleteTask(apiKeyContext("tdl_test"), &pbTodoList.DeleteTaskRequest{
+			Id: uuid.NewV4().String(),
+		})
+		if er, ok := status.FromError(err); !ok || er.Message() != auth.ErrStatusInsufficientScope.Message() {
+			t.Errorf("expect %v, but got %v", auth.ErrStatusInsufficientScope.Message(), err)
+		}
+	})
+
+	t.Run("Scopes_WatchNeedsRead", func(t *testing.T) {
+		conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{ApiKeyRepository: withApiKey(auth.ScopeWriteTasks)})))
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer conn.Close()
+
+		stream, err := pbTodoList.NewTodoListServiceClient(conn).WatchTasks(apiKeyContext("tdl_test"), &pbTodoList.WatchTasksRequest{AfterSequence: -1})
+		if err != nil {
+			t.Fatalf("expect no error, but got %v", err)
+		}
+
+		// write:tasks also reads, so the request itself is refused
+		if _, err := stream.Recv(); status.Convert(err).Message() != ErrStatusInvalidAfterSequence.Message() {
+			t.Errorf("expect %v, but got %v", ErrStatusInvalidAfterSequence.Message(), err)
+		}
+	})
+
+	t.Run("Scopes_AdminListsApiKeys", func(t *testing.T) {
+		apiKeyRepository := withApiKey(auth.ScopeAdmin)
+		apiKeyRepository.On("GetApiKeys", mock.Anything).Return([]*model.ApiKey{
+			{Id: uuid.NewV4(), Name: "ci", Prefix: "tdl_1234abcd", Scopes: []string{auth.ScopeAdmin}},
+		}, nil)
+
+		conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{ApiKeyRepository: apiKeyRepository})))
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer conn.Close()
+
+		response, err := pbTodoList.NewTodoListServiceClient(conn).ListApiKeys(apiKeyContext("tdl_test"), &pbTodoList.ListApiKeysRequest{})
+		if err != nil {
+			t.Fatalf("expect no error, but got %v", err)
+		}
+
+		if len(response.GetApiKeys()) != 1 || response.GetApiKeys()[0].GetName() != "ci" {
+			t.Errorf("expect the api key, but got %v", response)
+		}
+	})
+}
+
+func TestMethodScope(t *testing.T) {
+	tests := map[string]string{
+		"/todolist.TodoListService/GetTasks":                   auth.ScopeReadTasks,
+		"/todolist.TodoListService/CreateComment":              auth.ScopeWriteTasks,
+		"/todolist.TodoListService/CreateWebhook":              auth.ScopeAdmin,
+		"/todolist.TodoListService/RevokeApiKey":               auth.ScopeAdmin,
+		"/healthcheck.v1.HealthcheckService/GetHealthcheck":    "",
+		"/grpc.reflection.v1alpha.ServerReflection/Reflection": "",
+	}
+
+	for method, scope := range tests {
+		if got := MethodScope(method); got != scope {
+			t.Errorf("%s: expect %q, but got %q", method, scope, got)
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	"github.com/overridesh/sgg-todolist-service/internal/logging"
 	"github.com/overridesh/sgg-todolist-service/internal/model"
 	"github.com/overridesh/sgg-todolist-service/internal/repository"
 	pbTodoList "github.com/overridesh/sgg-todolist-service/proto"
@@ -19,12 +20,17 @@ func (svc *todoListGRPC) GetComments(ctx context.Context, in *pbTodoList.GetComm
 		return nil, err
 	}
 
+	ctx, err = svc.authorize(ctx, taskId, model.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+
 	task, err := svc.taskRepository.GetTask(ctx, taskId)
 	if err != nil {
 		if err == repository.ErrTaskNotFound {
 			return nil, ErrStatusTaskNotFound.Err()
 		}
-		zap.S().Errorf("cannot get task", zap.Error(err))
+		logging.FromContext(ctx).Error("cannot get task", zap.Error(err))
 		return nil, ErrStatusInternalServerError.Err()
 	}
 
@@ -32,7 +38,7 @@ func (svc *todoListGRPC) GetComments(ctx context.Context, in *pbTodoList.GetComm
 
 	comments, err := svc.commentRepository.GetCommentsByTaskId(ctx, task.Id)
 	if err != nil {
-		zap.S().Errorf("cannot get task", zap.Error(err))
+		logging.FromContext(ctx).Error("cannot get task", zap.Error(err))
 		return nil, ErrStatusInternalServerError.Err()
 	}
 
@@ -53,12 +59,17 @@ func (svc *todoListGRPC) CreateComment(ctx context.Context, in *pbTodoList.Creat
 		return nil, err
 	}
 
+	ctx, err = svc.authorize(ctx, taskId, model.RoleCommenter)
+	if err != nil {
+		return nil, err
+	}
+
 	task, err := svc.taskRepository.GetTask(ctx, taskId)
 	if err != nil {
 		if err == repository.ErrTaskNotFound {
 			return nil, ErrStatusTaskNotFound.Err()
 		}
-		zap.S().Errorf("cannot get task", zap.Error(err))
+		logging.FromContext(ctx).Error("cannot get task", zap.Error(err))
 		return nil, ErrStatusInternalServerError.Err()
 	}
 
@@ -67,7 +78,10 @@ func (svc *todoListGRPC) CreateComment(ctx context.Context, in *pbTodoList.Creat
 		Value:  in.GetComment(),
 	})
 	if err != nil {
-		zap.S().Errorf("cannot create comment", zap.Error(err))
+		if err == repository.ErrTaskNotFound {
+			return nil, ErrStatusTaskNotFound.Err()
+		}
+		logging.FromContext(ctx).Error("cannot create comment", zap.Error(err))
 		return nil, ErrStatusInternalServerError.Err()
 	}
 	response := pbTodoList.CreateCommentResponse{
@@ -79,7 +93,7 @@ func (svc *todoListGRPC) CreateComment(ctx context.Context, in *pbTodoList.Creat
 	}
 
 	if err := tools.SetStatusCode(ctx, http.StatusCreated); err != nil {
-		zap.S().Errorf("cannot set new status_code", zap.Error(err))
+		logging.FromContext(ctx).Error("cannot set new status_code", zap.Error(err))
 		return nil, ErrStatusInternalServerError.Err()
 	}
 
@@ -92,21 +106,35 @@ func (svc *todoListGRPC) DeleteComment(ctx context.Context, in *pbTodoList.Delet
 		return nil, err
 	}
 
+	ctx, err = svc.authorize(ctx, taskId, model.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+
 	commentId, err := tools.GetValidUUID(in.GetCommentId())
 	if err != nil {
 		return nil, err
 	}
 
-	if err := svc.commentRepository.DeleteCommentByTaskIdAndCommentId(ctx, taskId, commentId); err != nil {
+	task, err := svc.taskRepository.GetTask(ctx, taskId)
+	if err != nil {
+		if err == repository.ErrTaskNotFound {
+			return nil, ErrStatusTaskNotFound.Err()
+		}
+		logging.FromContext(ctx).Error("cannot get task", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	if err := svc.commentRepository.DeleteCommentByTaskIdAndCommentId(ctx, task.Id, commentId); err != nil {
 		if err == repository.ErrCommentNotFound {
 			return nil, ErrStatusCommentNotFound.Err()
 		}
-		zap.S().Errorf("cannot delete comment", zap.Error(err))
+		logging.FromContext(ctx).Error("cannot delete comment", zap.Error(err))
 		return nil, ErrStatusInternalServerError.Err()
 	}
 
 	if err := tools.SetStatusCode(ctx, http.StatusNoContent); err != nil {
-		zap.S().Errorf("cannot set new status_code", zap.Error(err))
+		logging.FromContext(ctx).Error("cannot set new status_code", zap.Error(err))
 		return nil, ErrStatusInternalServerError.Err()
 	}
 
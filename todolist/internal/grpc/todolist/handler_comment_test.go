@@ -31,7 +31,7 @@ func TestGetComments(t *testing.T) {
 			name: "GetComments_ErrStatusIdMustBeUUID",
 			input: func() (*pbTodoList.GetCommentsResponse, error) {
 				ctx := context.Background()
-				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(nil, nil, nil)))
+				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -57,7 +57,7 @@ func TestGetComments(t *testing.T) {
 				taskRepository.On("GetTask", mock.Anything, tx.Id).Return(nil, repository.ErrTaskNotFound)
 
 				ctx := context.Background()
-				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, nil, nil)))
+				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -83,7 +83,7 @@ func TestGetComments(t *testing.T) {
 				taskRepository.On("GetTask", mock.Anything, tx.Id).Return(nil, sql.ErrConnDone)
 
 				ctx := context.Background()
-				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, nil, nil)))
+				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -111,7 +111,7 @@ func TestGetComments(t *testing.T) {
 				commentRepository.On("GetCommentsByTaskId", mock.Anything, tx.Id).Return(nil, sql.ErrConnDone)
 
 				ctx := context.Background()
-				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, commentRepository, nil)))
+				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository, CommentRepository: commentRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -139,7 +139,7 @@ func TestGetComments(t *testing.T) {
 				commentRepository.On("GetCommentsByTaskId", mock.Anything, tx.Id).Return([]*model.Comment{}, nil)
 
 				ctx := context.Background()
-				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, commentRepository, nil)))
+				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository, CommentRepository: commentRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -169,7 +169,7 @@ func TestGetComments(t *testing.T) {
 				}}, nil)
 
 				ctx := context.Background()
-				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, commentRepository, nil)))
+				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository, CommentRepository: commentRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -212,7 +212,7 @@ func TestCreateComment(t *testing.T) {
 			name: "CreateComment_ErrStatusIdMustBeUUID",
 			input: func() (*pbTodoList.CreateCommentResponse, error) {
 				ctx := context.Background()
-				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(nil, nil, nil)))
+				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -238,7 +238,7 @@ func TestCreateComment(t *testing.T) {
 				taskRepository.On("GetTask", mock.Anything, tx.Id).Return(nil, repository.ErrTaskNotFound)
 
 				ctx := context.Background()
-				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, nil, nil)))
+				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -264,7 +264,7 @@ func TestCreateComment(t *testing.T) {
 				taskRepository.On("GetTask", mock.Anything, tx.Id).Return(nil, sql.ErrConnDone)
 
 				ctx := context.Background()
-				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, nil, nil)))
+				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -295,7 +295,7 @@ func TestCreateComment(t *testing.T) {
 				}).Return(nil, sql.ErrConnDone)
 
 				ctx := context.Background()
-				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, commentRepository, nil)))
+				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository, CommentRepository: commentRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -328,7 +328,7 @@ func TestCreateComment(t *testing.T) {
 				commentRepository.On("CreateComment", mock.Anything, comment).Return(&comment, nil)
 
 				ctx := context.Background()
-				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, commentRepository, nil)))
+				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository, CommentRepository: commentRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -372,7 +372,7 @@ func TestDeleteComment(t *testing.T) {
 			name: "DeleteComment_TaskIdErrStatusIdMustBeUUID",
 			input: func() (*emptypb.Empty, error) {
 				ctx := context.Background()
-				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(nil, nil, nil)))
+				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -390,7 +390,7 @@ func TestDeleteComment(t *testing.T) {
 			name: "DeleteComment_CommentIdErrStatusIdMustBeUUID",
 			input: func() (*emptypb.Empty, error) {
 				ctx := context.Background()
-				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(nil, nil, nil)))
+				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -413,11 +413,14 @@ func TestDeleteComment(t *testing.T) {
 					commentId uuid.UUID = uuid.NewV4()
 				)
 
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTask", mock.Anything, taskId).Return(&model.Task{Id: taskId}, nil)
+
 				commentRepository := new(mockRepository.CommentRepository)
 				commentRepository.On("DeleteCommentByTaskIdAndCommentId", mock.Anything, taskId, commentId).Return(sql.ErrConnDone)
 
 				ctx := context.Background()
-				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(nil, commentRepository, nil)))
+				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository, CommentRepository: commentRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -440,11 +443,14 @@ func TestDeleteComment(t *testing.T) {
 					commentId uuid.UUID = uuid.NewV4()
 				)
 
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTask", mock.Anything, taskId).Return(&model.Task{Id: taskId}, nil)
+
 				commentRepository := new(mockRepository.CommentRepository)
 				commentRepository.On("DeleteCommentByTaskIdAndCommentId", mock.Anything, taskId, commentId).Return(repository.ErrCommentNotFound)
 
 				ctx := context.Background()
-				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(nil, commentRepository, nil)))
+				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository, CommentRepository: commentRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -459,6 +465,33 @@ func TestDeleteComment(t *testing.T) {
 			},
 			output: ErrStatusCommentNotFound,
 		},
+		{
+			name: "DeleteComment_ErrStatusTaskNotFound",
+			input: func() (*emptypb.Empty, error) {
+				var (
+					taskId    uuid.UUID = uuid.NewV4()
+					commentId uuid.UUID = uuid.NewV4()
+				)
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTask", mock.Anything, taskId).Return(nil, repository.ErrTaskNotFound)
+
+				ctx := context.Background()
+				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.DeleteComment(ctx, &pbTodoList.DeleteCommentRequest{
+					Id:        taskId.String(),
+					CommentId: commentId.String(),
+				})
+			},
+			output: ErrStatusTaskNotFound,
+		},
 		{
 			name: "DeleteComment_Success",
 			input: func() (*emptypb.Empty, error) {
@@ -467,11 +500,14 @@ func TestDeleteComment(t *testing.T) {
 					commentId uuid.UUID = uuid.NewV4()
 				)
 
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTask", mock.Anything, taskId).Return(&model.Task{Id: taskId}, nil)
+
 				commentRepository := new(mockRepository.CommentRepository)
 				commentRepository.On("DeleteCommentByTaskIdAndCommentId", mock.Anything, taskId, commentId).Return(nil)
 
 				ctx := context.Background()
-				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(nil, commentRepository, nil)))
+				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository, CommentRepository: commentRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -1,17 +1,62 @@
 package todolist
 
 import (
+	"fmt"
+
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/overridesh/sgg-todolist-service/internal/model"
 	"github.com/overridesh/sgg-todolist-service/internal/repository"
 )
 
 var (
-	ErrStatusInternalServerError   *status.Status = status.New(codes.Internal, "internal server error")
-	ErrStatusTaskNotFound          *status.Status = status.New(codes.NotFound, repository.ErrTaskNotFound.Error())
-	ErrStatusCommentNotFound       *status.Status = status.New(codes.NotFound, repository.ErrCommentNotFound.Error())
-	ErrStatusErrLabelNotFound      *status.Status = status.New(codes.NotFound, repository.ErrLabelNotFound.Error())
-	ErrStatusLabelAlreadyExists    *status.Status = status.New(codes.AlreadyExists, repository.ErrLabelAlreadyExists.Error())
-	ErrStatusCannotParseTimeLayout *status.Status = status.New(codes.InvalidArgument, "cannot parse timelayout")
+	ErrStatusInternalServerError     *status.Status = status.New(codes.Internal, "internal server error")
+	ErrStatusTaskNotFound            *status.Status = status.New(codes.NotFound, repository.ErrTaskNotFound.Error())
+	ErrStatusCommentNotFound         *status.Status = status.New(codes.NotFound, repository.ErrCommentNotFound.Error())
+	ErrStatusErrLabelNotFound        *status.Status = status.New(codes.NotFound, repository.ErrLabelNotFound.Error())
+	ErrStatusLabelAlreadyExists      *status.Status = status.New(codes.AlreadyExists, repository.ErrLabelAlreadyExists.Error())
+	ErrStatusCannotParseTimeLayout   *status.Status = status.New(codes.InvalidArgument, "cannot parse timelayout")
+	ErrStatusInvalidPageToken        *status.Status = status.New(codes.InvalidArgument, "invalid page_token")
+	ErrStatusPageTokenMismatch       *status.Status = status.New(codes.InvalidArgument, "page_token was returned for another sort_direction or filters")
+	ErrStatusInvalidPageSize         *status.Status = status.New(codes.InvalidArgument, "page_size must be between 0 and 100")
+	ErrStatusInvalidSortWithToken    *status.Status = status.New(codes.InvalidArgument, "page_size and page_token only support sort by created_at")
+	ErrStatusSearchQueryRequired     *status.Status = status.New(codes.InvalidArgument, "q is required")
+	ErrStatusInvalidUpdateMask       *status.Status = status.New(codes.InvalidArgument, "update_mask only supports value, completed, due_date, parent_id, priority and recurrence")
+	ErrStatusInvalidEtag             *status.Status = status.New(codes.InvalidArgument, "invalid etag")
+	ErrStatusTaskEtagMismatch        *status.Status = status.New(codes.FailedPrecondition, repository.ErrTaskVersionMismatch.Error())
+	ErrStatusParentTaskNotFound      *status.Status = status.New(codes.NotFound, "parent task not found")
+	ErrStatusParentTaskDeleted       *status.Status = status.New(codes.FailedPrecondition, repository.ErrParentTaskDeleted.Error())
+	ErrStatusTaskHierarchyCycle      *status.Status = status.New(codes.InvalidArgument, "a task cannot be a subtask of itself or of its subtasks")
+	ErrStatusTaskMaxDepth            *status.Status = status.New(codes.InvalidArgument, fmt.Sprintf("subtasks cannot be nested more than %d levels", model.TaskMaxDepth))
+	ErrStatusIncompleteSubtasks      *status.Status = status.New(codes.FailedPrecondition, repository.ErrIncompleteSubtasks.Error())
+	ErrStatusInvalidPriority         *status.Status = status.New(codes.InvalidArgument, "invalid priority")
+	ErrStatusInvalidMoveTarget       *status.Status = status.New(codes.InvalidArgument, "exactly one of before_id or after_id is required")
+	ErrStatusMoveToItself            *status.Status = status.New(codes.InvalidArgument, "a task cannot be moved next to itself")
+	ErrStatusMoveTargetNotFound      *status.Status = status.New(codes.NotFound, repository.ErrMoveTargetNotFound.Error())
+	ErrStatusInvalidRecurrence       *status.Status = status.New(codes.InvalidArgument, "recurrence must be a valid RFC 5545 rule")
+	ErrStatusRecurrenceNeedsDueDate  *status.Status = status.New(codes.InvalidArgument, "recurrence requires a due_date")
+	ErrStatusTaskNotRecurring        *status.Status = status.New(codes.FailedPrecondition, "task does not repeat")
+	ErrStatusInvalidOccurrences      *status.Status = status.New(codes.InvalidArgument, fmt.Sprintf("count must be between 0 and %d", maxOccurrences))
+	ErrStatusReminderNotFound        *status.Status = status.New(codes.NotFound, repository.ErrReminderNotFound.Error())
+	ErrStatusReminderNeedsTime       *status.Status = status.New(codes.InvalidArgument, "remind_at is required for tasks without due_date")
+	ErrStatusWebhookNotFound         *status.Status = status.New(codes.NotFound, repository.ErrWebhookNotFound.Error())
+	ErrStatusWebhookDeliveryNotFound *status.Status = status.New(codes.NotFound, repository.ErrWebhookDeliveryNotFound.Error())
+	ErrStatusInvalidWebhookURL       *status.Status = status.New(codes.InvalidArgument, "url must be an absolute http or https url")
+	ErrStatusPrivateWebhookURL       *status.Status = status.New(codes.InvalidArgument, "url must reach a public address")
+	ErrStatusWebhookSecretRequired   *status.Status = status.New(codes.InvalidArgument, "secret is required")
+	ErrStatusInvalidWebhookEvent     *status.Status = status.New(codes.InvalidArgument, "invalid event")
+	ErrStatusInvalidDeliveryStatus   *status.Status = status.New(codes.InvalidArgument, "invalid status")
+	ErrStatusInvalidAfterSequence    *status.Status = status.New(codes.InvalidArgument, "after_sequence cannot be negative")
+	ErrStatusWatchFellBehind         *status.Status = status.New(codes.ResourceExhausted, "the watch fell behind the events, resume it from the last sequence")
+	ErrStatusWatchUnavailable        *status.Status = status.New(codes.Unavailable, "the events are not available, resume the watch later")
+	ErrStatusUnauthenticated         *status.Status = status.New(codes.Unauthenticated, "unauthenticated")
+	ErrStatusPermissionDenied        *status.Status = status.New(codes.PermissionDenied, "permission denied")
+	ErrStatusMemberNotFound          *status.Status = status.New(codes.NotFound, repository.ErrMemberNotFound.Error())
+	ErrStatusMemberIdRequired        *status.Status = status.New(codes.InvalidArgument, "member_id is required")
+	ErrStatusInvalidMemberRole       *status.Status = status.New(codes.InvalidArgument, "role must be viewer, commenter or editor")
+	ErrStatusShareWithOwner          *status.Status = status.New(codes.InvalidArgument, "a task cannot be shared with its owner")
+	ErrStatusApiKeyNotFound          *status.Status = status.New(codes.NotFound, repository.ErrApiKeyNotFound.Error())
+	ErrStatusApiKeyNameRequired      *status.Status = status.New(codes.InvalidArgument, "name is required")
+	ErrStatusInvalidApiKeyScopes     *status.Status = status.New(codes.InvalidArgument, "scopes must be any of read:tasks, write:tasks or admin")
 )
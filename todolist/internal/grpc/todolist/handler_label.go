@@ -8,6 +8,7 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	"github.com/overridesh/sgg-todolist-service/internal/logging"
 	"github.com/overridesh/sgg-todolist-service/internal/model"
 	"github.com/overridesh/sgg-todolist-service/internal/repository"
 	pbTodoList "github.com/overridesh/sgg-todolist-service/proto"
@@ -20,12 +21,17 @@ func (svc *todoListGRPC) GetLabels(ctx context.Context, in *pbTodoList.GetLabels
 		return nil, err
 	}
 
+	ctx, err = svc.authorize(ctx, taskId, model.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+
 	task, err := svc.taskRepository.GetTask(ctx, taskId)
 	if err != nil {
 		if err == repository.ErrTaskNotFound {
 			return nil, ErrStatusTaskNotFound.Err()
 		}
-		zap.S().Errorf("cannot get task", zap.Error(err))
+		logging.FromContext(ctx).Error("cannot get task", zap.Error(err))
 		return nil, ErrStatusInternalServerError.Err()
 	}
 
@@ -33,7 +39,7 @@ func (svc *todoListGRPC) GetLabels(ctx context.Context, in *pbTodoList.GetLabels
 
 	labels, err := svc.labelRepository.GetLabelsByTaskId(ctx, task.Id)
 	if err != nil {
-		zap.S().Errorf("cannot get labels", zap.Error(err))
+		logging.FromContext(ctx).Error("cannot get labels", zap.Error(err))
 		return nil, ErrStatusInternalServerError.Err()
 	}
 
@@ -54,12 +60,17 @@ func (svc *todoListGRPC) CreateLabel(ctx context.Context, in *pbTodoList.CreateL
 		return nil, err
 	}
 
+	ctx, err = svc.authorize(ctx, taskId, model.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+
 	task, err := svc.taskRepository.GetTask(ctx, taskId)
 	if err != nil {
 		if err == repository.ErrTaskNotFound {
 			return nil, ErrStatusTaskNotFound.Err()
 		}
-		zap.S().Errorf("cannot get task", zap.Error(err))
+		logging.FromContext(ctx).Error("cannot get task", zap.Error(err))
 		return nil, ErrStatusInternalServerError.Err()
 	}
 
@@ -71,7 +82,10 @@ func (svc *todoListGRPC) CreateLabel(ctx context.Context, in *pbTodoList.CreateL
 		if err == repository.ErrLabelAlreadyExists {
 			return nil, ErrStatusLabelAlreadyExists.Err()
 		}
-		zap.S().Errorf("cannot create label", zap.Error(err))
+		if err == repository.ErrTaskNotFound {
+			return nil, ErrStatusTaskNotFound.Err()
+		}
+		logging.FromContext(ctx).Error("cannot create label", zap.Error(err))
 		return nil, ErrStatusInternalServerError.Err()
 	}
 
@@ -84,7 +98,7 @@ func (svc *todoListGRPC) CreateLabel(ctx context.Context, in *pbTodoList.CreateL
 	}
 
 	if err := tools.SetStatusCode(ctx, http.StatusCreated); err != nil {
-		zap.S().Errorf("cannot set new status_code", zap.Error(err))
+		logging.FromContext(ctx).Error("cannot set new status_code", zap.Error(err))
 		return nil, ErrStatusInternalServerError.Err()
 	}
 	return &response, nil
@@ -96,23 +110,42 @@ func (svc *todoListGRPC) DeleteLabel(ctx context.Context, in *pbTodoList.DeleteL
 		return nil, err
 	}
 
+	ctx, err = svc.authorize(ctx, taskId, model.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+
 	labelId, err := tools.GetValidUUID(in.GetLabelId())
 	if err != nil {
 		return nil, err
 	}
 
-	if err := svc.labelRepository.DeleteLabelByTaskIdAndLabelId(ctx, taskId, labelId); err != nil {
+	task, err := svc.taskRepository.GetTask(ctx, taskId)
+	if err != nil {
+		if err == repository.ErrTaskNotFound {
+			return nil, ErrStatusTaskNotFound.Err()
+		}
+		logging.FromContext(ctx).Error("cannot get task", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	if err := svc.labelRepository.DeleteLabelByTaskIdAndLabelId(ctx, task.Id, labelId); err != nil {
 		if err == repository.ErrLabelNotFound {
 			return nil, ErrStatusErrLabelNotFound.Err()
 		}
-		zap.S().Errorf("cannot delete comment", zap.Error(err))
+		logging.FromContext(ctx).Error("cannot delete comment", zap.Error(err))
 		return nil, ErrStatusInternalServerError.Err()
 	}
 
 	if err := tools.SetStatusCode(ctx, http.StatusNoContent); err != nil {
-		zap.S().Errorf("cannot set new status_code", zap.Error(err))
+		logging.FromContext(ctx).Error("cannot set new status_code", zap.Error(err))
 		return nil, ErrStatusInternalServerError.Err()
 	}
 
 	return &emptypb.Empty{}, nil
 }
+
+// normalizeLabel turn a label of a filter into the stored form of the labels, which are lowercased
+func normalizeLabel(label string) string {
+	return strings.ToLower(strings.TrimSpace(label))
+}
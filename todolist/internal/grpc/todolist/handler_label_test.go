@@ -31,7 +31,7 @@ func TestGetLabels(t *testing.T) {
 			name: "GetLabels_ErrStatusIdMustBeUUID",
 			input: func() (*pbTodoList.GetLabelsResponse, error) {
 				ctx := context.Background()
-				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(nil, nil, nil)))
+				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -57,7 +57,7 @@ func TestGetLabels(t *testing.T) {
 				taskRepository.On("GetTask", mock.Anything, tx.Id).Return(nil, repository.ErrTaskNotFound)
 
 				ctx := context.Background()
-				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, nil, nil)))
+				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -83,7 +83,7 @@ func TestGetLabels(t *testing.T) {
 				taskRepository.On("GetTask", mock.Anything, tx.Id).Return(nil, sql.ErrConnDone)
 
 				ctx := context.Background()
-				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, nil, nil)))
+				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -111,7 +111,7 @@ func TestGetLabels(t *testing.T) {
 				labelRepository.On("GetLabelsByTaskId", mock.Anything, tx.Id).Return(nil, sql.ErrConnDone)
 
 				ctx := context.Background()
-				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, nil, labelRepository)))
+				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository, LabelRepository: labelRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -139,7 +139,7 @@ func TestGetLabels(t *testing.T) {
 				labelRepository.On("GetLabelsByTaskId", mock.Anything, tx.Id).Return([]*model.Label{}, nil)
 
 				ctx := context.Background()
-				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, nil, labelRepository)))
+				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository, LabelRepository: labelRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -169,7 +169,7 @@ func TestGetLabels(t *testing.T) {
 				}}, nil)
 
 				ctx := context.Background()
-				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, nil, labelRepository)))
+				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository, LabelRepository: labelRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -212,7 +212,7 @@ func TestCreateLabel(t *testing.T) {
 			name: "CreateLabel_ErrStatusIdMustBeUUID",
 			input: func() (*pbTodoList.CreateLabelResponse, error) {
 				ctx := context.Background()
-				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(nil, nil, nil)))
+				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -238,7 +238,7 @@ func TestCreateLabel(t *testing.T) {
 				taskRepository.On("GetTask", mock.Anything, tx.Id).Return(nil, repository.ErrTaskNotFound)
 
 				ctx := context.Background()
-				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, nil, nil)))
+				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -264,7 +264,7 @@ func TestCreateLabel(t *testing.T) {
 				taskRepository.On("GetTask", mock.Anything, tx.Id).Return(nil, sql.ErrConnDone)
 
 				ctx := context.Background()
-				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, nil, nil)))
+				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -295,7 +295,7 @@ func TestCreateLabel(t *testing.T) {
 				}).Return(nil, sql.ErrConnDone)
 
 				ctx := context.Background()
-				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, nil, labelRepository)))
+				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository, LabelRepository: labelRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -326,7 +326,7 @@ func TestCreateLabel(t *testing.T) {
 				}).Return(nil, repository.ErrLabelAlreadyExists)
 
 				ctx := context.Background()
-				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, nil, labelRepository)))
+				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository, LabelRepository: labelRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -359,7 +359,7 @@ func TestCreateLabel(t *testing.T) {
 				labelRepository.On("CreateLabel", mock.Anything, label).Return(&label, nil)
 
 				ctx := context.Background()
-				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, nil, labelRepository)))
+				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository, LabelRepository: labelRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -403,7 +403,7 @@ func TestDeleteLabel(t *testing.T) {
 			name: "DeleteLabel_TaskIdErrStatusIdMustBeUUID",
 			input: func() (*emptypb.Empty, error) {
 				ctx := context.Background()
-				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(nil, nil, nil)))
+				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -421,7 +421,7 @@ func TestDeleteLabel(t *testing.T) {
 			name: "DeleteLabel_LabelIdErrStatusIdMustBeUUID",
 			input: func() (*emptypb.Empty, error) {
 				ctx := context.Background()
-				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(nil, nil, nil)))
+				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -444,11 +444,14 @@ func TestDeleteLabel(t *testing.T) {
 					labelId uuid.UUID = uuid.NewV4()
 				)
 
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTask", mock.Anything, taskId).Return(&model.Task{Id: taskId}, nil)
+
 				labelRepository := new(mockRepository.LabelRepository)
 				labelRepository.On("DeleteLabelByTaskIdAndLabelId", mock.Anything, taskId, labelId).Return(sql.ErrConnDone)
 
 				ctx := context.Background()
-				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(nil, nil, labelRepository)))
+				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository, LabelRepository: labelRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -471,11 +474,14 @@ func TestDeleteLabel(t *testing.T) {
 					labelId uuid.UUID = uuid.NewV4()
 				)
 
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTask", mock.Anything, taskId).Return(&model.Task{Id: taskId}, nil)
+
 				labelRepository := new(mockRepository.LabelRepository)
 				labelRepository.On("DeleteLabelByTaskIdAndLabelId", mock.Anything, taskId, labelId).Return(repository.ErrLabelNotFound)
 
 				ctx := context.Background()
-				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(nil, nil, labelRepository)))
+				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository, LabelRepository: labelRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -490,6 +496,33 @@ func TestDeleteLabel(t *testing.T) {
 			},
 			output: ErrStatusErrLabelNotFound,
 		},
+		{
+			name: "DeleteLabel_ErrStatusTaskNotFound",
+			input: func() (*emptypb.Empty, error) {
+				var (
+					taskId  uuid.UUID = uuid.NewV4()
+					labelId uuid.UUID = uuid.NewV4()
+				)
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTask", mock.Anything, taskId).Return(nil, repository.ErrTaskNotFound)
+
+				ctx := context.Background()
+				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.DeleteLabel(ctx, &pbTodoList.DeleteLabelRequest{
+					Id:      taskId.String(),
+					LabelId: labelId.String(),
+				})
+			},
+			output: ErrStatusTaskNotFound,
+		},
 		{
 			name: "DeleteLabel_Success",
 			input: func() (*emptypb.Empty, error) {
@@ -498,11 +531,14 @@ func TestDeleteLabel(t *testing.T) {
 					labelId uuid.UUID = uuid.NewV4()
 				)
 
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTask", mock.Anything, taskId).Return(&model.Task{Id: taskId}, nil)
+
 				labelRepository := new(mockRepository.LabelRepository)
 				labelRepository.On("DeleteLabelByTaskIdAndLabelId", mock.Anything, taskId, labelId).Return(nil)
 
 				ctx := context.Background()
-				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(nil, nil, labelRepository)))
+				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository, LabelRepository: labelRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
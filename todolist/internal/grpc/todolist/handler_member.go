@@ -0,0 +1,215 @@
+package todolist
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	uuid "github.com/satori/go.uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/overridesh/sgg-todolist-service/internal/auth"
+	"github.com/overridesh/sgg-todolist-service/internal/logging"
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+	"github.com/overridesh/sgg-todolist-service/internal/repository"
+	pbTodoList "github.com/overridesh/sgg-todolist-service/proto"
+	"github.com/overridesh/sgg-todolist-service/tools"
+)
+
+var (
+	memberRoles map[pbTodoList.TaskMemberRole]string = map[pbTodoList.TaskMemberRole]string{
+		pbTodoList.TaskMemberRole_TASK_MEMBER_ROLE_VIEWER:    model.RoleViewer,
+		pbTodoList.TaskMemberRole_TASK_MEMBER_ROLE_COMMENTER: model.RoleCommenter,
+		pbTodoList.TaskMemberRole_TASK_MEMBER_ROLE_EDITOR:    model.RoleEditor,
+	}
+)
+
+// authorize checks the caller has at least the role on the task, the owner of a task has every role. The task and
+// anything under it are reached in the scope of the owner of the task, so the returned context acts as the owner for
+// the members of a shared task. A task that was not shared with the caller is not found.
+func (svc *todoListGRPC) authorize(ctx context.Context, taskId uuid.UUID, role string) (context.Context, error) {
+	access, err := svc.memberRepository.GetTaskAccess(ctx, taskId)
+	return svc.actAsOwner(ctx, access, err, role)
+}
+
+// authorizeDeleted checks the caller has at least the role on a task of the trash, like authorize
+func (svc *todoListGRPC) authorizeDeleted(ctx context.Context, taskId uuid.UUID, role string) (context.Context, error) {
+	access, err := svc.memberRepository.GetDeletedTaskAccess(ctx, taskId)
+	return svc.actAsOwner(ctx, access, err, role)
+}
+
+// authorizeCaller checks the request has a caller, for the methods that only reach what belongs to the caller like
+// the trash, the webhooks and the api keys
+func (svc *todoListGRPC) authorizeCaller(ctx context.Context) error {
+	if _, ok := auth.FromContext(ctx); !ok {
+		return ErrStatusUnauthenticated.Err()
+	}
+	return nil
+}
+
+// actAsOwner checks access, got with err, allows the role and returns a context that acts as the owner of the task
+func (svc *todoListGRPC) actAsOwner(ctx context.Context, access *model.TaskAccess, err error, role string) (context.Context, error) {
+	if err != nil {
+		if err == repository.ErrTaskNotFound {
+			return nil, ErrStatusTaskNotFound.Err()
+		}
+		logging.FromContext(ctx).Error("cannot get task access", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	if !access.Allows(role) {
+		return nil, ErrStatusPermissionDenied.Err()
+	}
+
+	identity, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, ErrStatusUnauthenticated.Err()
+	}
+
+	if access.OwnerId == identity.Subject {
+		return ctx, nil
+	}
+
+	return auth.NewContext(ctx, &auth.Identity{
+		Subject:  access.OwnerId,
+		TenantId: identity.TenantId,
+		Scopes:   identity.Scopes,
+	}), nil
+}
+
+// authorizeParent checks the caller is an editor of the parent of a subtask, none is needed for a root task. The
+// subtask belongs to the owner of its parent, so the returned context acts as that owner.
+func (svc *todoListGRPC) authorizeParent(ctx context.Context, parent string) (context.Context, error) {
+	if len(parent) == 0 {
+		return ctx, nil
+	}
+
+	parentId, err := tools.GetValidUUID(parent)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = svc.authorize(ctx, parentId, model.RoleEditor)
+	if status.Code(err) == codes.NotFound {
+		return nil, ErrStatusParentTaskNotFound.Err()
+	}
+	return ctx, err
+}
+
+func (svc *todoListGRPC) ListTaskMembers(ctx context.Context, in *pbTodoList.ListTaskMembersRequest) (*pbTodoList.ListTaskMembersResponse, error) {
+	taskId, err := tools.GetValidUUID(in.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = svc.authorize(ctx, taskId, model.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := svc.memberRepository.GetTaskMembers(ctx, taskId)
+	if err != nil {
+		logging.FromContext(ctx).Error("cannot get task members", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	response := pbTodoList.ListTaskMembersResponse{
+		Members: []*pbTodoList.TaskMember{},
+	}
+
+	for _, member := range members {
+		response.Members = append(response.Members, getTaskMemberResponse(member))
+	}
+
+	return &response, nil
+}
+
+func (svc *todoListGRPC) ShareTask(ctx context.Context, in *pbTodoList.ShareTaskRequest) (*pbTodoList.ShareTaskResponse, error) {
+	taskId, err := tools.GetValidUUID(in.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	memberId := strings.TrimSpace(in.GetMemberId())
+	if len(memberId) == 0 {
+		return nil, ErrStatusMemberIdRequired.Err()
+	}
+
+	role, ok := memberRoles[in.GetRole()]
+	if !ok {
+		return nil, ErrStatusInvalidMemberRole.Err()
+	}
+
+	ctx, err = svc.authorize(ctx, taskId, model.RoleOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	if identity, _ := auth.FromContext(ctx); identity.Subject == memberId {
+		return nil, ErrStatusShareWithOwner.Err()
+	}
+
+	member, err := svc.memberRepository.ShareTask(ctx, model.TaskMember{
+		TaskId:   taskId,
+		MemberId: memberId,
+		Role:     role,
+	})
+	if err != nil {
+		if err == repository.ErrTaskNotFound {
+			return nil, ErrStatusTaskNotFound.Err()
+		}
+		logging.FromContext(ctx).Error("cannot share task", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	return &pbTodoList.ShareTaskResponse{
+		Member: getTaskMemberResponse(member),
+	}, nil
+}
+
+func (svc *todoListGRPC) UnshareTask(ctx context.Context, in *pbTodoList.UnshareTaskRequest) (*emptypb.Empty, error) {
+	taskId, err := tools.GetValidUUID(in.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = svc.authorize(ctx, taskId, model.RoleOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := svc.memberRepository.UnshareTask(ctx, taskId, strings.TrimSpace(in.GetMemberId())); err != nil {
+		if err == repository.ErrMemberNotFound {
+			return nil, ErrStatusMemberNotFound.Err()
+		}
+		logging.FromContext(ctx).Error("cannot unshare task", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	if err := tools.SetStatusCode(ctx, http.StatusNoContent); err != nil {
+		logging.FromContext(ctx).Error("cannot set new status_code", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func getTaskMemberResponse(member *model.TaskMember) *pbTodoList.TaskMember {
+	response := pbTodoList.TaskMember{
+		TaskId:    member.TaskId.String(),
+		MemberId:  member.MemberId,
+		CreatedAt: tools.FormatDate(member.CreatedAt),
+		UpdatedAt: tools.FormatDate(member.UpdatedAt),
+	}
+
+	for role, value := range memberRoles {
+		if value == member.Role {
+			response.Role = role
+		}
+	}
+
+	return &response
+}
@@ -0,0 +1,456 @@
+package todolist
+
+import (
+	"context"
+	"errors"
+	"log"
+	"testing"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/overridesh/sgg-todolist-service/internal/auth"
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+	"github.com/overridesh/sgg-todolist-service/internal/repository"
+	mockRepository "github.com/overridesh/sgg-todolist-service/pkg/mock"
+	pbTodoList "github.com/overridesh/sgg-todolist-service/proto"
+)
+
+// taskOwner is the owner of the tasks shared with the default identity of the test server
+const taskOwner string = "user-2"
+
+// sharedWith returns the members of a task of taskOwner that was shared with the caller with the role
+func sharedWith(role string) *mockRepository.MemberRepository {
+	memberRepository := new(mockRepository.MemberRepository)
+	memberRepository.On("GetTaskAccess", mock.Anything, mock.Anything).Return(&model.TaskAccess{
+		OwnerId: taskOwner,
+		Role:    role,
+	}, nil)
+	return memberRepository
+}
+
+// asOwner matches the context of a request made in the scope of taskOwner
+var asOwner interface{} = mock.MatchedBy(func(ctx context.Context) bool {
+	identity, ok := auth.FromContext(ctx)
+	return ok && identity.Subject == taskOwner && identity.TenantId == auth.DefaultTenant
+})
+
+func TestShareTask(t *testing.T) {
+	taskId := uuid.NewV4()
+
+	tests := []struct {
+		name   string
+		input  func() (*pbTodoList.ShareTaskResponse, error)
+		output *status.Status
+	}{
+		{
+			name: "ShareTask_ErrStatusMemberIdRequired",
+			input: func() (*pbTodoList.ShareTaskResponse, error) {
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.ShareTask(context.Background(), &pbTodoList.ShareTaskRequest{
+					Id:       taskId.String(),
+					MemberId: " ",
+					Role:     pbTodoList.TaskMemberRole_TASK_MEMBER_ROLE_VIEWER,
+				})
+			},
+			output: ErrStatusMemberIdRequired,
+		},
+		{
+			name: "ShareTask_ErrStatusInvalidMemberRole",
+			input: func() (*pbTodoList.ShareTaskResponse, error) {
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.ShareTask(context.Background(), &pbTodoList.ShareTaskRequest{
+					Id:       taskId.String(),
+					MemberId: "user-3",
+				})
+			},
+			output: ErrStatusInvalidMemberRole,
+		},
+		{
+			name: "ShareTask_ErrStatusPermissionDenied",
+			input: func() (*pbTodoList.ShareTaskResponse, error) {
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{MemberRepository: sharedWith(model.RoleEditor)})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.ShareTask(context.Background(), &pbTodoList.ShareTaskRequest{
+					Id:       taskId.String(),
+					MemberId: "user-3",
+					Role:     pbTodoList.TaskMemberRole_TASK_MEMBER_ROLE_EDITOR,
+				})
+			},
+			output: ErrStatusPermissionDenied,
+		},
+		{
+			name: "ShareTask_ErrStatusShareWithOwner",
+			input: func() (*pbTodoList.ShareTaskResponse, error) {
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.ShareTask(context.Background(), &pbTodoList.ShareTaskRequest{
+					Id:       taskId.String(),
+					MemberId: auth.DefaultSubject,
+					Role:     pbTodoList.TaskMemberRole_TASK_MEMBER_ROLE_VIEWER,
+				})
+			},
+			output: ErrStatusShareWithOwner,
+		},
+		{
+			name: "ShareTask_ErrStatusTaskNotFound",
+			input: func() (*pbTodoList.ShareTaskResponse, error) {
+				memberRepository := new(mockRepository.MemberRepository)
+				memberRepository.On("GetTaskAccess", mock.Anything, taskId).Return(nil, repository.ErrTaskNotFound)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{MemberRepository: memberRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.ShareTask(context.Background(), &pbTodoList.ShareTaskRequest{
+					Id:       taskId.String(),
+					MemberId: "user-3",
+					Role:     pbTodoList.TaskMemberRole_TASK_MEMBER_ROLE_VIEWER,
+				})
+			},
+			output: ErrStatusTaskNotFound,
+		},
+		{
+			name: "ShareTask_Success",
+			input: func() (*pbTodoList.ShareTaskResponse, error) {
+				member := model.TaskMember{
+					TaskId:   taskId,
+					MemberId: "user-3",
+					Role:     model.RoleCommenter,
+				}
+
+				memberRepository := new(mockRepository.MemberRepository)
+				memberRepository.On("GetTaskAccess", mock.Anything, taskId).Return(&model.TaskAccess{
+					OwnerId: auth.DefaultSubject,
+					Role:    model.RoleOwner,
+				}, nil)
+				memberRepository.On("ShareTask", mock.Anything, member).Return(&member, nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{MemberRepository: memberRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				response, err := client.ShareTask(context.Background(), &pbTodoList.ShareTaskRequest{
+					Id:       taskId.String(),
+					MemberId: " user-3 ",
+					Role:     pbTodoList.TaskMemberRole_TASK_MEMBER_ROLE_COMMENTER,
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				if response.GetMember().GetMemberId() != "user-3" || response.GetMember().GetRole() != pbTodoList.TaskMemberRole_TASK_MEMBER_ROLE_COMMENTER {
+					t.Errorf("expect the shared member, but got %v", response)
+				}
+
+				return response, nil
+			},
+			output: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.input()
+			if err != nil {
+				if tt.output == nil {
+					t.Fatalf("expect no error, but got %v", err)
+				}
+				if er, ok := status.FromError(err); ok {
+					if er.Code() != tt.output.Code() {
+						t.Errorf("error code: expected %v, received %v", tt.output.Code(), er.Code())
+					}
+					if er.Message() != tt.output.Message() {
+						t.Errorf("error message: expected %v, received %v", tt.output.Message(), er.Message())
+					}
+				}
+			} else if tt.output != nil {
+				t.Errorf("expect error %v, but got nil", tt.output.Message())
+			}
+		})
+	}
+}
+
+func TestUnshareTask(t *testing.T) {
+	taskId := uuid.NewV4()
+
+	tests := []struct {
+		name   string
+		input  func() error
+		output *status.Status
+	}{
+		{
+			name: "UnshareTask_ErrStatusMemberNotFound",
+			input: func() error {
+				memberRepository := new(mockRepository.MemberRepository)
+				memberRepository.On("GetTaskAccess", mock.Anything, taskId).Return(&model.TaskAccess{
+					OwnerId: auth.DefaultSubject,
+					Role:    model.RoleOwner,
+				}, nil)
+				memberRepository.On("UnshareTask", mock.Anything, taskId, "user-3").Return(repository.ErrMemberNotFound)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{MemberRepository: memberRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				_, err = client.UnshareTask(context.Background(), &pbTodoList.UnshareTaskRequest{
+					Id:       taskId.String(),
+					MemberId: "user-3",
+				})
+				return err
+			},
+			output: ErrStatusMemberNotFound,
+		},
+		{
+			name: "UnshareTask_ErrStatusPermissionDenied",
+			input: func() error {
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{MemberRepository: sharedWith(model.RoleEditor)})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				_, err = client.UnshareTask(context.Background(), &pbTodoList.UnshareTaskRequest{
+					Id:       taskId.String(),
+					MemberId: auth.DefaultSubject,
+				})
+				return err
+			},
+			output: ErrStatusPermissionDenied,
+		},
+		{
+			name: "UnshareTask_Success",
+			input: func() error {
+				memberRepository := new(mockRepository.MemberRepository)
+				memberRepository.On("GetTaskAccess", mock.Anything, taskId).Return(&model.TaskAccess{
+					OwnerId: auth.DefaultSubject,
+					Role:    model.RoleOwner,
+				}, nil)
+				memberRepository.On("UnshareTask", mock.Anything, taskId, "user-3").Return(nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{MemberRepository: memberRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				_, err = client.UnshareTask(context.Background(), &pbTodoList.UnshareTaskRequest{
+					Id:       taskId.String(),
+					MemberId: " user-3 ",
+				})
+				return err
+			},
+			output: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.input()
+			if err != nil {
+				if tt.output == nil {
+					t.Fatalf("expect no error, but got %v", err)
+				}
+				if er, ok := status.FromError(err); ok {
+					if er.Code() != tt.output.Code() {
+						t.Errorf("error code: expected %v, received %v", tt.output.Code(), er.Code())
+					}
+					if er.Message() != tt.output.Message() {
+						t.Errorf("error message: expected %v, received %v", tt.output.Message(), er.Message())
+					}
+				}
+			} else if tt.output != nil {
+				t.Errorf("expect error %v, but got nil", tt.output.Message())
+			}
+		})
+	}
+}
+
+func TestListTaskMembers(t *testing.T) {
+	t.Run("ListTaskMembers_Viewer", func(t *testing.T) {
+		taskId := uuid.NewV4()
+
+		memberRepository := sharedWith(model.RoleViewer)
+		memberRepository.On("GetTaskMembers", asOwner, taskId).Return([]*model.TaskMember{
+			{TaskId: taskId, MemberId: auth.DefaultSubject, Role: model.RoleViewer},
+			{TaskId: taskId, MemberId: "user-3", Role: model.RoleEditor},
+		}, nil)
+
+		conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{MemberRepository: memberRepository})))
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer conn.Close()
+
+		response, err := pbTodoList.NewTodoListServiceClient(conn).ListTaskMembers(context.Background(), &pbTodoList.ListTaskMembersRequest{
+			Id: taskId.String(),
+		})
+		if err != nil {
+			t.Fatalf("expect no error, but got %v", err)
+		}
+
+		if len(response.GetMembers()) != 2 || response.GetMembers()[1].GetRole() != pbTodoList.TaskMemberRole_TASK_MEMBER_ROLE_EDITOR {
+			t.Errorf("expect the 2 members, but got %v", response)
+		}
+	})
+
+	t.Run("ListTaskMembers_ErrStatusInternalServerError", func(t *testing.T) {
+		memberRepository := new(mockRepository.MemberRepository)
+		memberRepository.On("GetTaskAccess", mock.Anything, mock.Anything).Return(nil, errors.New("unknown_error"))
+
+		conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{MemberRepository: memberRepository})))
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer conn.Close()
+
+		_, err = pbTodoList.NewTodoListServiceClient(conn).ListTaskMembers(context.Background(), &pbTodoList.ListTaskMembersRequest{
+			Id: uuid.NewV4().String(),
+		})
+		if er, ok := status.FromError(err); !ok || er.Message() != ErrStatusInternalServerError.Message() {
+			t.Errorf("expect %v, but got %v", ErrStatusInternalServerError.Message(), err)
+		}
+	})
+}
+
+func TestTaskRoles(t *testing.T) {
+	t.Run("Roles_ViewerCannotComment", func(t *testing.T) {
+		conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{MemberRepository: sharedWith(model.RoleViewer)})))
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer conn.Close()
+
+		_, err = pbTodoList.NewTodoListServiceClient(conn).CreateComment(context.Background(), &pbTodoList.CreateCommentRequest{
+			Id:      uuid.NewV4().String(),
+			Comment: "comment",
+		})
+		if er, ok := status.FromError(err); !ok || er.Code() != ErrStatusPermissionDenied.Code() {
+			t.Errorf("expect %v, but got %v", ErrStatusPermissionDenied.Code(), err)
+		}
+	})
+
+	t.Run("Roles_CommenterCannotUpdate", func(t *testing.T) {
+		conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{MemberRepository: sharedWith(model.RoleCommenter)})))
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer conn.Close()
+
+		_, err = pbTodoList.NewTodoListServiceClient(conn).DeleteTask(context.Background(), &pbTodoList.DeleteTaskRequest{
+			Id: uuid.NewV4().String(),
+		})
+		if er, ok := status.FromError(err); !ok || er.Code() != ErrStatusPermissionDenied.Code() {
+			t.Errorf("expect %v, but got %v", ErrStatusPermissionDenied.Code(), err)
+		}
+	})
+
+	t.Run("Roles_NotShared", func(t *testing.T) {
+		memberRepository := new(mockRepository.MemberRepository)
+		memberRepository.On("GetTaskAccess", mock.Anything, mock.Anything).Return(nil, repository.ErrTaskNotFound)
+
+		conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{MemberRepository: memberRepository})))
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer conn.Close()
+
+		_, err = pbTodoList.NewTodoListServiceClient(conn).GetTask(context.Background(), &pbTodoList.GetTaskRequest{
+			Id: uuid.NewV4().String(),
+		})
+		if er, ok := status.FromError(err); !ok || er.Message() != ErrStatusTaskNotFound.Message() {
+			t.Errorf("expect %v, but got %v", ErrStatusTaskNotFound.Message(), err)
+		}
+	})
+
+	t.Run("Roles_ViewerReadsAsOwner", func(t *testing.T) {
+		task := model.Task{Id: uuid.NewV4(), Value: "shared"}
+
+		taskRepository := new(mockRepository.TaskRepository)
+		taskRepository.On("GetTask", asOwner, task.Id).Return(&task, nil)
+		taskRepository.On("GetSubtasks", asOwner, task.Id).Return([]*model.Task{}, nil)
+
+		commentRepository := new(mockRepository.CommentRepository)
+		commentRepository.On("GetCommentsByTaskId", asOwner, task.Id).Return([]*model.Comment{}, nil)
+
+		labelRepository := new(mockRepository.LabelRepository)
+		labelRepository.On("GetLabelsByTaskId", asOwner, task.Id).Return([]*model.Label{}, nil)
+
+		conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository, CommentRepository: commentRepository, LabelRepository: labelRepository, MemberRepository: sharedWith(model.RoleViewer)})))
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer conn.Close()
+
+		response, err := pbTodoList.NewTodoListServiceClient(conn).GetTask(context.Background(), &pbTodoList.GetTaskRequest{
+			Id: task.Id.String(),
+		})
+		if err != nil {
+			t.Fatalf("expect no error, but got %v", err)
+		}
+
+		if response.GetValue() != task.Value {
+			t.Errorf("expect the shared task, but got %v", response)
+		}
+	})
+
+	t.Run("Roles_SubtaskOfSharedParent", func(t *testing.T) {
+		conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{MemberRepository: sharedWith(model.RoleCommenter)})))
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer conn.Close()
+
+		_, err = pbTodoList.NewTodoListServiceClient(conn).CreateTask(context.Background(), &pbTodoList.CreateTaskRequest{
+			Value:    "subtask",
+			ParentId: uuid.NewV4().String(),
+		})
+		if er, ok := status.FromError(err); !ok || er.Code() != ErrStatusPermissionDenied.Code() {
+			t.Errorf("expect %v, but got %v", ErrStatusPermissionDenied.Code(), err)
+		}
+	})
+}
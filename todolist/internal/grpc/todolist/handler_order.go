@@ -0,0 +1,76 @@
+package todolist
+
+import (
+	"context"
+
+	uuid "github.com/satori/go.uuid"
+	"go.uber.org/zap"
+
+	"github.com/overridesh/sgg-todolist-service/internal/logging"
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+	"github.com/overridesh/sgg-todolist-service/internal/repository"
+	pbTodoList "github.com/overridesh/sgg-todolist-service/proto"
+	"github.com/overridesh/sgg-todolist-service/tools"
+)
+
+func (svc *todoListGRPC) MoveTask(ctx context.Context, in *pbTodoList.MoveTaskRequest) (*pbTodoList.MoveTaskResponse, error) {
+	taskId, err := tools.GetValidUUID(in.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = svc.authorize(ctx, taskId, model.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	if (len(in.GetBeforeId()) > 0) == (len(in.GetAfterId()) > 0) {
+		return nil, ErrStatusInvalidMoveTarget.Err()
+	}
+
+	after := len(in.GetAfterId()) > 0
+
+	target := in.GetBeforeId()
+	if after {
+		target = in.GetAfterId()
+	}
+
+	targetId, err := tools.GetValidUUID(target)
+	if err != nil {
+		return nil, err
+	}
+
+	if uuid.Equal(taskId, targetId) {
+		return nil, ErrStatusMoveToItself.Err()
+	}
+
+	task, err := svc.taskRepository.MoveTask(ctx, taskId, targetId, after)
+	if err != nil {
+		if err == repository.ErrTaskNotFound {
+			return nil, ErrStatusTaskNotFound.Err()
+		}
+		if err == repository.ErrMoveTargetNotFound {
+			return nil, ErrStatusMoveTargetNotFound.Err()
+		}
+		logging.FromContext(ctx).Error("cannot move task", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	if err := tools.SetEtag(ctx, tools.FormatEtag(task.Version)); err != nil {
+		logging.FromContext(ctx).Error("cannot set etag", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	return &pbTodoList.MoveTaskResponse{
+		Task: getTaskResponse(task),
+	}, nil
+}
+
+// getPriority validate the priority of a task
+func getPriority(priority pbTodoList.TaskPriority) (int32, error) {
+	if _, ok := pbTodoList.TaskPriority_name[int32(priority)]; !ok {
+		return 0, ErrStatusInvalidPriority.Err()
+	}
+
+	return int32(priority), nil
+}
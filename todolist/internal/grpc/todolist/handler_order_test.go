@@ -0,0 +1,343 @@
+package todolist
+
+import (
+	"context"
+	"log"
+	"testing"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+	"github.com/overridesh/sgg-todolist-service/internal/repository"
+	mockRepository "github.com/overridesh/sgg-todolist-service/pkg/mock"
+	pbTodoList "github.com/overridesh/sgg-todolist-service/proto"
+	"github.com/overridesh/sgg-todolist-service/tools"
+)
+
+func TestMoveTask(t *testing.T) {
+	var (
+		id       uuid.UUID = uuid.NewV4()
+		targetId uuid.UUID = uuid.NewV4()
+	)
+
+	tests := []struct {
+		name   string
+		input  func() (*pbTodoList.MoveTaskResponse, error)
+		output *status.Status
+	}{
+		{
+			name: "MoveTask_ErrStatusInvalidMoveTargetWithoutTarget",
+			input: func() (*pbTodoList.MoveTaskResponse, error) {
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.MoveTask(context.Background(), &pbTodoList.MoveTaskRequest{
+					Id: id.String(),
+				})
+			},
+			output: ErrStatusInvalidMoveTarget,
+		},
+		{
+			name: "MoveTask_ErrStatusInvalidMoveTargetWithBothTargets",
+			input: func() (*pbTodoList.MoveTaskResponse, error) {
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.MoveTask(context.Background(), &pbTodoList.MoveTaskRequest{
+					Id:       id.String(),
+					BeforeId: targetId.String(),
+					AfterId:  targetId.String(),
+				})
+			},
+			output: ErrStatusInvalidMoveTarget,
+		},
+		{
+			name: "MoveTask_ErrGetValidUUID",
+			input: func() (*pbTodoList.MoveTaskResponse, error) {
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.MoveTask(context.Background(), &pbTodoList.MoveTaskRequest{
+					Id:      id.String(),
+					AfterId: "ASD",
+				})
+			},
+			output: tools.ErrStatusIdMustBeUUID,
+		},
+		{
+			name: "MoveTask_ErrStatusMoveToItself",
+			input: func() (*pbTodoList.MoveTaskResponse, error) {
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.MoveTask(context.Background(), &pbTodoList.MoveTaskRequest{
+					Id:       id.String(),
+					BeforeId: id.String(),
+				})
+			},
+			output: ErrStatusMoveToItself,
+		},
+		{
+			name: "MoveTask_ErrStatusMoveTargetNotFound",
+			input: func() (*pbTodoList.MoveTaskResponse, error) {
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("MoveTask", mock.Anything, id, targetId, false).Return(nil, repository.ErrMoveTargetNotFound)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.MoveTask(context.Background(), &pbTodoList.MoveTaskRequest{
+					Id:       id.String(),
+					BeforeId: targetId.String(),
+				})
+			},
+			output: ErrStatusMoveTargetNotFound,
+		},
+		{
+			name: "MoveTask_ErrStatusTaskNotFound",
+			input: func() (*pbTodoList.MoveTaskResponse, error) {
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("MoveTask", mock.Anything, id, targetId, true).Return(nil, repository.ErrTaskNotFound)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.MoveTask(context.Background(), &pbTodoList.MoveTaskRequest{
+					Id:      id.String(),
+					AfterId: targetId.String(),
+				})
+			},
+			output: ErrStatusTaskNotFound,
+		},
+		{
+			name: "MoveTask_Success",
+			input: func() (*pbTodoList.MoveTaskResponse, error) {
+				task := model.Task{
+					Id:       id,
+					Version:  2,
+					Position: 1536,
+				}
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("MoveTask", mock.Anything, id, targetId, true).Return(&task, nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				response, err := client.MoveTask(context.Background(), &pbTodoList.MoveTaskRequest{
+					Id:      id.String(),
+					AfterId: targetId.String(),
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				if response.GetTask().GetPosition() != task.Position || response.GetTask().GetEtag() != tools.FormatEtag(task.Version) {
+					t.Errorf("expect the moved task, but got %v", response)
+				}
+
+				return response, nil
+			},
+			output: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.input()
+			if err != nil {
+				if tt.output == nil {
+					t.Fatalf("expect no error, but got %v", err)
+				}
+				if er, ok := status.FromError(err); ok {
+					if er.Code() != tt.output.Code() {
+						t.Errorf("error code: expected %v, received %v", tt.output.Code(), er.Code())
+					}
+					if er.Message() != tt.output.Message() {
+						t.Errorf("error message: expected %v, received %v", tt.output.Message(), er.Message())
+					}
+				}
+			} else if tt.output != nil {
+				t.Errorf("expect error %v, but got nil", tt.output.Message())
+			}
+		})
+	}
+}
+
+func TestTaskPriority(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  func() error
+		output *status.Status
+	}{
+		{
+			name: "CreateTask_ErrStatusInvalidPriority",
+			input: func() error {
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				_, err = client.CreateTask(context.Background(), &pbTodoList.CreateTaskRequest{
+					Value:    "task",
+					Priority: pbTodoList.TaskPriority(10),
+				})
+				return err
+			},
+			output: ErrStatusInvalidPriority,
+		},
+		{
+			name: "CreateTask_SuccessWithPriority",
+			input: func() error {
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("CreateTask", mock.Anything, mock.MatchedBy(func(task model.Task) bool {
+					return task.Priority == model.TaskPriorityUrgent
+				})).Return(&model.Task{Id: uuid.NewV4(), Priority: model.TaskPriorityUrgent}, nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				response, err := client.CreateTask(context.Background(), &pbTodoList.CreateTaskRequest{
+					Value:    "task",
+					Priority: pbTodoList.TaskPriority_TASK_PRIORITY_URGENT,
+				})
+				if err != nil {
+					return err
+				}
+
+				if response.GetTask().GetPriority() != pbTodoList.TaskPriority_TASK_PRIORITY_URGENT {
+					t.Errorf("expect an urgent task, but got %v", response)
+				}
+
+				return nil
+			},
+			output: nil,
+		},
+		{
+			name: "UpdateTask_SuccessWithPriorityMask",
+			input: func() error {
+				task := model.Task{
+					Id:       uuid.NewV4(),
+					Value:    "task",
+					Priority: model.TaskPriorityLow,
+				}
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTask", mock.Anything, task.Id).Return(&task, nil)
+				taskRepository.On("UpdateTask", mock.Anything, mock.MatchedBy(func(updated *model.Task) bool {
+					return updated.Priority == model.TaskPriorityHigh && updated.Value == "task"
+				}), model.TaskUpdate{Fields: []string{model.TaskFieldPriority}}).Return(nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				_, err = client.UpdateTask(context.Background(), &pbTodoList.UpdateTaskRequest{
+					Id:         task.Id.String(),
+					Value:      "ignored",
+					Priority:   pbTodoList.TaskPriority_TASK_PRIORITY_HIGH,
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{model.TaskFieldPriority}},
+				})
+				return err
+			},
+			output: nil,
+		},
+		{
+			name: "GetTasks_SortByPriority",
+			input: func() error {
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTasks", mock.Anything, mock.MatchedBy(func(filter model.TaskFilter) bool {
+					return filter.SortBy == model.TaskSortByPriority && filter.SortDirection == model.SortDirectionDesc
+				})).Return([]*model.Task{}, nil)
+				taskRepository.On("CountTasks", mock.Anything, mock.Anything).Return(int64(0), nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				_, err = client.GetTasks(context.Background(), &pbTodoList.GetTasksRequest{
+					SortBy:        pbTodoList.TaskSortField_TASK_SORT_FIELD_PRIORITY,
+					SortDirection: pbTodoList.SortDirection_SORT_DIRECTION_DESC,
+				})
+				return err
+			},
+			output: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.input()
+			if err != nil {
+				if tt.output == nil {
+					t.Fatalf("expect no error, but got %v", err)
+				}
+				if er, ok := status.FromError(err); ok {
+					if er.Code() != tt.output.Code() {
+						t.Errorf("error code: expected %v, received %v", tt.output.Code(), er.Code())
+					}
+					if er.Message() != tt.output.Message() {
+						t.Errorf("error message: expected %v, received %v", tt.output.Message(), er.Message())
+					}
+				}
+			} else if tt.output != nil {
+				t.Errorf("expect error %v, but got nil", tt.output.Message())
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package todolist
+
+import (
+	"context"
+	"database/sql"
+
+	"go.uber.org/zap"
+
+	"github.com/overridesh/sgg-todolist-service/internal/logging"
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+	"github.com/overridesh/sgg-todolist-service/internal/repository"
+	pbTodoList "github.com/overridesh/sgg-todolist-service/proto"
+	"github.com/overridesh/sgg-todolist-service/tools"
+)
+
+const (
+	defaultOccurrences int32 = 10
+	maxOccurrences     int32 = 100
+)
+
+func (svc *todoListGRPC) GetTaskOccurrences(ctx context.Context, in *pbTodoList.GetTaskOccurrencesRequest) (*pbTodoList.GetTaskOccurrencesResponse, error) {
+	taskId, err := tools.GetValidUUID(in.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = svc.authorize(ctx, taskId, model.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+
+	count := in.GetCount()
+	if count < 0 || count > maxOccurrences {
+		return nil, ErrStatusInvalidOccurrences.Err()
+	}
+	if count == 0 {
+		count = defaultOccurrences
+	}
+
+	task, err := svc.taskRepository.GetTask(ctx, taskId)
+	if err != nil {
+		if err == repository.ErrTaskNotFound {
+			return nil, ErrStatusTaskNotFound.Err()
+		}
+		logging.FromContext(ctx).Error("cannot get task", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	if len(task.Recurrence) == 0 || !task.DueDate.Valid {
+		return nil, ErrStatusTaskNotRecurring.Err()
+	}
+
+	occurrences, err := tools.NextOccurrences(task.Recurrence, task.DueDate.Time, int(count))
+	if err != nil {
+		logging.FromContext(ctx).Error("cannot get task occurrences", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	response := pbTodoList.GetTaskOccurrencesResponse{
+		DueDates: []string{},
+	}
+
+	for _, occurrence := range occurrences {
+		response.DueDates = append(response.DueDates, tools.FormatDate(occurrence))
+	}
+
+	return &response, nil
+}
+
+// getRecurrence validate the recurrence rule of a task, the series starts at the due date.
+// An empty rule means the task does not repeat.
+func getRecurrence(rule string, dueDate sql.NullTime) (string, error) {
+	if len(rule) == 0 {
+		return "", nil
+	}
+
+	if !dueDate.Valid {
+		return "", ErrStatusRecurrenceNeedsDueDate.Err()
+	}
+
+	recurrence, err := tools.ParseRecurrence(rule, dueDate.Time)
+	if err != nil {
+		return "", ErrStatusInvalidRecurrence.Err()
+	}
+
+	return recurrence, nil
+}
+
+// getNextOccurrence build the next occurrence of a recurring task that has just been completed,
+// nil when the task does not repeat or its series has ended
+func getNextOccurrence(ctx context.Context, task *model.Task, wasCompleted bool) (*model.Task, error) {
+	if wasCompleted || !task.Completed || len(task.Recurrence) == 0 || !task.DueDate.Valid {
+		return nil, nil
+	}
+
+	occurrences, err := tools.NextOccurrences(task.Recurrence, task.DueDate.Time, 1)
+	if err != nil {
+		logging.FromContext(ctx).Error("cannot get next occurrence", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	if len(occurrences) == 0 {
+		return nil, nil
+	}
+
+	return &model.Task{
+		Value:      task.Value,
+		DueDate:    sql.NullTime{Time: occurrences[0], Valid: true},
+		ParentId:   task.ParentId,
+		Priority:   task.Priority,
+		Recurrence: task.Recurrence,
+	}, nil
+}
+
+// moveRecurrence hand over the recurrence of a completed task to its next occurrence, so completing it
+// again after reopening it does not repeat it twice
+func moveRecurrence(task *model.Task, fields []string) []string {
+	task.Recurrence = ""
+
+	for _, field := range fields {
+		if field == model.TaskFieldRecurrence {
+			return fields
+		}
+	}
+
+	// fields can be model.TaskUpdatableFields, never append to it in place
+	return append(fields[:len(fields):len(fields)], model.TaskFieldRecurrence)
+}
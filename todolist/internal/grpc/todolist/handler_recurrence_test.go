@@ -0,0 +1,401 @@
+package todolist
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+	mockRepository "github.com/overridesh/sgg-todolist-service/pkg/mock"
+	pbTodoList "github.com/overridesh/sgg-todolist-service/proto"
+	"github.com/overridesh/sgg-todolist-service/tools"
+)
+
+const weeklyRecurrence string = "DTSTART:20220404T093000Z\nRRULE:FREQ=WEEKLY;COUNT=3"
+
+func TestCreateRecurringTask(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  func() (*pbTodoList.CreateTaskResponse, error)
+		output *status.Status
+	}{
+		{
+			name: "CreateTask_ErrStatusRecurrenceNeedsDueDate",
+			input: func() (*pbTodoList.CreateTaskResponse, error) {
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.CreateTask(context.Background(), &pbTodoList.CreateTaskRequest{
+					Value:      "weekly chores",
+					Recurrence: "FREQ=WEEKLY",
+				})
+			},
+			output: ErrStatusRecurrenceNeedsDueDate,
+		},
+		{
+			name: "CreateTask_ErrStatusInvalidRecurrence",
+			input: func() (*pbTodoList.CreateTaskResponse, error) {
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.CreateTask(context.Background(), &pbTodoList.CreateTaskRequest{
+					Value:      "weekly chores",
+					DueDate:    "2022-04-04T09:30:00.000Z",
+					Recurrence: "EVERY=MONDAY",
+				})
+			},
+			output: ErrStatusInvalidRecurrence,
+		},
+		{
+			name: "CreateTask_SuccessWithRecurrence",
+			input: func() (*pbTodoList.CreateTaskResponse, error) {
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("CreateTask", mock.Anything, mock.MatchedBy(func(task model.Task) bool {
+					return task.Recurrence == "DTSTART:20220404T093000Z\nRRULE:FREQ=WEEKLY;BYDAY=MO"
+				})).Return(&model.Task{Id: uuid.NewV4()}, nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.CreateTask(context.Background(), &pbTodoList.CreateTaskRequest{
+					Value:      "weekly chores",
+					DueDate:    "2022-04-04T09:30:00.000Z",
+					Recurrence: "FREQ=WEEKLY;BYDAY=MO",
+				})
+			},
+			output: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.input()
+			if err != nil {
+				if tt.output == nil {
+					t.Fatalf("expect no error, but got %v", err)
+				}
+				if er, ok := status.FromError(err); ok {
+					if er.Code() != tt.output.Code() {
+						t.Errorf("error code: expected %v, received %v", tt.output.Code(), er.Code())
+					}
+					if er.Message() != tt.output.Message() {
+						t.Errorf("error message: expected %v, received %v", tt.output.Message(), er.Message())
+					}
+				}
+			} else if tt.output != nil {
+				t.Errorf("expect error %v, but got nil", tt.output.Message())
+			}
+		})
+	}
+}
+
+func TestCompleteRecurringTask(t *testing.T) {
+	newRecurringTask := func(dueDate time.Time) model.Task {
+		return model.Task{
+			Id:         uuid.NewV4(),
+			Value:      "weekly chores",
+			DueDate:    sql.NullTime{Time: dueDate, Valid: true},
+			Priority:   model.TaskPriorityLow,
+			Recurrence: weeklyRecurrence,
+			Version:    1,
+		}
+	}
+
+	isNextOccurrence := func(dueDate time.Time, fields ...string) interface{} {
+		return mock.MatchedBy(func(update model.TaskUpdate) bool {
+			next := update.NextOccurrence
+			return next != nil && next.DueDate.Valid && next.DueDate.Time.Equal(dueDate) &&
+				next.Recurrence == weeklyRecurrence && next.Priority == model.TaskPriorityLow && !next.Completed &&
+				reflect.DeepEqual(update.Fields, fields)
+		})
+	}
+
+	tests := []struct {
+		name   string
+		input  func() error
+		output *status.Status
+	}{
+		{
+			name: "UpdateTaskStatus_SuccessCreatesNextOccurrence",
+			input: func() error {
+				task := newRecurringTask(time.Date(2022, time.April, 4, 9, 30, 0, 0, time.UTC))
+				next := time.Date(2022, time.April, 11, 9, 30, 0, 0, time.UTC)
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTask", mock.Anything, task.Id).Return(&task, nil)
+				taskRepository.On("UpdateTask", mock.Anything, mock.MatchedBy(func(updated *model.Task) bool {
+					return updated.Completed && updated.Recurrence == ""
+				}), isNextOccurrence(next, model.TaskFieldCompleted, model.TaskFieldRecurrence)).Return(nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				_, err = client.UpdateTaskStatus(context.Background(), &pbTodoList.UpdateTaskStatusRequest{
+					Id:        task.Id.String(),
+					Completed: true,
+				})
+				if err != nil {
+					return err
+				}
+
+				taskRepository.AssertExpectations(t)
+				return nil
+			},
+			output: nil,
+		},
+		{
+			name: "UpdateTaskStatus_SuccessSeriesEnded",
+			input: func() error {
+				task := newRecurringTask(time.Date(2022, time.April, 18, 9, 30, 0, 0, time.UTC))
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTask", mock.Anything, task.Id).Return(&task, nil)
+				taskRepository.On("UpdateTask", mock.Anything, mock.Anything, model.TaskUpdate{Fields: []string{model.TaskFieldCompleted}}).Return(nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				_, err = client.UpdateTaskStatus(context.Background(), &pbTodoList.UpdateTaskStatusRequest{
+					Id:        task.Id.String(),
+					Completed: true,
+				})
+				return err
+			},
+			output: nil,
+		},
+		{
+			name: "UpdateTaskStatus_ErrStatusInternalServerErrorKeepsSeries",
+			input: func() error {
+				task := newRecurringTask(time.Date(2022, time.April, 4, 9, 30, 0, 0, time.UTC))
+				next := time.Date(2022, time.April, 11, 9, 30, 0, 0, time.UTC)
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTask", mock.Anything, task.Id).Return(&task, nil)
+				taskRepository.On("UpdateTask", mock.Anything, mock.Anything, isNextOccurrence(next, model.TaskFieldCompleted, model.TaskFieldRecurrence)).Return(sql.ErrConnDone)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				_, err = client.UpdateTaskStatus(context.Background(), &pbTodoList.UpdateTaskStatusRequest{
+					Id:        task.Id.String(),
+					Completed: true,
+				})
+				return err
+			},
+			output: ErrStatusInternalServerError,
+		},
+		{
+			name: "UpdateTask_SuccessReturnsNextOccurrence",
+			input: func() error {
+				task := newRecurringTask(time.Date(2022, time.April, 4, 9, 30, 0, 0, time.UTC))
+				next := model.Task{
+					Id:      uuid.NewV4(),
+					DueDate: sql.NullTime{Time: time.Date(2022, time.April, 11, 9, 30, 0, 0, time.UTC), Valid: true},
+				}
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTask", mock.Anything, task.Id).Return(&task, nil)
+				taskRepository.On("UpdateTask", mock.Anything, mock.Anything, isNextOccurrence(next.DueDate.Time, model.TaskFieldValue, model.TaskFieldCompleted, model.TaskFieldDueDate, model.TaskFieldRecurrence)).
+					Run(func(args mock.Arguments) {
+						args.Get(2).(model.TaskUpdate).NextOccurrence.Id = next.Id
+					}).
+					Return(nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				response, err := client.UpdateTask(context.Background(), &pbTodoList.UpdateTaskRequest{
+					Id:        task.Id.String(),
+					Value:     task.Value,
+					Completed: true,
+					DueDate:   tools.FormatDate(task.DueDate.Time),
+				})
+				if err != nil {
+					return err
+				}
+
+				if response.GetNextOccurrence().GetId() != next.Id.String() || len(response.GetTask().GetRecurrence()) > 0 {
+					t.Errorf("expect the next occurrence to carry the recurrence, but got %v", response)
+				}
+
+				// The default update fields must not be modified by the completion of recurring tasks
+				if len(model.TaskUpdatableFields) != 3 {
+					t.Errorf("expect default update fields to be untouched, but got %v", model.TaskUpdatableFields)
+				}
+
+				return nil
+			},
+			output: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.input()
+			if err != nil {
+				if tt.output == nil {
+					t.Fatalf("expect no error, but got %v", err)
+				}
+				if er, ok := status.FromError(err); ok {
+					if er.Code() != tt.output.Code() {
+						t.Errorf("error code: expected %v, received %v", tt.output.Code(), er.Code())
+					}
+					if er.Message() != tt.output.Message() {
+						t.Errorf("error message: expected %v, received %v", tt.output.Message(), er.Message())
+					}
+				}
+			} else if tt.output != nil {
+				t.Errorf("expect error %v, but got nil", tt.output.Message())
+			}
+		})
+	}
+}
+
+func TestGetTaskOccurrences(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  func() (*pbTodoList.GetTaskOccurrencesResponse, error)
+		output *status.Status
+	}{
+		{
+			name: "GetTaskOccurrences_ErrStatusInvalidOccurrences",
+			input: func() (*pbTodoList.GetTaskOccurrencesResponse, error) {
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.GetTaskOccurrences(context.Background(), &pbTodoList.GetTaskOccurrencesRequest{
+					Id:    uuid.NewV4().String(),
+					Count: 1000,
+				})
+			},
+			output: ErrStatusInvalidOccurrences,
+		},
+		{
+			name: "GetTaskOccurrences_ErrStatusTaskNotRecurring",
+			input: func() (*pbTodoList.GetTaskOccurrencesResponse, error) {
+				task := model.Task{Id: uuid.NewV4()}
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTask", mock.Anything, task.Id).Return(&task, nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.GetTaskOccurrences(context.Background(), &pbTodoList.GetTaskOccurrencesRequest{
+					Id: task.Id.String(),
+				})
+			},
+			output: ErrStatusTaskNotRecurring,
+		},
+		{
+			name: "GetTaskOccurrences_Success",
+			input: func() (*pbTodoList.GetTaskOccurrencesResponse, error) {
+				task := model.Task{
+					Id:         uuid.NewV4(),
+					DueDate:    sql.NullTime{Time: time.Date(2022, time.April, 4, 9, 30, 0, 0, time.UTC), Valid: true},
+					Recurrence: weeklyRecurrence,
+				}
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTask", mock.Anything, task.Id).Return(&task, nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				response, err := client.GetTaskOccurrences(context.Background(), &pbTodoList.GetTaskOccurrencesRequest{
+					Id: task.Id.String(),
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				if strings.Join(response.GetDueDates(), ",") != "2022-04-11T09:30:00.000Z,2022-04-18T09:30:00.000Z" {
+					t.Errorf("expect the two remaining occurrences, but got %v", response.GetDueDates())
+				}
+
+				return response, nil
+			},
+			output: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.input()
+			if err != nil {
+				if tt.output == nil {
+					t.Fatalf("expect no error, but got %v", err)
+				}
+				if er, ok := status.FromError(err); ok {
+					if er.Code() != tt.output.Code() {
+						t.Errorf("error code: expected %v, received %v", tt.output.Code(), er.Code())
+					}
+					if er.Message() != tt.output.Message() {
+						t.Errorf("error message: expected %v, received %v", tt.output.Message(), er.Message())
+					}
+				}
+			} else if tt.output != nil {
+				t.Errorf("expect error %v, but got nil", tt.output.Message())
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package todolist
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/overridesh/sgg-todolist-service/internal/logging"
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+	"github.com/overridesh/sgg-todolist-service/internal/repository"
+	pbTodoList "github.com/overridesh/sgg-todolist-service/proto"
+	"github.com/overridesh/sgg-todolist-service/tools"
+)
+
+func (svc *todoListGRPC) ListReminders(ctx context.Context, in *pbTodoList.ListRemindersRequest) (*pbTodoList.ListRemindersResponse, error) {
+	taskId, err := tools.GetValidUUID(in.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = svc.authorize(ctx, taskId, model.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := svc.taskRepository.GetTask(ctx, taskId)
+	if err != nil {
+		if err == repository.ErrTaskNotFound {
+			return nil, ErrStatusTaskNotFound.Err()
+		}
+		logging.FromContext(ctx).Error("cannot get task", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	reminders, err := svc.reminderRepository.GetRemindersByTaskId(ctx, task.Id)
+	if err != nil {
+		logging.FromContext(ctx).Error("cannot get reminders", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	response := pbTodoList.ListRemindersResponse{
+		Reminders: []*pbTodoList.Reminder{},
+	}
+
+	for _, reminder := range reminders {
+		response.Reminders = append(response.Reminders, getReminderResponse(reminder))
+	}
+
+	return &response, nil
+}
+
+func (svc *todoListGRPC) CreateReminder(ctx context.Context, in *pbTodoList.CreateReminderRequest) (*pbTodoList.CreateReminderResponse, error) {
+	taskId, err := tools.GetValidUUID(in.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = svc.authorize(ctx, taskId, model.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := svc.taskRepository.GetTask(ctx, taskId)
+	if err != nil {
+		if err == repository.ErrTaskNotFound {
+			return nil, ErrStatusTaskNotFound.Err()
+		}
+		logging.FromContext(ctx).Error("cannot get task", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	var remindAt time.Time
+
+	if len(strings.TrimSpace(in.GetRemindAt())) > 0 {
+		if remindAt, err = time.Parse(tools.TimeLayout, in.GetRemindAt()); err != nil {
+			logging.FromContext(ctx).Error("cannot parse timelayout", zap.Error(err))
+			return nil, ErrStatusCannotParseTimeLayout.Err()
+		}
+	} else if task.DueDate.Valid {
+		remindAt = task.DueDate.Time
+	} else {
+		return nil, ErrStatusReminderNeedsTime.Err()
+	}
+
+	reminder, err := svc.reminderRepository.CreateReminder(ctx, model.Reminder{
+		TaskId:   task.Id,
+		RemindAt: remindAt,
+	})
+	if err != nil {
+		logging.FromContext(ctx).Error("cannot create reminder", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	if err := tools.SetStatusCode(ctx, http.StatusCreated); err != nil {
+		logging.FromContext(ctx).Error("cannot set new status_code", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	return &pbTodoList.CreateReminderResponse{
+		Reminder: getReminderResponse(reminder),
+	}, nil
+}
+
+func (svc *todoListGRPC) DeleteReminder(ctx context.Context, in *pbTodoList.DeleteReminderRequest) (*emptypb.Empty, error) {
+	taskId, err := tools.GetValidUUID(in.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = svc.authorize(ctx, taskId, model.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	reminderId, err := tools.GetValidUUID(in.GetReminderId())
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := svc.taskRepository.GetTask(ctx, taskId)
+	if err != nil {
+		if err == repository.ErrTaskNotFound {
+			return nil, ErrStatusTaskNotFound.Err()
+		}
+		logging.FromContext(ctx).Error("cannot get task", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	if err := svc.reminderRepository.DeleteReminder(ctx, task.Id, reminderId); err != nil {
+		if err == repository.ErrReminderNotFound {
+			return nil, ErrStatusReminderNotFound.Err()
+		}
+		logging.FromContext(ctx).Error("cannot delete reminder", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	if err := tools.SetStatusCode(ctx, http.StatusNoContent); err != nil {
+		logging.FromContext(ctx).Error("cannot set new status_code", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// getReminderResponse map a reminder from the repository into the protobuf reminder
+func getReminderResponse(reminder *model.Reminder) *pbTodoList.Reminder {
+	response := pbTodoList.Reminder{
+		Id:        reminder.Id.String(),
+		TaskId:    reminder.TaskId.String(),
+		RemindAt:  tools.FormatDate(reminder.RemindAt),
+		CreatedAt: tools.FormatDate(reminder.CreatedAt),
+	}
+
+	if reminder.SentAt.Valid {
+		response.SentAt = tools.FormatDate(reminder.SentAt.Time)
+	}
+
+	return &response
+}
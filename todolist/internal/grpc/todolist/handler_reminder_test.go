@@ -0,0 +1,388 @@
+package todolist
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"testing"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+	"github.com/overridesh/sgg-todolist-service/internal/repository"
+	mockRepository "github.com/overridesh/sgg-todolist-service/pkg/mock"
+	pbTodoList "github.com/overridesh/sgg-todolist-service/proto"
+	"github.com/overridesh/sgg-todolist-service/tools"
+)
+
+func TestListReminders(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  func() (*pbTodoList.ListRemindersResponse, error)
+		output *status.Status
+	}{
+		{
+			name: "ListReminders_ErrStatusTaskNotFound",
+			input: func() (*pbTodoList.ListRemindersResponse, error) {
+				id := uuid.NewV4()
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTask", mock.Anything, id).Return(nil, repository.ErrTaskNotFound)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.ListReminders(context.Background(), &pbTodoList.ListRemindersRequest{
+					Id: id.String(),
+				})
+			},
+			output: ErrStatusTaskNotFound,
+		},
+		{
+			name: "ListReminders_ErrStatusInternalServerError",
+			input: func() (*pbTodoList.ListRemindersResponse, error) {
+				task := model.Task{Id: uuid.NewV4()}
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTask", mock.Anything, task.Id).Return(&task, nil)
+
+				reminderRepository := new(mockRepository.ReminderRepository)
+				reminderRepository.On("GetRemindersByTaskId", mock.Anything, task.Id).Return(nil, errors.New("unknown_error"))
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository, ReminderRepository: reminderRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.ListReminders(context.Background(), &pbTodoList.ListRemindersRequest{
+					Id: task.Id.String(),
+				})
+			},
+			output: ErrStatusInternalServerError,
+		},
+		{
+			name: "ListReminders_Success",
+			input: func() (*pbTodoList.ListRemindersResponse, error) {
+				task := model.Task{Id: uuid.NewV4()}
+				sent := model.Reminder{
+					Id:       uuid.NewV4(),
+					TaskId:   task.Id,
+					RemindAt: time.Now(),
+					SentAt:   sql.NullTime{Time: time.Now(), Valid: true},
+				}
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTask", mock.Anything, task.Id).Return(&task, nil)
+
+				reminderRepository := new(mockRepository.ReminderRepository)
+				reminderRepository.On("GetRemindersByTaskId", mock.Anything, task.Id).Return([]*model.Reminder{&sent}, nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository, ReminderRepository: reminderRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				response, err := client.ListReminders(context.Background(), &pbTodoList.ListRemindersRequest{
+					Id: task.Id.String(),
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				if len(response.GetReminders()) != 1 || response.GetReminders()[0].GetSentAt() != tools.FormatDate(sent.SentAt.Time) {
+					t.Errorf("expect the sent reminder, but got %v", response)
+				}
+
+				return response, nil
+			},
+			output: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.input()
+			if err != nil {
+				if tt.output == nil {
+					t.Fatalf("expect no error, but got %v", err)
+				}
+				if er, ok := status.FromError(err); ok {
+					if er.Code() != tt.output.Code() {
+						t.Errorf("error code: expected %v, received %v", tt.output.Code(), er.Code())
+					}
+					if er.Message() != tt.output.Message() {
+						t.Errorf("error message: expected %v, received %v", tt.output.Message(), er.Message())
+					}
+				}
+			} else if tt.output != nil {
+				t.Errorf("expect error %v, but got nil", tt.output.Message())
+			}
+		})
+	}
+}
+
+func TestCreateReminder(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  func() (*pbTodoList.CreateReminderResponse, error)
+		output *status.Status
+	}{
+		{
+			name: "CreateReminder_ErrStatusCannotParseTimeLayout",
+			input: func() (*pbTodoList.CreateReminderResponse, error) {
+				task := model.Task{Id: uuid.NewV4()}
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTask", mock.Anything, task.Id).Return(&task, nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.CreateReminder(context.Background(), &pbTodoList.CreateReminderRequest{
+					Id:       task.Id.String(),
+					RemindAt: "tomorrow",
+				})
+			},
+			output: ErrStatusCannotParseTimeLayout,
+		},
+		{
+			name: "CreateReminder_ErrStatusReminderNeedsTime",
+			input: func() (*pbTodoList.CreateReminderResponse, error) {
+				task := model.Task{Id: uuid.NewV4()}
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTask", mock.Anything, task.Id).Return(&task, nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.CreateReminder(context.Background(), &pbTodoList.CreateReminderRequest{
+					Id: task.Id.String(),
+				})
+			},
+			output: ErrStatusReminderNeedsTime,
+		},
+		{
+			name: "CreateReminder_SuccessAtDueDate",
+			input: func() (*pbTodoList.CreateReminderResponse, error) {
+				task := model.Task{
+					Id:      uuid.NewV4(),
+					DueDate: sql.NullTime{Time: time.Date(2022, time.April, 4, 9, 0, 0, 0, time.UTC), Valid: true},
+				}
+				reminder := model.Reminder{
+					Id:       uuid.NewV4(),
+					TaskId:   task.Id,
+					RemindAt: task.DueDate.Time,
+				}
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTask", mock.Anything, task.Id).Return(&task, nil)
+
+				reminderRepository := new(mockRepository.ReminderRepository)
+				reminderRepository.On("CreateReminder", mock.Anything, model.Reminder{TaskId: task.Id, RemindAt: task.DueDate.Time}).Return(&reminder, nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository, ReminderRepository: reminderRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				response, err := client.CreateReminder(context.Background(), &pbTodoList.CreateReminderRequest{
+					Id: task.Id.String(),
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				if response.GetReminder().GetRemindAt() != "2022-04-04T09:00:00.000Z" {
+					t.Errorf("expect a reminder at the due date, but got %v", response)
+				}
+
+				return response, nil
+			},
+			output: nil,
+		},
+		{
+			name: "CreateReminder_Success",
+			input: func() (*pbTodoList.CreateReminderResponse, error) {
+				task := model.Task{Id: uuid.NewV4()}
+				remindAt := time.Date(2022, time.April, 3, 18, 0, 0, 0, time.UTC)
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTask", mock.Anything, task.Id).Return(&task, nil)
+
+				reminderRepository := new(mockRepository.ReminderRepository)
+				reminderRepository.On("CreateReminder", mock.Anything, model.Reminder{TaskId: task.Id, RemindAt: remindAt}).
+					Return(&model.Reminder{Id: uuid.NewV4(), TaskId: task.Id, RemindAt: remindAt}, nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository, ReminderRepository: reminderRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.CreateReminder(context.Background(), &pbTodoList.CreateReminderRequest{
+					Id:       task.Id.String(),
+					RemindAt: tools.FormatDate(remindAt),
+				})
+			},
+			output: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.input()
+			if err != nil {
+				if tt.output == nil {
+					t.Fatalf("expect no error, but got %v", err)
+				}
+				if er, ok := status.FromError(err); ok {
+					if er.Code() != tt.output.Code() {
+						t.Errorf("error code: expected %v, received %v", tt.output.Code(), er.Code())
+					}
+					if er.Message() != tt.output.Message() {
+						t.Errorf("error message: expected %v, received %v", tt.output.Message(), er.Message())
+					}
+				}
+			} else if tt.output != nil {
+				t.Errorf("expect error %v, but got nil", tt.output.Message())
+			}
+		})
+	}
+}
+
+func TestDeleteReminder(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  func() error
+		output *status.Status
+	}{
+		{
+			name: "DeleteReminder_ErrGetValidUUID",
+			input: func() error {
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				_, err = client.DeleteReminder(context.Background(), &pbTodoList.DeleteReminderRequest{
+					Id:         uuid.NewV4().String(),
+					ReminderId: "ASD",
+				})
+				return err
+			},
+			output: tools.ErrStatusIdMustBeUUID,
+		},
+		{
+			name: "DeleteReminder_ErrStatusReminderNotFound",
+			input: func() error {
+				task := model.Task{Id: uuid.NewV4()}
+				reminderId := uuid.NewV4()
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTask", mock.Anything, task.Id).Return(&task, nil)
+
+				reminderRepository := new(mockRepository.ReminderRepository)
+				reminderRepository.On("DeleteReminder", mock.Anything, task.Id, reminderId).Return(repository.ErrReminderNotFound)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository, ReminderRepository: reminderRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				_, err = client.DeleteReminder(context.Background(), &pbTodoList.DeleteReminderRequest{
+					Id:         task.Id.String(),
+					ReminderId: reminderId.String(),
+				})
+				return err
+			},
+			output: ErrStatusReminderNotFound,
+		},
+		{
+			name: "DeleteReminder_Success",
+			input: func() error {
+				task := model.Task{Id: uuid.NewV4()}
+				reminderId := uuid.NewV4()
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTask", mock.Anything, task.Id).Return(&task, nil)
+
+				reminderRepository := new(mockRepository.ReminderRepository)
+				reminderRepository.On("DeleteReminder", mock.Anything, task.Id, reminderId).Return(nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository, ReminderRepository: reminderRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				_, err = client.DeleteReminder(context.Background(), &pbTodoList.DeleteReminderRequest{
+					Id:         task.Id.String(),
+					ReminderId: reminderId.String(),
+				})
+				return err
+			},
+			output: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.input()
+			if err != nil {
+				if tt.output == nil {
+					t.Fatalf("expect no error, but got %v", err)
+				}
+				if er, ok := status.FromError(err); ok {
+					if er.Code() != tt.output.Code() {
+						t.Errorf("error code: expected %v, received %v", tt.output.Code(), er.Code())
+					}
+					if er.Message() != tt.output.Message() {
+						t.Errorf("error message: expected %v, received %v", tt.output.Message(), er.Message())
+					}
+				}
+			} else if tt.output != nil {
+				t.Errorf("expect error %v, but got nil", tt.output.Message())
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package todolist
+
+import (
+	"context"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/overridesh/sgg-todolist-service/internal/logging"
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+	pbTodoList "github.com/overridesh/sgg-todolist-service/proto"
+)
+
+var (
+	matchSources map[string]pbTodoList.MatchSource = map[string]pbTodoList.MatchSource{
+		model.SearchSourceTask:    pbTodoList.MatchSource_MATCH_SOURCE_TASK,
+		model.SearchSourceComment: pbTodoList.MatchSource_MATCH_SOURCE_COMMENT,
+		model.SearchSourceLabel:   pbTodoList.MatchSource_MATCH_SOURCE_LABEL,
+	}
+)
+
+func (svc *todoListGRPC) SearchTasks(ctx context.Context, in *pbTodoList.SearchTasksRequest) (*pbTodoList.SearchTasksResponse, error) {
+	query := strings.TrimSpace(in.GetQ())
+	if len(query) == 0 {
+		return nil, ErrStatusSearchQueryRequired.Err()
+	}
+
+	if in.GetPageSize() < 0 || in.GetPageSize() > maxPageSize {
+		return nil, ErrStatusInvalidPageSize.Err()
+	}
+
+	results, err := svc.taskRepository.SearchTasks(ctx, model.TaskSearch{
+		Query:    query,
+		Page:     in.GetPage(),
+		PageSize: uint64(in.GetPageSize()),
+	})
+	if err != nil {
+		logging.FromContext(ctx).Error("cannot search tasks", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	response := pbTodoList.SearchTasksResponse{}
+	for _, result := range results {
+		searchResult := pbTodoList.SearchResult{
+			Task: getTaskResponse(&result.Task),
+			Rank: result.Rank,
+		}
+
+		for _, match := range result.Matches {
+			searchResult.Matches = append(searchResult.Matches, &pbTodoList.SearchMatch{
+				Source:  matchSources[match.Source],
+				Snippet: match.Snippet,
+			})
+		}
+
+		response.Results = append(response.Results, &searchResult)
+	}
+
+	return &response, nil
+}
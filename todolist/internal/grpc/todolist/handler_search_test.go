@@ -0,0 +1,126 @@
+package todolist
+
+import (
+	"context"
+	"errors"
+	"log"
+	"testing"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+	mockRepository "github.com/overridesh/sgg-todolist-service/pkg/mock"
+	pbTodoList "github.com/overridesh/sgg-todolist-service/proto"
+)
+
+func TestSearchTasks(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  func() (*pbTodoList.SearchTasksResponse, error)
+		output *status.Status
+	}{
+		{
+			name: "SearchTasks_Success",
+			input: func() (*pbTodoList.SearchTasksResponse, error) {
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("SearchTasks", mock.Anything, model.TaskSearch{
+					Query: "report",
+					Page:  1,
+				}).Return([]*model.TaskSearchResult{{
+					Task: model.Task{
+						Id: uuid.NewV4(),
+					},
+					Rank: 0.5,
+					Matches: []*model.SearchMatch{{
+						Source:  model.SearchSourceComment,
+						Snippet: "monthly <mark>report</mark>",
+					}},
+				}}, nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				response, err := client.SearchTasks(context.Background(), &pbTodoList.SearchTasksRequest{
+					Q:    " report ",
+					Page: 1,
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				if len(response.GetResults()) != 1 || response.GetResults()[0].GetMatches()[0].GetSource() != pbTodoList.MatchSource_MATCH_SOURCE_COMMENT {
+					t.Errorf("expect one result matched by comment, but got %v", response)
+				}
+
+				return response, nil
+			},
+			output: nil,
+		},
+		{
+			name: "SearchTasks_ErrStatusSearchQueryRequired",
+			input: func() (*pbTodoList.SearchTasksResponse, error) {
+				taskRepository := new(mockRepository.TaskRepository)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.SearchTasks(context.Background(), &pbTodoList.SearchTasksRequest{
+					Q: "  ",
+				})
+			},
+			output: ErrStatusSearchQueryRequired,
+		},
+		{
+			name: "SearchTasks_ErrStatusInternalServerError",
+			input: func() (*pbTodoList.SearchTasksResponse, error) {
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("SearchTasks", mock.Anything, model.TaskSearch{
+					Query: "report",
+				}).Return(nil, errors.New("unknow_error"))
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.SearchTasks(context.Background(), &pbTodoList.SearchTasksRequest{
+					Q: "report",
+				})
+			},
+			output: ErrStatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.input()
+			if err != nil {
+				if er, ok := status.FromError(err); ok {
+					if er.Code() != tt.output.Code() {
+						t.Errorf("error code: expected %v, received %v", codes.InvalidArgument, er.Code())
+					}
+					if er.Message() != tt.output.Message() {
+						t.Errorf("error message: expected %v, received %v", tt.output.Message(), er.Message())
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package todolist
+
+import (
+	"context"
+
+	uuid "github.com/satori/go.uuid"
+	"go.uber.org/zap"
+
+	"github.com/overridesh/sgg-todolist-service/internal/logging"
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+	"github.com/overridesh/sgg-todolist-service/internal/repository"
+	pbTodoList "github.com/overridesh/sgg-todolist-service/proto"
+	"github.com/overridesh/sgg-todolist-service/tools"
+)
+
+func (svc *todoListGRPC) GetSubtasks(ctx context.Context, in *pbTodoList.GetSubtasksRequest) (*pbTodoList.GetSubtasksResponse, error) {
+	taskId, err := tools.GetValidUUID(in.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = svc.authorize(ctx, taskId, model.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := svc.taskRepository.GetTask(ctx, taskId)
+	if err != nil {
+		if err == repository.ErrTaskNotFound {
+			return nil, ErrStatusTaskNotFound.Err()
+		}
+		logging.FromContext(ctx).Error("cannot get task", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	subtasks, err := svc.taskRepository.GetSubtasks(ctx, task.Id)
+	if err != nil {
+		logging.FromContext(ctx).Error("cannot get subtasks", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	response := pbTodoList.GetSubtasksResponse{
+		Subtasks: []*pbTodoList.Task{},
+	}
+
+	for _, subtask := range subtasks {
+		response.Subtasks = append(response.Subtasks, getTaskResponse(subtask))
+	}
+
+	return &response, nil
+}
+
+// getParentId validate the parent of a task, task is nil when it is a new one.
+// An empty parent id means the task has no parent.
+func (svc *todoListGRPC) getParentId(ctx context.Context, task *model.Task, parent string) (uuid.NullUUID, error) {
+	if len(parent) == 0 {
+		return uuid.NullUUID{}, nil
+	}
+
+	parentId, err := tools.GetValidUUID(parent)
+	if err != nil {
+		return uuid.NullUUID{}, err
+	}
+
+	ancestors, err := svc.taskRepository.GetTaskAncestors(ctx, parentId)
+	if err != nil {
+		if err == repository.ErrTaskNotFound {
+			return uuid.NullUUID{}, ErrStatusParentTaskNotFound.Err()
+		}
+		logging.FromContext(ctx).Error("cannot get task ancestors", zap.Error(err))
+		return uuid.NullUUID{}, ErrStatusInternalServerError.Err()
+	}
+
+	// Levels the task brings with it, its own and the ones of its subtasks
+	height := 1
+
+	if task != nil {
+		for _, ancestorId := range ancestors {
+			if ancestorId == task.Id {
+				return uuid.NullUUID{}, ErrStatusTaskHierarchyCycle.Err()
+			}
+		}
+
+		if height, err = svc.taskRepository.GetSubtaskHeight(ctx, task.Id); err != nil {
+			logging.FromContext(ctx).Error("cannot get subtask height", zap.Error(err))
+			return uuid.NullUUID{}, ErrStatusInternalServerError.Err()
+		}
+	}
+
+	if len(ancestors)+height > model.TaskMaxDepth {
+		return uuid.NullUUID{}, ErrStatusTaskMaxDepth.Err()
+	}
+
+	return uuid.NullUUID{UUID: parentId, Valid: true}, nil
+}
+
+// getSubtaskCompletion get what happens to the incomplete subtasks of a task when it is completed
+func getSubtaskCompletion(completion pbTodoList.SubtaskCompletion) string {
+	switch completion {
+	case pbTodoList.SubtaskCompletion_SUBTASK_COMPLETION_REQUIRE:
+		return model.SubtaskCompletionRequire
+	case pbTodoList.SubtaskCompletion_SUBTASK_COMPLETION_CASCADE:
+		return model.SubtaskCompletionCascade
+	}
+	return ""
+}
@@ -0,0 +1,495 @@
+package todolist
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"testing"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+	"github.com/overridesh/sgg-todolist-service/internal/repository"
+	mockRepository "github.com/overridesh/sgg-todolist-service/pkg/mock"
+	pbTodoList "github.com/overridesh/sgg-todolist-service/proto"
+)
+
+func TestGetSubtasks(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  func() (*pbTodoList.GetSubtasksResponse, error)
+		output *status.Status
+	}{
+		{
+			name: "GetSubtasks_ErrStatusTaskNotFound",
+			input: func() (*pbTodoList.GetSubtasksResponse, error) {
+				id := uuid.NewV4()
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTask", mock.Anything, id).Return(nil, repository.ErrTaskNotFound)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.GetSubtasks(context.Background(), &pbTodoList.GetSubtasksRequest{
+					Id: id.String(),
+				})
+			},
+			output: ErrStatusTaskNotFound,
+		},
+		{
+			name: "GetSubtasks_ErrStatusInternalServerError",
+			input: func() (*pbTodoList.GetSubtasksResponse, error) {
+				task := model.Task{
+					Id: uuid.NewV4(),
+				}
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTask", mock.Anything, task.Id).Return(&task, nil)
+				taskRepository.On("GetSubtasks", mock.Anything, task.Id).Return(nil, sql.ErrConnDone)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.GetSubtasks(context.Background(), &pbTodoList.GetSubtasksRequest{
+					Id: task.Id.String(),
+				})
+			},
+			output: ErrStatusInternalServerError,
+		},
+		{
+			name: "GetSubtasks_Success",
+			input: func() (*pbTodoList.GetSubtasksResponse, error) {
+				task := model.Task{
+					Id: uuid.NewV4(),
+				}
+				subtask := model.Task{
+					Id:       uuid.NewV4(),
+					ParentId: uuid.NullUUID{UUID: task.Id, Valid: true},
+				}
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTask", mock.Anything, task.Id).Return(&task, nil)
+				taskRepository.On("GetSubtasks", mock.Anything, task.Id).Return([]*model.Task{&subtask}, nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				response, err := client.GetSubtasks(context.Background(), &pbTodoList.GetSubtasksRequest{
+					Id: task.Id.String(),
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				if len(response.GetSubtasks()) != 1 || response.GetSubtasks()[0].GetId() != subtask.Id.String() {
+					t.Errorf("expect one subtask, but got %v", response)
+				}
+
+				return response, nil
+			},
+			output: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.input()
+			if err != nil {
+				if er, ok := status.FromError(err); ok {
+					if er.Code() != tt.output.Code() {
+						t.Errorf("error code: expected %v, received %v", codes.InvalidArgument, er.Code())
+					}
+					if er.Message() != tt.output.Message() {
+						t.Errorf("error message: expected %v, received %v", tt.output.Message(), er.Message())
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestTaskParent(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  func() error
+		output *status.Status
+	}{
+		{
+			name: "CreateTask_ErrStatusParentTaskNotFound",
+			input: func() error {
+				parentId := uuid.NewV4()
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTaskAncestors", mock.Anything, parentId).Return(nil, repository.ErrTaskNotFound)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				_, err = client.CreateTask(context.Background(), &pbTodoList.CreateTaskRequest{
+					Value:    "subtask",
+					ParentId: parentId.String(),
+				})
+				return err
+			},
+			output: ErrStatusParentTaskNotFound,
+		},
+		{
+			name: "CreateTask_ErrStatusTaskMaxDepth",
+			input: func() error {
+				ancestors := make([]uuid.UUID, model.TaskMaxDepth)
+				for i := range ancestors {
+					ancestors[i] = uuid.NewV4()
+				}
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTaskAncestors", mock.Anything, ancestors[0]).Return(ancestors, nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				_, err = client.CreateTask(context.Background(), &pbTodoList.CreateTaskRequest{
+					Value:    "subtask",
+					ParentId: ancestors[0].String(),
+				})
+				return err
+			},
+			output: ErrStatusTaskMaxDepth,
+		},
+		{
+			name: "CreateTask_SuccessWithParent",
+			input: func() error {
+				parentId := uuid.NewV4()
+				task := model.Task{
+					Value:    "subtask",
+					ParentId: uuid.NullUUID{UUID: parentId, Valid: true},
+				}
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTaskAncestors", mock.Anything, parentId).Return([]uuid.UUID{parentId}, nil)
+				taskRepository.On("CreateTask", mock.Anything, task).Return(&task, nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				response, err := client.CreateTask(context.Background(), &pbTodoList.CreateTaskRequest{
+					Value:    task.Value,
+					ParentId: parentId.String(),
+				})
+				if err != nil {
+					return err
+				}
+
+				if response.GetTask().GetParentId() != parentId.String() {
+					t.Errorf("expect parent %v, but got %v", parentId, response.GetTask().GetParentId())
+				}
+
+				return nil
+			},
+			output: nil,
+		},
+		{
+			name: "UpdateTask_ErrStatusTaskHierarchyCycle",
+			input: func() error {
+				task := model.Task{
+					Id: uuid.NewV4(),
+				}
+				subtaskId := uuid.NewV4()
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTask", mock.Anything, task.Id).Return(&task, nil)
+				taskRepository.On("GetTaskAncestors", mock.Anything, subtaskId).Return([]uuid.UUID{subtaskId, task.Id}, nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				_, err = client.UpdateTask(context.Background(), &pbTodoList.UpdateTaskRequest{
+					Id:         task.Id.String(),
+					ParentId:   subtaskId.String(),
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{model.TaskFieldParentId}},
+				})
+				return err
+			},
+			output: ErrStatusTaskHierarchyCycle,
+		},
+		{
+			name: "UpdateTask_ErrStatusTaskMaxDepth",
+			input: func() error {
+				task := model.Task{
+					Id: uuid.NewV4(),
+				}
+				parentId := uuid.NewV4()
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTask", mock.Anything, task.Id).Return(&task, nil)
+				taskRepository.On("GetTaskAncestors", mock.Anything, parentId).Return([]uuid.UUID{parentId, uuid.NewV4()}, nil)
+				taskRepository.On("GetSubtaskHeight", mock.Anything, task.Id).Return(model.TaskMaxDepth-1, nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				_, err = client.UpdateTask(context.Background(), &pbTodoList.UpdateTaskRequest{
+					Id:         task.Id.String(),
+					ParentId:   parentId.String(),
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{model.TaskFieldParentId}},
+				})
+				return err
+			},
+			output: ErrStatusTaskMaxDepth,
+		},
+		{
+			name: "UpdateTask_SuccessRemoveParent",
+			input: func() error {
+				task := model.Task{
+					Id:       uuid.NewV4(),
+					ParentId: uuid.NullUUID{UUID: uuid.NewV4(), Valid: true},
+				}
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTask", mock.Anything, task.Id).Return(&task, nil)
+				taskRepository.On("UpdateTask", mock.Anything, mock.MatchedBy(func(updated *model.Task) bool {
+					return !updated.ParentId.Valid
+				}), model.TaskUpdate{Fields: []string{model.TaskFieldParentId}}).Return(nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				_, err = client.UpdateTask(context.Background(), &pbTodoList.UpdateTaskRequest{
+					Id:         task.Id.String(),
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{model.TaskFieldParentId}},
+				})
+				return err
+			},
+			output: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.input()
+			if err != nil {
+				if tt.output == nil {
+					t.Fatalf("expect no error, but got %v", err)
+				}
+				if er, ok := status.FromError(err); ok {
+					if er.Code() != tt.output.Code() {
+						t.Errorf("error code: expected %v, received %v", tt.output.Code(), er.Code())
+					}
+					if er.Message() != tt.output.Message() {
+						t.Errorf("error message: expected %v, received %v", tt.output.Message(), er.Message())
+					}
+				}
+			} else if tt.output != nil {
+				t.Errorf("expect error %v, but got nil", tt.output.Message())
+			}
+		})
+	}
+}
+
+func TestSubtaskCompletion(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  func() (*emptypb.Empty, error)
+		output *status.Status
+	}{
+		{
+			name: "UpdateTaskStatus_ErrStatusIncompleteSubtasks",
+			input: func() (*emptypb.Empty, error) {
+				task := model.Task{
+					Id: uuid.NewV4(),
+				}
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTask", mock.Anything, task.Id).Return(&task, nil)
+				taskRepository.On("UpdateTask", mock.Anything, &task, model.TaskUpdate{
+					Fields:            []string{model.TaskFieldCompleted},
+					SubtaskCompletion: model.SubtaskCompletionRequire,
+				}).Return(repository.ErrIncompleteSubtasks)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.UpdateTaskStatus(context.Background(), &pbTodoList.UpdateTaskStatusRequest{
+					Id:                task.Id.String(),
+					Completed:         true,
+					SubtaskCompletion: pbTodoList.SubtaskCompletion_SUBTASK_COMPLETION_REQUIRE,
+				})
+			},
+			output: ErrStatusIncompleteSubtasks,
+		},
+		{
+			name: "UpdateTaskStatus_SuccessRequireSubtasks",
+			input: func() (*emptypb.Empty, error) {
+				task := model.Task{
+					Id: uuid.NewV4(),
+				}
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTask", mock.Anything, task.Id).Return(&task, nil)
+				taskRepository.On("UpdateTask", mock.Anything, &task, model.TaskUpdate{
+					Fields:            []string{model.TaskFieldCompleted},
+					SubtaskCompletion: model.SubtaskCompletionRequire,
+				}).Return(nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.UpdateTaskStatus(context.Background(), &pbTodoList.UpdateTaskStatusRequest{
+					Id:                task.Id.String(),
+					Completed:         true,
+					SubtaskCompletion: pbTodoList.SubtaskCompletion_SUBTASK_COMPLETION_REQUIRE,
+				})
+			},
+			output: nil,
+		},
+		{
+			name: "UpdateTaskStatus_SuccessCascadeSubtasks",
+			input: func() (*emptypb.Empty, error) {
+				task := model.Task{
+					Id: uuid.NewV4(),
+				}
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTask", mock.Anything, task.Id).Return(&task, nil)
+				taskRepository.On("UpdateTask", mock.Anything, &task, model.TaskUpdate{
+					Fields:            []string{model.TaskFieldCompleted},
+					SubtaskCompletion: model.SubtaskCompletionCascade,
+				}).Return(nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				response, err := client.UpdateTaskStatus(context.Background(), &pbTodoList.UpdateTaskStatusRequest{
+					Id:                task.Id.String(),
+					Completed:         true,
+					SubtaskCompletion: pbTodoList.SubtaskCompletion_SUBTASK_COMPLETION_CASCADE,
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				taskRepository.AssertExpectations(t)
+
+				return response, nil
+			},
+			output: nil,
+		},
+		{
+			name: "UpdateTaskStatus_ErrStatusInternalServerErrorOnCascade",
+			input: func() (*emptypb.Empty, error) {
+				task := model.Task{
+					Id: uuid.NewV4(),
+				}
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTask", mock.Anything, task.Id).Return(&task, nil)
+				taskRepository.On("UpdateTask", mock.Anything, &task, model.TaskUpdate{
+					Fields:            []string{model.TaskFieldCompleted},
+					SubtaskCompletion: model.SubtaskCompletionCascade,
+				}).Return(sql.ErrConnDone)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.UpdateTaskStatus(context.Background(), &pbTodoList.UpdateTaskStatusRequest{
+					Id:                task.Id.String(),
+					Completed:         true,
+					SubtaskCompletion: pbTodoList.SubtaskCompletion_SUBTASK_COMPLETION_CASCADE,
+				})
+			},
+			output: ErrStatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.input()
+			if err != nil {
+				if tt.output == nil {
+					t.Fatalf("expect no error, but got %v", err)
+				}
+				if er, ok := status.FromError(err); ok {
+					if er.Code() != tt.output.Code() {
+						t.Errorf("error code: expected %v, received %v", tt.output.Code(), er.Code())
+					}
+					if er.Message() != tt.output.Message() {
+						t.Errorf("error message: expected %v, received %v", tt.output.Message(), er.Message())
+					}
+				}
+			} else if tt.output != nil {
+				t.Errorf("expect error %v, but got nil", tt.output.Message())
+			}
+		})
+	}
+}
@@ -9,26 +9,89 @@ import (
 
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
+	"github.com/overridesh/sgg-todolist-service/internal/broker"
+	"github.com/overridesh/sgg-todolist-service/internal/logging"
 	"github.com/overridesh/sgg-todolist-service/internal/model"
 	"github.com/overridesh/sgg-todolist-service/internal/repository"
+	"github.com/overridesh/sgg-todolist-service/internal/webhook"
 	pbTodoList "github.com/overridesh/sgg-todolist-service/proto"
 	"github.com/overridesh/sgg-todolist-service/tools"
 )
 
+const (
+	// The default page size is the one of the repository
+	defaultPageSize int32 = int32(repository.DefaultPageSize)
+	maxPageSize     int32 = 100
+)
+
+var (
+	taskSortFields map[pbTodoList.TaskSortField]string = map[pbTodoList.TaskSortField]string{
+		pbTodoList.TaskSortField_TASK_SORT_FIELD_CREATED_AT: model.TaskSortByCreatedAt,
+		pbTodoList.TaskSortField_TASK_SORT_FIELD_UPDATED_AT: model.TaskSortByUpdatedAt,
+		pbTodoList.TaskSortField_TASK_SORT_FIELD_DUE_DATE:   model.TaskSortByDueDate,
+		pbTodoList.TaskSortField_TASK_SORT_FIELD_VALUE:      model.TaskSortByValue,
+		pbTodoList.TaskSortField_TASK_SORT_FIELD_PRIORITY:   model.TaskSortByPriority,
+		pbTodoList.TaskSortField_TASK_SORT_FIELD_POSITION:   model.TaskSortByPosition,
+	}
+	// Paths of the update mask are the same as the task columns
+	taskUpdateMaskPaths map[string]bool = map[string]bool{
+		model.TaskFieldValue:      true,
+		model.TaskFieldCompleted:  true,
+		model.TaskFieldDueDate:    true,
+		model.TaskFieldParentId:   true,
+		model.TaskFieldPriority:   true,
+		model.TaskFieldRecurrence: true,
+	}
+	sortDirections map[pbTodoList.SortDirection]string = map[pbTodoList.SortDirection]string{
+		pbTodoList.SortDirection_SORT_DIRECTION_ASC:  model.SortDirectionAsc,
+		pbTodoList.SortDirection_SORT_DIRECTION_DESC: model.SortDirectionDesc,
+	}
+)
+
 // NewTodoListGRPC implements the protobuf interface
 type todoListGRPC struct {
-	taskRepository    repository.TaskRepository
-	commentRepository repository.CommentRepository
-	labelRepository   repository.LabelRepository
+	taskRepository     repository.TaskRepository
+	commentRepository  repository.CommentRepository
+	labelRepository    repository.LabelRepository
+	reminderRepository repository.ReminderRepository
+	webhookRepository  repository.WebhookRepository
+	eventRepository    repository.EventRepository
+	memberRepository   repository.MemberRepository
+	apiKeyRepository   repository.ApiKeyRepository
+	subscriber         broker.Subscriber
+	webhookGuard       *webhook.Guard
+}
+
+// Dependencies of the service, the repositories and the subscriber of the task events
+type Dependencies struct {
+	TaskRepository     repository.TaskRepository
+	CommentRepository  repository.CommentRepository
+	LabelRepository    repository.LabelRepository
+	ReminderRepository repository.ReminderRepository
+	WebhookRepository  repository.WebhookRepository
+	EventRepository    repository.EventRepository
+	MemberRepository   repository.MemberRepository
+	ApiKeyRepository   repository.ApiKeyRepository
+	Subscriber         broker.Subscriber
+	// WebhookGuard rejects the webhooks of private addresses, they are all accepted without it
+	WebhookGuard *webhook.Guard
 }
 
 // New initializes a new NewTodoListGRPC struct.
-func NewGRPC(taskRepository repository.TaskRepository, commentRepository repository.CommentRepository, labelRepository repository.LabelRepository) pbTodoList.TodoListServiceServer {
+func NewGRPC(deps Dependencies) pbTodoList.TodoListServiceServer {
 	return &todoListGRPC{
-		taskRepository:    taskRepository,
-		commentRepository: commentRepository,
-		labelRepository:   labelRepository,
+		taskRepository:     deps.TaskRepository,
+		commentRepository:  deps.CommentRepository,
+		labelRepository:    deps.LabelRepository,
+		reminderRepository: deps.ReminderRepository,
+		webhookRepository:  deps.WebhookRepository,
+		eventRepository:    deps.EventRepository,
+		memberRepository:   deps.MemberRepository,
+		apiKeyRepository:   deps.ApiKeyRepository,
+		subscriber:         deps.Subscriber,
+		webhookGuard:       deps.WebhookGuard,
 	}
 }
 
@@ -38,30 +101,57 @@ func (svc *todoListGRPC) GetTask(ctx context.Context, in *pbTodoList.GetTaskRequ
 		return nil, err
 	}
 
+	ctx, err = svc.authorize(ctx, taskId, model.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+
 	task, err := svc.taskRepository.GetTask(ctx, taskId)
 	if err != nil {
 		if err == repository.ErrTaskNotFound {
 			return nil, ErrStatusTaskNotFound.Err()
 		}
-		zap.S().Errorf("cannot get task", zap.Error(err))
+		logging.FromContext(ctx).Error("cannot get task", zap.Error(err))
 		return nil, ErrStatusInternalServerError.Err()
 	}
 
+	etag := tools.FormatEtag(task.Version)
+	if err := tools.SetEtag(ctx, etag); err != nil {
+		logging.FromContext(ctx).Error("cannot set etag", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	if isEtagMatch(tools.GetMetadata(ctx, tools.IfNoneMatchHeader), task.Version) {
+		if err := tools.SetStatusCode(ctx, http.StatusNotModified); err != nil {
+			logging.FromContext(ctx).Error("cannot set new status_code", zap.Error(err))
+			return nil, ErrStatusInternalServerError.Err()
+		}
+		return &pbTodoList.GetTaskResponse{}, nil
+	}
+
 	response := pbTodoList.GetTaskResponse{
-		Id:        task.Id.String(),
-		Value:     task.Value,
-		Completed: task.Completed,
-		CreatedAt: tools.FormatDate(task.CreatedAt),
-		UpdatedAt: tools.FormatDate(task.UpdatedAt),
+		Id:         task.Id.String(),
+		Value:      task.Value,
+		Completed:  task.Completed,
+		CreatedAt:  tools.FormatDate(task.CreatedAt),
+		UpdatedAt:  tools.FormatDate(task.UpdatedAt),
+		Etag:       etag,
+		Priority:   pbTodoList.TaskPriority(task.Priority),
+		Position:   task.Position,
+		Recurrence: task.Recurrence,
 	}
 
 	if task.DueDate.Valid {
 		response.DueDate = tools.FormatDate(task.DueDate.Time)
 	}
 
+	if task.ParentId.Valid {
+		response.ParentId = task.ParentId.UUID.String()
+	}
+
 	comments, err := svc.commentRepository.GetCommentsByTaskId(ctx, taskId)
 	if err != nil && err != repository.ErrCommentNotFound {
-		zap.S().Errorf("cannot get task", zap.Error(err))
+		logging.FromContext(ctx).Error("cannot get task", zap.Error(err))
 		return nil, ErrStatusInternalServerError.Err()
 	}
 
@@ -75,7 +165,7 @@ func (svc *todoListGRPC) GetTask(ctx context.Context, in *pbTodoList.GetTaskRequ
 
 	labels, err := svc.labelRepository.GetLabelsByTaskId(ctx, taskId)
 	if err != nil && err != repository.ErrLabelNotFound {
-		zap.S().Errorf("cannot get task", zap.Error(err))
+		logging.FromContext(ctx).Error("cannot get task", zap.Error(err))
 		return nil, ErrStatusInternalServerError.Err()
 	}
 
@@ -87,33 +177,65 @@ func (svc *todoListGRPC) GetTask(ctx context.Context, in *pbTodoList.GetTaskRequ
 		})
 	}
 
+	subtasks, err := svc.taskRepository.GetSubtasks(ctx, taskId)
+	if err != nil {
+		logging.FromContext(ctx).Error("cannot get subtasks", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	for _, subtask := range subtasks {
+		response.Subtasks = append(response.Subtasks, getTaskResponse(subtask))
+	}
+
 	return &response, nil
 }
 
 func (svc *todoListGRPC) GetTasks(ctx context.Context, in *pbTodoList.GetTasksRequest) (*pbTodoList.GetTasksResponse, error) {
-	var page int32 = in.GetPage()
+	filter, err := getTaskFilter(ctx, in)
+	if err != nil {
+		return nil, err
+	}
 
-	tasks, err := svc.taskRepository.GetTasks(ctx, page)
+	tasks, err := svc.taskRepository.GetTasks(ctx, filter)
 	if err != nil {
-		zap.S().Errorf("cannot get tasks", zap.Error(err))
+		logging.FromContext(ctx).Error("cannot get tasks", zap.Error(err))
 		return nil, ErrStatusInternalServerError.Err()
 	}
 
-	response := pbTodoList.GetTasksResponse{}
-	for _, task := range tasks {
-		taskList := pbTodoList.Task{
-			Id:        task.Id.String(),
-			Value:     task.Value,
-			Completed: task.Completed,
-			CreatedAt: tools.FormatDate(task.CreatedAt),
-			UpdatedAt: tools.FormatDate(task.UpdatedAt),
-		}
+	total, err := svc.taskRepository.CountTasks(ctx, filter)
+	if err != nil {
+		logging.FromContext(ctx).Error("cannot count tasks", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
 
-		if task.DueDate.Valid {
-			taskList.DueDate = tools.FormatDate(task.DueDate.Time)
-		}
+	response := pbTodoList.GetTasksResponse{
+		TotalSize: total,
+	}
 
-		response.Tasks = append(response.Tasks, &taskList)
+	if filter.Limit > 0 {
+		response.PageSize = int32(filter.Limit - 1)
+
+		// The extra task fetched means there is a next page
+		if uint64(len(tasks)) == filter.Limit {
+			tasks = tasks[:len(tasks)-1]
+			response.HasMore = true
+
+			response.NextPageToken, err = encodePageToken(tasks[len(tasks)-1], filter)
+			if err != nil {
+				logging.FromContext(ctx).Error("cannot encode page token", zap.Error(err))
+				return nil, ErrStatusInternalServerError.Err()
+			}
+		}
+	} else {
+		response.Page = filter.Page
+		if response.Page < 1 {
+			response.Page = 1
+		}
+		response.PageSize = defaultPageSize
+		response.HasMore = int64(response.Page)*int64(response.PageSize) < total
+	}
+	for _, task := range tasks {
+		response.Tasks = append(response.Tasks, getTaskResponse(task))
 	}
 
 	return &response, nil
@@ -125,37 +247,50 @@ func (svc *todoListGRPC) CreateTask(ctx context.Context, in *pbTodoList.CreateTa
 	if len(strings.TrimSpace(in.GetDueDate())) > 0 {
 		dueDateTime, err := time.Parse(tools.TimeLayout, in.GetDueDate())
 		if err != nil {
-			zap.S().Errorf("cannot parse timelayout", zap.Error(err))
+			logging.FromContext(ctx).Error("cannot parse timelayout", zap.Error(err))
 			return nil, ErrStatusCannotParseTimeLayout.Err()
 		}
 		dueDate.Time = dueDateTime
 		dueDate.Valid = true
 	}
 
+	priority, err := getPriority(in.GetPriority())
+	if err != nil {
+		return nil, err
+	}
+
+	recurrence, err := getRecurrence(in.GetRecurrence(), dueDate)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = svc.authorizeParent(ctx, in.GetParentId())
+	if err != nil {
+		return nil, err
+	}
+
+	parentId, err := svc.getParentId(ctx, nil, in.GetParentId())
+	if err != nil {
+		return nil, err
+	}
+
 	task, err := svc.taskRepository.CreateTask(ctx, model.Task{
-		Value:   in.GetValue(),
-		DueDate: dueDate,
+		Value:      in.GetValue(),
+		DueDate:    dueDate,
+		ParentId:   parentId,
+		Priority:   priority,
+		Recurrence: recurrence,
 	})
 	if err != nil {
-		zap.S().Errorf("cannot create task", zap.Error(err))
+		logging.FromContext(ctx).Error("cannot create task", zap.Error(err))
 		return nil, ErrStatusInternalServerError.Err()
 	}
 	response := pbTodoList.CreateTaskResponse{
-		Task: &pbTodoList.Task{
-			Id:        task.Id.String(),
-			Value:     task.Value,
-			Completed: task.Completed,
-			CreatedAt: tools.FormatDate(task.CreatedAt),
-			UpdatedAt: tools.FormatDate(task.UpdatedAt),
-		},
-	}
-
-	if task.DueDate.Valid {
-		response.Task.DueDate = tools.FormatDate(task.DueDate.Time)
+		Task: getTaskResponse(task),
 	}
 
 	if err := tools.SetStatusCode(ctx, http.StatusCreated); err != nil {
-		zap.S().Errorf("cannot set new status_code", zap.Error(err))
+		logging.FromContext(ctx).Error("cannot set new status_code", zap.Error(err))
 		return nil, ErrStatusInternalServerError.Err()
 	}
 
@@ -168,44 +303,113 @@ func (svc *todoListGRPC) UpdateTask(ctx context.Context, in *pbTodoList.UpdateTa
 		return nil, err
 	}
 
+	// The new parent is checked against the caller, not against the owner of the task
+	caller := ctx
+
+	ctx, err = svc.authorize(ctx, taskId, model.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := getTaskUpdateFields(in.GetUpdateMask())
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := getExpectedVersion(ctx, in.GetEtag())
+	if err != nil {
+		return nil, err
+	}
+
 	task, err := svc.taskRepository.GetTask(ctx, taskId)
 	if err != nil {
 		if err == repository.ErrTaskNotFound {
 			return nil, ErrStatusTaskNotFound.Err()
 		}
-		zap.S().Errorf("cannot update task", zap.Error(err))
+		logging.FromContext(ctx).Error("cannot update task", zap.Error(err))
 		return nil, ErrStatusInternalServerError.Err()
 	}
 
-	task.Completed = in.Completed
-	task.Value = in.Value
+	if version.Valid && version.Int64 != task.Version {
+		return nil, preconditionFailed(ctx)
+	}
 
-	if task.DueDate.Valid {
-		dueDateTime, err := time.Parse(tools.TimeLayout, in.GetDueDate())
-		if err != nil {
-			zap.S().Errorf("cannot parse timelayout", zap.Error(err))
-			return nil, ErrStatusCannotParseTimeLayout.Err()
+	wasCompleted := task.Completed
+
+	for _, field := range fields {
+		switch field {
+		case model.TaskFieldValue:
+			task.Value = in.GetValue()
+		case model.TaskFieldCompleted:
+			task.Completed = in.GetCompleted()
+		case model.TaskFieldDueDate:
+			if task.DueDate, err = tools.ParseNullTime(in.GetDueDate()); err != nil {
+				logging.FromContext(ctx).Error("cannot parse timelayout", zap.Error(err))
+				return nil, ErrStatusCannotParseTimeLayout.Err()
+			}
+		case model.TaskFieldParentId:
+			if _, err := svc.authorizeParent(caller, in.GetParentId()); err != nil {
+				return nil, err
+			}
+			if task.ParentId, err = svc.getParentId(ctx, task, in.GetParentId()); err != nil {
+				return nil, err
+			}
+		case model.TaskFieldPriority:
+			if task.Priority, err = getPriority(in.GetPriority()); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// The recurrence is anchored at the due date, which could be in the same update
+	for _, field := range fields {
+		if field == model.TaskFieldRecurrence {
+			if task.Recurrence, err = getRecurrence(in.GetRecurrence(), task.DueDate); err != nil {
+				return nil, err
+			}
 		}
-		task.DueDate.Time = dueDateTime
 	}
 
-	if err := svc.taskRepository.UpdateTask(ctx, task); err != nil {
-		zap.S().Errorf("cannot update task", zap.Error(err))
+	update := model.TaskUpdate{
+		Fields:            fields,
+		SubtaskCompletion: getSubtaskCompletion(in.GetSubtaskCompletion()),
+		WasCompleted:      wasCompleted,
+	}
+
+	next, err := getNextOccurrence(ctx, task, wasCompleted)
+	if err != nil {
+		return nil, err
+	}
+	if next != nil {
+		update.Fields = moveRecurrence(task, update.Fields)
+		update.NextOccurrence = next
+	}
+
+	if err := svc.taskRepository.UpdateTask(ctx, task, update); err != nil {
+		if err == repository.ErrTaskNotFound {
+			return nil, ErrStatusTaskNotFound.Err()
+		}
+		if err == repository.ErrTaskVersionMismatch {
+			return nil, preconditionFailed(ctx)
+		}
+		if err == repository.ErrIncompleteSubtasks {
+			return nil, ErrStatusIncompleteSubtasks.Err()
+		}
+		logging.FromContext(ctx).Error("cannot update task", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	if err := tools.SetEtag(ctx, tools.FormatEtag(task.Version)); err != nil {
+		logging.FromContext(ctx).Error("cannot set etag", zap.Error(err))
 		return nil, ErrStatusInternalServerError.Err()
 	}
 
 	response := pbTodoList.UpdateTaskResponse{
-		Task: &pbTodoList.Task{
-			Id:        task.Id.String(),
-			Value:     task.Value,
-			Completed: task.Completed,
-			CreatedAt: tools.FormatDate(task.CreatedAt),
-			UpdatedAt: tools.FormatDate(task.UpdatedAt),
-		},
+		Task: getTaskResponse(task),
 	}
 
-	if task.DueDate.Valid {
-		response.Task.DueDate = tools.FormatDate(task.DueDate.Time)
+	if next != nil {
+		response.NextOccurrence = getTaskResponse(next)
 	}
 
 	return &response, nil
@@ -217,16 +421,29 @@ func (svc *todoListGRPC) DeleteTask(ctx context.Context, in *pbTodoList.DeleteTa
 		return nil, err
 	}
 
-	if err := svc.taskRepository.DeleteTask(ctx, taskId); err != nil {
+	ctx, err = svc.authorize(ctx, taskId, model.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := getExpectedVersion(ctx, in.GetEtag())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := svc.taskRepository.DeleteTask(ctx, taskId, version); err != nil {
 		if err == repository.ErrTaskNotFound {
 			return nil, ErrStatusTaskNotFound.Err()
 		}
-		zap.S().Errorf("cannot delete task", zap.Error(err))
+		if err == repository.ErrTaskVersionMismatch {
+			return nil, preconditionFailed(ctx)
+		}
+		logging.FromContext(ctx).Error("cannot delete task", zap.Error(err))
 		return nil, ErrStatusInternalServerError.Err()
 	}
 
 	if err := tools.SetStatusCode(ctx, http.StatusNoContent); err != nil {
-		zap.S().Errorf("cannot set new status_code", zap.Error(err))
+		logging.FromContext(ctx).Error("cannot set new status_code", zap.Error(err))
 		return nil, ErrStatusInternalServerError.Err()
 	}
 
@@ -239,26 +456,226 @@ func (svc *todoListGRPC) UpdateTaskStatus(ctx context.Context, in *pbTodoList.Up
 		return nil, err
 	}
 
+	ctx, err = svc.authorize(ctx, taskId, model.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := getExpectedVersion(ctx, in.GetEtag())
+	if err != nil {
+		return nil, err
+	}
+
 	task, err := svc.taskRepository.GetTask(ctx, taskId)
 	if err != nil {
 		if err == repository.ErrTaskNotFound {
 			return nil, ErrStatusTaskNotFound.Err()
 		}
-		zap.S().Errorf("cannot get task", zap.Error(err))
+		logging.FromContext(ctx).Error("cannot get task", zap.Error(err))
 		return nil, ErrStatusInternalServerError.Err()
 	}
 
+	if version.Valid && version.Int64 != task.Version {
+		return nil, preconditionFailed(ctx)
+	}
+
+	wasCompleted := task.Completed
 	task.Completed = in.GetCompleted()
 
-	if err := svc.taskRepository.UpdateTask(ctx, task); err != nil {
-		zap.S().Errorf("cannot update task", zap.Error(err))
+	fields := []string{model.TaskFieldCompleted}
+
+	update := model.TaskUpdate{
+		Fields:            fields,
+		SubtaskCompletion: getSubtaskCompletion(in.GetSubtaskCompletion()),
+		WasCompleted:      wasCompleted,
+	}
+
+	next, err := getNextOccurrence(ctx, task, wasCompleted)
+	if err != nil {
+		return nil, err
+	}
+	if next != nil {
+		update.Fields = moveRecurrence(task, update.Fields)
+		update.NextOccurrence = next
+	}
+
+	if err := svc.taskRepository.UpdateTask(ctx, task, update); err != nil {
+		if err == repository.ErrTaskNotFound {
+			return nil, ErrStatusTaskNotFound.Err()
+		}
+		if err == repository.ErrTaskVersionMismatch {
+			return nil, preconditionFailed(ctx)
+		}
+		if err == repository.ErrIncompleteSubtasks {
+			return nil, ErrStatusIncompleteSubtasks.Err()
+		}
+		logging.FromContext(ctx).Error("cannot update task", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	if err := tools.SetEtag(ctx, tools.FormatEtag(task.Version)); err != nil {
+		logging.FromContext(ctx).Error("cannot set etag", zap.Error(err))
 		return nil, ErrStatusInternalServerError.Err()
 	}
 
 	if err := tools.SetStatusCode(ctx, http.StatusNoContent); err != nil {
-		zap.S().Errorf("cannot set new status_code", zap.Error(err))
+		logging.FromContext(ctx).Error("cannot set new status_code", zap.Error(err))
 		return nil, ErrStatusInternalServerError.Err()
 	}
 
 	return &emptypb.Empty{}, nil
 }
+
+// getTaskResponse map a task from the repository into the protobuf task
+func getTaskResponse(task *model.Task) *pbTodoList.Task {
+	response := pbTodoList.Task{
+		Id:         task.Id.String(),
+		Value:      task.Value,
+		Completed:  task.Completed,
+		CreatedAt:  tools.FormatDate(task.CreatedAt),
+		UpdatedAt:  tools.FormatDate(task.UpdatedAt),
+		Etag:       tools.FormatEtag(task.Version),
+		Priority:   pbTodoList.TaskPriority(task.Priority),
+		Position:   task.Position,
+		Recurrence: task.Recurrence,
+	}
+
+	if task.DueDate.Valid {
+		response.DueDate = tools.FormatDate(task.DueDate.Time)
+	}
+
+	if task.DeletedAt.Valid {
+		response.DeletedAt = tools.FormatDate(task.DeletedAt.Time)
+	}
+
+	if task.ParentId.Valid {
+		response.ParentId = task.ParentId.UUID.String()
+	}
+
+	return &response
+}
+
+// getExpectedVersion get the version the caller expects from the etag field or the If-Match header
+func getExpectedVersion(ctx context.Context, etag string) (sql.NullInt64, error) {
+	if len(strings.TrimSpace(etag)) == 0 {
+		etag = tools.GetMetadata(ctx, tools.IfMatchHeader)
+	}
+
+	version, err := tools.ParseEtag(etag)
+	if err != nil {
+		return version, ErrStatusInvalidEtag.Err()
+	}
+
+	return version, nil
+}
+
+// isEtagMatch check if any etag of an If-None-Match header is the current version
+func isEtagMatch(header string, version int64) bool {
+	for _, etag := range strings.Split(header, ",") {
+		if strings.TrimSpace(etag) == "*" {
+			return true
+		}
+
+		expected, err := tools.ParseEtag(etag)
+		if err == nil && expected.Valid && expected.Int64 == version {
+			return true
+		}
+	}
+
+	return false
+}
+
+// preconditionFailed build the error returned when the etag does not match, with http status 412
+func preconditionFailed(ctx context.Context) error {
+	if err := tools.SetStatusCode(ctx, http.StatusPreconditionFailed); err != nil {
+		logging.FromContext(ctx).Error("cannot set new status_code", zap.Error(err))
+		return ErrStatusInternalServerError.Err()
+	}
+
+	return ErrStatusTaskEtagMismatch.Err()
+}
+
+// getTaskUpdateFields get the columns to update from the update mask, all of them when it is empty
+func getTaskUpdateFields(updateMask *fieldmaskpb.FieldMask) ([]string, error) {
+	if len(updateMask.GetPaths()) == 0 {
+		return model.TaskUpdatableFields, nil
+	}
+
+	var fields []string
+
+	seen := map[string]bool{}
+	for _, path := range updateMask.GetPaths() {
+		if !taskUpdateMaskPaths[path] {
+			return nil, ErrStatusInvalidUpdateMask.Err()
+		}
+		if seen[path] {
+			continue
+		}
+		seen[path] = true
+		fields = append(fields, path)
+	}
+
+	return fields, nil
+}
+
+// getTaskFilter map the GetTasks request into a repository filter
+func getTaskFilter(ctx context.Context, in *pbTodoList.GetTasksRequest) (model.TaskFilter, error) {
+	var (
+		err    error
+		filter model.TaskFilter = model.TaskFilter{
+			Page:          in.GetPage(),
+			Label:         normalizeLabel(in.GetLabel()),
+			SortBy:        taskSortFields[in.GetSortBy()],
+			SortDirection: sortDirections[in.GetSortDirection()],
+		}
+	)
+
+	if in.GetPageSize() < 0 || in.GetPageSize() > maxPageSize {
+		return filter, ErrStatusInvalidPageSize.Err()
+	}
+
+	if in.GetPageSize() > 0 || len(in.GetPageToken()) > 0 {
+		if filter.SortBy != "" && filter.SortBy != model.TaskSortByCreatedAt {
+			return filter, ErrStatusInvalidSortWithToken.Err()
+		}
+
+		pageSize := in.GetPageSize()
+		if pageSize == 0 {
+			pageSize = defaultPageSize
+		}
+
+		// Fetch one more task than requested to know if there is a next page
+		filter.Limit = uint64(pageSize) + 1
+	}
+
+	if in.Completed != nil {
+		filter.Completed = sql.NullBool{Bool: in.GetCompleted(), Valid: true}
+	}
+
+	for _, date := range []struct {
+		value string
+		dest  *sql.NullTime
+	}{
+		{in.GetDueBefore(), &filter.DueBefore},
+		{in.GetDueAfter(), &filter.DueAfter},
+		{in.GetCreatedAfter(), &filter.CreatedAfter},
+		{in.GetCreatedBefore(), &filter.CreatedBefore},
+	} {
+		if *date.dest, err = tools.ParseNullTime(date.value); err != nil {
+			logging.FromContext(ctx).Error("cannot parse timelayout", zap.Error(err))
+			return filter, ErrStatusCannotParseTimeLayout.Err()
+		}
+	}
+
+	// The token is bound to the sort and the filters, so it is read once they are all set
+	if len(in.GetPageToken()) > 0 {
+		if filter.After, err = decodePageToken(in.GetPageToken(), filter); err != nil {
+			if err == errPageTokenOtherQuery {
+				return filter, ErrStatusPageTokenMismatch.Err()
+			}
+			return filter, ErrStatusInvalidPageToken.Err()
+		}
+	}
+
+	return filter, nil
+}
@@ -6,13 +6,16 @@ import (
 	"errors"
 	"log"
 	"testing"
+	"time"
 
 	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	"github.com/overridesh/sgg-todolist-service/internal/model"
 	"github.com/overridesh/sgg-todolist-service/internal/repository"
@@ -41,9 +44,10 @@ func TestGetTask(t *testing.T) {
 				taskRepository.On("GetTask", mock.Anything, tx.Id).Return(&tx, nil)
 				commentRepository.On("GetCommentsByTaskId", mock.Anything, tx.Id).Return(nil, repository.ErrCommentNotFound)
 				labelRepository.On("GetLabelsByTaskId", mock.Anything, tx.Id).Return(nil, repository.ErrLabelNotFound)
+				taskRepository.On("GetSubtasks", mock.Anything, tx.Id).Return([]*model.Task{}, nil)
 
 				ctx := context.Background()
-				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, commentRepository, labelRepository)))
+				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository, CommentRepository: commentRepository, LabelRepository: labelRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -57,6 +61,81 @@ func TestGetTask(t *testing.T) {
 			},
 			output: nil,
 		},
+		{
+			name: "GetTask_SubtasksErrStatusInternalServerError",
+			input: func() (*pbTodoList.GetTaskResponse, error) {
+				commentRepository := new(mockRepository.CommentRepository)
+				labelRepository := new(mockRepository.LabelRepository)
+				taskRepository := new(mockRepository.TaskRepository)
+
+				tx := model.Task{
+					Id: uuid.NewV4(),
+				}
+
+				taskRepository.On("GetTask", mock.Anything, tx.Id).Return(&tx, nil)
+				commentRepository.On("GetCommentsByTaskId", mock.Anything, tx.Id).Return(nil, repository.ErrCommentNotFound)
+				labelRepository.On("GetLabelsByTaskId", mock.Anything, tx.Id).Return(nil, repository.ErrLabelNotFound)
+				taskRepository.On("GetSubtasks", mock.Anything, tx.Id).Return(nil, sql.ErrConnDone)
+
+				ctx := context.Background()
+				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository, CommentRepository: commentRepository, LabelRepository: labelRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.GetTask(ctx, &pbTodoList.GetTaskRequest{
+					Id: tx.Id.String(),
+				})
+			},
+			output: ErrStatusInternalServerError,
+		},
+		{
+			name: "GetTask_SuccessWithSubtasks",
+			input: func() (*pbTodoList.GetTaskResponse, error) {
+				commentRepository := new(mockRepository.CommentRepository)
+				labelRepository := new(mockRepository.LabelRepository)
+				taskRepository := new(mockRepository.TaskRepository)
+
+				tx := model.Task{
+					Id: uuid.NewV4(),
+				}
+				subtask := model.Task{
+					Id:       uuid.NewV4(),
+					ParentId: uuid.NullUUID{UUID: tx.Id, Valid: true},
+				}
+
+				taskRepository.On("GetTask", mock.Anything, tx.Id).Return(&tx, nil)
+				commentRepository.On("GetCommentsByTaskId", mock.Anything, tx.Id).Return(nil, repository.ErrCommentNotFound)
+				labelRepository.On("GetLabelsByTaskId", mock.Anything, tx.Id).Return(nil, repository.ErrLabelNotFound)
+				taskRepository.On("GetSubtasks", mock.Anything, tx.Id).Return([]*model.Task{&subtask}, nil)
+
+				ctx := context.Background()
+				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository, CommentRepository: commentRepository, LabelRepository: labelRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				response, err := client.GetTask(ctx, &pbTodoList.GetTaskRequest{
+					Id: tx.Id.String(),
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				if len(response.GetSubtasks()) != 1 || response.GetSubtasks()[0].GetParentId() != tx.Id.String() {
+					t.Errorf("expect the subtask in the response, but got %v", response.GetSubtasks())
+				}
+
+				return response, nil
+			},
+			output: nil,
+		},
 		{
 			name: "GetTask_LabelsErrStatusInternalServerError",
 			input: func() (*pbTodoList.GetTaskResponse, error) {
@@ -73,7 +152,7 @@ func TestGetTask(t *testing.T) {
 				labelRepository.On("GetLabelsByTaskId", mock.Anything, tx.Id).Return(nil, sql.ErrConnDone)
 
 				ctx := context.Background()
-				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, commentRepository, labelRepository)))
+				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository, CommentRepository: commentRepository, LabelRepository: labelRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -101,9 +180,10 @@ func TestGetTask(t *testing.T) {
 				taskRepository.On("GetTask", mock.Anything, tx.Id).Return(&tx, nil)
 				commentRepository.On("GetCommentsByTaskId", mock.Anything, tx.Id).Return(nil, sql.ErrConnDone)
 				labelRepository.On("GetLabelsByTaskId", mock.Anything, tx.Id).Return(nil, repository.ErrLabelNotFound)
+				taskRepository.On("GetSubtasks", mock.Anything, tx.Id).Return([]*model.Task{}, nil)
 
 				ctx := context.Background()
-				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, commentRepository, labelRepository)))
+				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository, CommentRepository: commentRepository, LabelRepository: labelRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -123,7 +203,7 @@ func TestGetTask(t *testing.T) {
 				taskRepository := new(mockRepository.TaskRepository)
 
 				ctx := context.Background()
-				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, nil, nil)))
+				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -143,7 +223,7 @@ func TestGetTask(t *testing.T) {
 				taskRepository := new(mockRepository.TaskRepository)
 
 				ctx := context.Background()
-				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, nil, nil)))
+				conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -167,7 +247,7 @@ func TestGetTask(t *testing.T) {
 
 				taskRepository.On("GetTask", mock.Anything, tx.Id).Return(nil, errors.New("unknow_error"))
 
-				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, nil, nil)))
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -191,7 +271,7 @@ func TestGetTask(t *testing.T) {
 
 				taskRepository.On("GetTask", mock.Anything, tx.Id).Return(nil, repository.ErrTaskNotFound)
 
-				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, nil, nil)))
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -235,9 +315,10 @@ func TestGetTasks(t *testing.T) {
 			input: func() (*pbTodoList.GetTasksResponse, error) {
 				taskRepository := new(mockRepository.TaskRepository)
 				var page int32 = 1
-				taskRepository.On("GetTasks", mock.Anything, page).Return([]*model.Task{}, nil)
+				taskRepository.On("GetTasks", mock.Anything, model.TaskFilter{Page: page}).Return([]*model.Task{}, nil)
+				taskRepository.On("CountTasks", mock.Anything, model.TaskFilter{Page: page}).Return(int64(0), nil)
 
-				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, nil, nil)))
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -256,11 +337,12 @@ func TestGetTasks(t *testing.T) {
 			input: func() (*pbTodoList.GetTasksResponse, error) {
 				taskRepository := new(mockRepository.TaskRepository)
 				var page int32 = 1
-				taskRepository.On("GetTasks", mock.Anything, page).Return([]*model.Task{{
+				taskRepository.On("GetTasks", mock.Anything, model.TaskFilter{Page: page}).Return([]*model.Task{{
 					Id: uuid.NewV4(),
 				}}, nil)
+				taskRepository.On("CountTasks", mock.Anything, model.TaskFilter{Page: page}).Return(int64(1), nil)
 
-				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, nil, nil)))
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -279,9 +361,9 @@ func TestGetTasks(t *testing.T) {
 			input: func() (*pbTodoList.GetTasksResponse, error) {
 				var page int32 = 1
 				taskRepository := new(mockRepository.TaskRepository)
-				taskRepository.On("GetTasks", mock.Anything, page).Return(nil, errors.New("unknow_error"))
+				taskRepository.On("GetTasks", mock.Anything, model.TaskFilter{Page: page}).Return(nil, errors.New("unknow_error"))
 
-				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, nil, nil)))
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -295,6 +377,259 @@ func TestGetTasks(t *testing.T) {
 			},
 			output: ErrStatusInternalServerError,
 		},
+		{
+			name: "GetTasks_CountErrStatusInternalServerError",
+			input: func() (*pbTodoList.GetTasksResponse, error) {
+				var page int32 = 1
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTasks", mock.Anything, model.TaskFilter{Page: page}).Return([]*model.Task{}, nil)
+				taskRepository.On("CountTasks", mock.Anything, model.TaskFilter{Page: page}).Return(int64(0), errors.New("unknow_error"))
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.GetTasks(context.Background(), &pbTodoList.GetTasksRequest{
+					Page: page,
+				})
+			},
+			output: ErrStatusInternalServerError,
+		},
+		{
+			name: "GetTasks_SuccessWithPageMetadata",
+			input: func() (*pbTodoList.GetTasksResponse, error) {
+				var page int32 = 2
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTasks", mock.Anything, model.TaskFilter{Page: page}).Return([]*model.Task{}, nil)
+				taskRepository.On("CountTasks", mock.Anything, model.TaskFilter{Page: page}).Return(int64(45), nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				response, err := client.GetTasks(context.Background(), &pbTodoList.GetTasksRequest{
+					Page: page,
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				if response.GetTotalSize() != 45 || response.GetPage() != page || response.GetPageSize() != defaultPageSize || !response.GetHasMore() {
+					t.Errorf("unexpected pagination metadata, got %v", response)
+				}
+
+				return response, nil
+			},
+			output: nil,
+		},
+		{
+			name: "GetTasks_SuccessWithFilter",
+			input: func() (*pbTodoList.GetTasksResponse, error) {
+				var (
+					page      int32 = 1
+					completed bool  = true
+				)
+
+				dueBefore, err := time.Parse(tools.TimeLayout, "2022-03-28T23:37:17.150Z")
+				if err != nil {
+					log.Fatal(err)
+				}
+
+				filter := model.TaskFilter{
+					Page:          page,
+					Completed:     sql.NullBool{Bool: completed, Valid: true},
+					DueBefore:     sql.NullTime{Time: dueBefore, Valid: true},
+					Label:         "work",
+					SortBy:        model.TaskSortByDueDate,
+					SortDirection: model.SortDirectionDesc,
+				}
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTasks", mock.Anything, filter).Return([]*model.Task{}, nil)
+				taskRepository.On("CountTasks", mock.Anything, filter).Return(int64(0), nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.GetTasks(context.Background(), &pbTodoList.GetTasksRequest{
+					Page:          page,
+					Completed:     &completed,
+					DueBefore:     tools.FormatDate(dueBefore),
+					Label:         "Work",
+					SortBy:        pbTodoList.TaskSortField_TASK_SORT_FIELD_DUE_DATE,
+					SortDirection: pbTodoList.SortDirection_SORT_DIRECTION_DESC,
+				})
+			},
+			output: nil,
+		},
+		{
+			name: "GetTasks_ErrStatusCannotParseTimeLayout",
+			input: func() (*pbTodoList.GetTasksResponse, error) {
+				taskRepository := new(mockRepository.TaskRepository)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.GetTasks(context.Background(), &pbTodoList.GetTasksRequest{
+					CreatedAfter: "yesterday",
+				})
+			},
+			output: ErrStatusCannotParseTimeLayout,
+		},
+		{
+			name: "GetTasks_SuccessWithPageToken",
+			input: func() (*pbTodoList.GetTasksResponse, error) {
+				var pageSize int32 = 1
+
+				tasks := []*model.Task{
+					{Id: uuid.NewV4(), CreatedAt: time.Now().UTC()},
+					{Id: uuid.NewV4(), CreatedAt: time.Now().UTC()},
+				}
+
+				token, err := encodePageToken(tasks[0], model.TaskFilter{})
+				if err != nil {
+					log.Fatal(err)
+				}
+
+				cursor, err := decodePageToken(token, model.TaskFilter{})
+				if err != nil {
+					log.Fatal(err)
+				}
+
+				filter := model.TaskFilter{
+					Limit: uint64(pageSize) + 1,
+					After: cursor,
+				}
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTasks", mock.Anything, filter).Return(tasks, nil)
+				taskRepository.On("CountTasks", mock.Anything, filter).Return(int64(len(tasks)), nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				response, err := client.GetTasks(context.Background(), &pbTodoList.GetTasksRequest{
+					PageSize:  pageSize,
+					PageToken: token,
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				if len(response.GetTasks()) != int(pageSize) || response.GetNextPageToken() != token || !response.GetHasMore() {
+					t.Errorf("expect %d task and next_page_token %s, but got %v", pageSize, token, response)
+				}
+
+				return response, nil
+			},
+			output: nil,
+		},
+		{
+			name: "GetTasks_ErrStatusInvalidPageToken",
+			input: func() (*pbTodoList.GetTasksResponse, error) {
+				taskRepository := new(mockRepository.TaskRepository)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.GetTasks(context.Background(), &pbTodoList.GetTasksRequest{
+					PageToken: "invalid_token",
+				})
+			},
+			output: ErrStatusInvalidPageToken,
+		},
+		{
+			name: "GetTasks_ErrStatusPageTokenMismatch",
+			input: func() (*pbTodoList.GetTasksResponse, error) {
+				token, err := encodePageToken(&model.Task{Id: uuid.NewV4(), CreatedAt: time.Now().UTC()}, model.TaskFilter{})
+				if err != nil {
+					log.Fatal(err)
+				}
+
+				taskRepository := new(mockRepository.TaskRepository)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.GetTasks(context.Background(), &pbTodoList.GetTasksRequest{
+					PageToken:     token,
+					SortDirection: pbTodoList.SortDirection_SORT_DIRECTION_DESC,
+				})
+			},
+			output: ErrStatusPageTokenMismatch,
+		},
+		{
+			name: "GetTasks_ErrStatusInvalidPageSize",
+			input: func() (*pbTodoList.GetTasksResponse, error) {
+				taskRepository := new(mockRepository.TaskRepository)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.GetTasks(context.Background(), &pbTodoList.GetTasksRequest{
+					PageSize: maxPageSize + 1,
+				})
+			},
+			output: ErrStatusInvalidPageSize,
+		},
+		{
+			name: "GetTasks_ErrStatusInvalidSortWithToken",
+			input: func() (*pbTodoList.GetTasksResponse, error) {
+				taskRepository := new(mockRepository.TaskRepository)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.GetTasks(context.Background(), &pbTodoList.GetTasksRequest{
+					PageSize: 10,
+					SortBy:   pbTodoList.TaskSortField_TASK_SORT_FIELD_VALUE,
+				})
+			},
+			output: ErrStatusInvalidSortWithToken,
+		},
 	}
 
 	for _, tt := range tests {
@@ -327,7 +662,7 @@ func TestCreateTask(t *testing.T) {
 				task := model.Task{}
 				taskRepository.On("CreateTask", mock.Anything, task).Return(&task, nil)
 
-				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, nil, nil)))
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -346,7 +681,7 @@ func TestCreateTask(t *testing.T) {
 			input: func() (*pbTodoList.CreateTaskResponse, error) {
 				taskRepository := new(mockRepository.TaskRepository)
 
-				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, nil, nil)))
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -368,7 +703,7 @@ func TestCreateTask(t *testing.T) {
 				task := model.Task{}
 				taskRepository.On("CreateTask", mock.Anything, task).Return(&task, errors.New("uknow error"))
 
-				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, nil, nil)))
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -410,7 +745,7 @@ func TestUpdateTask(t *testing.T) {
 		{
 			name: "UpdateTask_ErrGetValidUUID",
 			input: func() (*pbTodoList.UpdateTaskResponse, error) {
-				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(nil, nil, nil)))
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -434,7 +769,7 @@ func TestUpdateTask(t *testing.T) {
 
 				taskRepository.On("GetTask", mock.Anything, tx.Id).Return(nil, repository.ErrTaskNotFound)
 
-				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, nil, nil)))
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -457,9 +792,9 @@ func TestUpdateTask(t *testing.T) {
 				}
 
 				taskRepository.On("GetTask", mock.Anything, tx.Id).Return(&tx, nil)
-				taskRepository.On("UpdateTask", mock.Anything, &tx).Return(nil, repository.ErrTaskNotFound)
+				taskRepository.On("UpdateTask", mock.Anything, &tx, mock.Anything).Return(nil, repository.ErrTaskNotFound)
 
-				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, nil, nil)))
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -483,7 +818,7 @@ func TestUpdateTask(t *testing.T) {
 				taskRepository := new(mockRepository.TaskRepository)
 				taskRepository.On("GetTask", mock.Anything, task.Id).Return(nil, errors.New("unknown_error"))
 
-				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, nil, nil)))
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -506,9 +841,9 @@ func TestUpdateTask(t *testing.T) {
 
 				taskRepository := new(mockRepository.TaskRepository)
 				taskRepository.On("GetTask", mock.Anything, task.Id).Return(&task, nil)
-				taskRepository.On("UpdateTask", mock.Anything, &task).Return(errors.New("unknown_error"))
+				taskRepository.On("UpdateTask", mock.Anything, &task, mock.Anything).Return(errors.New("unknown_error"))
 
-				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, nil, nil)))
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -531,9 +866,9 @@ func TestUpdateTask(t *testing.T) {
 
 				taskRepository := new(mockRepository.TaskRepository)
 				taskRepository.On("GetTask", mock.Anything, task.Id).Return(&task, nil)
-				taskRepository.On("UpdateTask", mock.Anything, &task).Return(nil)
+				taskRepository.On("UpdateTask", mock.Anything, &task, mock.Anything).Return(nil)
 
-				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, nil, nil)))
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -547,6 +882,155 @@ func TestUpdateTask(t *testing.T) {
 			},
 			output: nil,
 		},
+		{
+			name: "UpdateTask_SuccessWithUpdateMask",
+			input: func() (*pbTodoList.UpdateTaskResponse, error) {
+				task := model.Task{
+					Id:        uuid.NewV4(),
+					Value:     "old value",
+					Completed: true,
+				}
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTask", mock.Anything, task.Id).Return(&task, nil)
+				taskRepository.On("UpdateTask", mock.Anything, &model.Task{
+					Id:        task.Id,
+					Value:     "new value",
+					Completed: true,
+				}, model.TaskUpdate{Fields: []string{model.TaskFieldValue}, WasCompleted: true}).Return(nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				response, err := client.UpdateTask(context.Background(), &pbTodoList.UpdateTaskRequest{
+					Id:         task.Id.String(),
+					Value:      "new value",
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"value"}},
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				if !response.GetTask().GetCompleted() {
+					t.Errorf("expect task to keep completed, but got %v", response)
+				}
+
+				return response, nil
+			},
+			output: nil,
+		},
+		{
+			name: "UpdateTask_SuccessSetDueDate",
+			input: func() (*pbTodoList.UpdateTaskResponse, error) {
+				dueDate, err := time.Parse(tools.TimeLayout, "2022-03-28T23:37:17.150Z")
+				if err != nil {
+					log.Fatal(err)
+				}
+
+				task := model.Task{
+					Id: uuid.NewV4(),
+				}
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTask", mock.Anything, task.Id).Return(&task, nil)
+				taskRepository.On("UpdateTask", mock.Anything, &model.Task{
+					Id:      task.Id,
+					DueDate: sql.NullTime{Time: dueDate, Valid: true},
+				}, model.TaskUpdate{Fields: []string{model.TaskFieldDueDate}}).Return(nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.UpdateTask(context.Background(), &pbTodoList.UpdateTaskRequest{
+					Id:         task.Id.String(),
+					DueDate:    tools.FormatDate(dueDate),
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"due_date"}},
+				})
+			},
+			output: nil,
+		},
+		{
+			name: "UpdateTask_ErrStatusInvalidUpdateMask",
+			input: func() (*pbTodoList.UpdateTaskResponse, error) {
+				taskRepository := new(mockRepository.TaskRepository)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.UpdateTask(context.Background(), &pbTodoList.UpdateTaskRequest{
+					Id:         uuid.NewV4().String(),
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"created_at"}},
+				})
+			},
+			output: ErrStatusInvalidUpdateMask,
+		},
+		{
+			name: "UpdateTask_ErrStatusTaskEtagMismatchWithIfMatch",
+			input: func() (*pbTodoList.UpdateTaskResponse, error) {
+				task := model.Task{
+					Id:      uuid.NewV4(),
+					Version: 3,
+				}
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTask", mock.Anything, task.Id).Return(&task, nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				ctx := metadata.AppendToOutgoingContext(context.Background(), tools.IfMatchHeader, tools.FormatEtag(2))
+				return client.UpdateTask(ctx, &pbTodoList.UpdateTaskRequest{
+					Id:    task.Id.String(),
+					Value: "new value",
+				})
+			},
+			output: ErrStatusTaskEtagMismatch,
+		},
+		{
+			name: "UpdateTask_ErrStatusCannotParseTimeLayout",
+			input: func() (*pbTodoList.UpdateTaskResponse, error) {
+				task := model.Task{
+					Id: uuid.NewV4(),
+				}
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetTask", mock.Anything, task.Id).Return(&task, nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.UpdateTask(context.Background(), &pbTodoList.UpdateTaskRequest{
+					Id:      task.Id.String(),
+					DueDate: "tomorrow",
+				})
+			},
+			output: ErrStatusCannotParseTimeLayout,
+		},
 	}
 
 	for _, tt := range tests {
@@ -575,7 +1059,7 @@ func TestDeleteTask(t *testing.T) {
 		{
 			name: "DeleteTask_ErrGetValidUUID",
 			input: func() (*emptypb.Empty, error) {
-				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(nil, nil, nil)))
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -597,9 +1081,9 @@ func TestDeleteTask(t *testing.T) {
 					Id: uuid.NewV4(),
 				}
 
-				taskRepository.On("DeleteTask", mock.Anything, tx.Id).Return(repository.ErrTaskNotFound)
+				taskRepository.On("DeleteTask", mock.Anything, tx.Id, sql.NullInt64{}).Return(repository.ErrTaskNotFound)
 
-				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, nil, nil)))
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -621,9 +1105,9 @@ func TestDeleteTask(t *testing.T) {
 				}
 
 				taskRepository := new(mockRepository.TaskRepository)
-				taskRepository.On("DeleteTask", mock.Anything, task.Id).Return(errors.New("unknown_error"))
+				taskRepository.On("DeleteTask", mock.Anything, task.Id, sql.NullInt64{}).Return(errors.New("unknown_error"))
 
-				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, nil, nil)))
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -637,6 +1121,49 @@ func TestDeleteTask(t *testing.T) {
 			},
 			output: ErrStatusInternalServerError,
 		},
+		{
+			name: "DeleteTask_ErrStatusInvalidEtag",
+			input: func() (*emptypb.Empty, error) {
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.DeleteTask(context.Background(), &pbTodoList.DeleteTaskRequest{
+					Id:   uuid.NewV4().String(),
+					Etag: "ASD",
+				})
+			},
+			output: ErrStatusInvalidEtag,
+		},
+		{
+			name: "DeleteTask_ErrStatusTaskEtagMismatch",
+			input: func() (*emptypb.Empty, error) {
+				task := model.Task{
+					Id: uuid.NewV4(),
+				}
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("DeleteTask", mock.Anything, task.Id, sql.NullInt64{Int64: 2, Valid: true}).Return(repository.ErrTaskVersionMismatch)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.DeleteTask(context.Background(), &pbTodoList.DeleteTaskRequest{
+					Id:   task.Id.String(),
+					Etag: tools.FormatEtag(2),
+				})
+			},
+			output: ErrStatusTaskEtagMismatch,
+		},
 		{
 			name: "DeleteTask_Success",
 			input: func() (*emptypb.Empty, error) {
@@ -645,9 +1172,9 @@ func TestDeleteTask(t *testing.T) {
 				}
 
 				taskRepository := new(mockRepository.TaskRepository)
-				taskRepository.On("DeleteTask", mock.Anything, task.Id).Return(nil)
+				taskRepository.On("DeleteTask", mock.Anything, task.Id, sql.NullInt64{}).Return(nil)
 
-				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, nil, nil)))
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -689,7 +1216,7 @@ func TestUpdateTaskStatus(t *testing.T) {
 		{
 			name: "UpdateTask_ErrGetValidUUID",
 			input: func() (*emptypb.Empty, error) {
-				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(nil, nil, nil)))
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -713,7 +1240,7 @@ func TestUpdateTaskStatus(t *testing.T) {
 
 				taskRepository.On("GetTask", mock.Anything, tx.Id).Return(nil, repository.ErrTaskNotFound)
 
-				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, nil, nil)))
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -736,9 +1263,9 @@ func TestUpdateTaskStatus(t *testing.T) {
 				}
 
 				taskRepository.On("GetTask", mock.Anything, tx.Id).Return(&tx, nil)
-				taskRepository.On("UpdateTask", mock.Anything, &tx).Return(nil, repository.ErrTaskNotFound)
+				taskRepository.On("UpdateTask", mock.Anything, &tx, mock.Anything).Return(nil, repository.ErrTaskNotFound)
 
-				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, nil, nil)))
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -752,6 +1279,86 @@ func TestUpdateTaskStatus(t *testing.T) {
 			},
 			output: ErrStatusTaskNotFound,
 		},
+		{
+			name: "UpdateTaskStatus_ErrStatusTaskEtagMismatch",
+			input: func() (*emptypb.Empty, error) {
+				taskRepository := new(mockRepository.TaskRepository)
+				tx := model.Task{
+					Id:      uuid.NewV4(),
+					Version: 2,
+				}
+
+				taskRepository.On("GetTask", mock.Anything, tx.Id).Return(&tx, nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.UpdateTaskStatus(context.Background(), &pbTodoList.UpdateTaskStatusRequest{
+					Id:   tx.Id.String(),
+					Etag: tools.FormatEtag(1),
+				})
+			},
+			output: ErrStatusTaskEtagMismatch,
+		},
+		{
+			name: "UpdateTaskStatus_ErrStatusTaskEtagMismatchOnUpdate",
+			input: func() (*emptypb.Empty, error) {
+				taskRepository := new(mockRepository.TaskRepository)
+				tx := model.Task{
+					Id:      uuid.NewV4(),
+					Version: 1,
+				}
+
+				taskRepository.On("GetTask", mock.Anything, tx.Id).Return(&tx, nil)
+				taskRepository.On("UpdateTask", mock.Anything, &tx, mock.Anything).Return(repository.ErrTaskVersionMismatch)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.UpdateTaskStatus(context.Background(), &pbTodoList.UpdateTaskStatusRequest{
+					Id:   tx.Id.String(),
+					Etag: tools.FormatEtag(1),
+				})
+			},
+			output: ErrStatusTaskEtagMismatch,
+		},
+		{
+			name: "UpdateTaskStatus_ErrStatusTaskNotFoundOnUpdate",
+			input: func() (*emptypb.Empty, error) {
+				taskRepository := new(mockRepository.TaskRepository)
+				tx := model.Task{
+					Id:      uuid.NewV4(),
+					Version: 1,
+				}
+
+				taskRepository.On("GetTask", mock.Anything, tx.Id).Return(&tx, nil)
+				taskRepository.On("UpdateTask", mock.Anything, &tx, mock.Anything).Return(repository.ErrTaskNotFound)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.UpdateTaskStatus(context.Background(), &pbTodoList.UpdateTaskStatusRequest{
+					Id:   tx.Id.String(),
+					Etag: tools.FormatEtag(1),
+				})
+			},
+			output: ErrStatusTaskNotFound,
+		},
 		{
 			name: "UpdateTask_ErrStatusInternalServerErrorOnGet",
 			input: func() (*emptypb.Empty, error) {
@@ -762,7 +1369,7 @@ func TestUpdateTaskStatus(t *testing.T) {
 				taskRepository := new(mockRepository.TaskRepository)
 				taskRepository.On("GetTask", mock.Anything, task.Id).Return(nil, errors.New("unknown_error"))
 
-				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, nil, nil)))
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -785,9 +1392,9 @@ func TestUpdateTaskStatus(t *testing.T) {
 
 				taskRepository := new(mockRepository.TaskRepository)
 				taskRepository.On("GetTask", mock.Anything, task.Id).Return(&task, nil)
-				taskRepository.On("UpdateTask", mock.Anything, &task).Return(errors.New("unknown_error"))
+				taskRepository.On("UpdateTask", mock.Anything, &task, mock.Anything).Return(errors.New("unknown_error"))
 
-				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, nil, nil)))
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -810,9 +1417,9 @@ func TestUpdateTaskStatus(t *testing.T) {
 
 				taskRepository := new(mockRepository.TaskRepository)
 				taskRepository.On("GetTask", mock.Anything, task.Id).Return(&task, nil)
-				taskRepository.On("UpdateTask", mock.Anything, &task).Return(errors.New("unknown_error"))
+				taskRepository.On("UpdateTask", mock.Anything, &task, mock.Anything).Return(errors.New("unknown_error"))
 
-				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, nil, nil)))
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -835,9 +1442,9 @@ func TestUpdateTaskStatus(t *testing.T) {
 
 				taskRepository := new(mockRepository.TaskRepository)
 				taskRepository.On("GetTask", mock.Anything, task.Id).Return(&task, nil)
-				taskRepository.On("UpdateTask", mock.Anything, &task).Return(nil)
+				taskRepository.On("UpdateTask", mock.Anything, &task, mock.Anything).Return(nil)
 
-				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(taskRepository, nil, nil)))
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -0,0 +1,101 @@
+package todolist
+
+import (
+	"context"
+	"net/http"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/overridesh/sgg-todolist-service/internal/logging"
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+	"github.com/overridesh/sgg-todolist-service/internal/repository"
+	pbTodoList "github.com/overridesh/sgg-todolist-service/proto"
+	"github.com/overridesh/sgg-todolist-service/tools"
+)
+
+func (svc *todoListGRPC) ListDeletedTasks(ctx context.Context, in *pbTodoList.ListDeletedTasksRequest) (*pbTodoList.ListDeletedTasksResponse, error) {
+	if err := svc.authorizeCaller(ctx); err != nil {
+		return nil, err
+	}
+
+	if in.GetPageSize() < 0 || in.GetPageSize() > maxPageSize {
+		return nil, ErrStatusInvalidPageSize.Err()
+	}
+
+	tasks, err := svc.taskRepository.GetDeletedTasks(ctx, in.GetPage(), uint64(in.GetPageSize()))
+	if err != nil {
+		logging.FromContext(ctx).Error("cannot get deleted tasks", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	response := pbTodoList.ListDeletedTasksResponse{
+		Tasks: []*pbTodoList.Task{},
+	}
+
+	for _, task := range tasks {
+		response.Tasks = append(response.Tasks, getTaskResponse(task))
+	}
+
+	return &response, nil
+}
+
+func (svc *todoListGRPC) RestoreTask(ctx context.Context, in *pbTodoList.RestoreTaskRequest) (*pbTodoList.RestoreTaskResponse, error) {
+	taskId, err := tools.GetValidUUID(in.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = svc.authorizeDeleted(ctx, taskId, model.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := svc.taskRepository.RestoreTask(ctx, taskId)
+	if err != nil {
+		if err == repository.ErrTaskNotFound {
+			return nil, ErrStatusTaskNotFound.Err()
+		}
+		if err == repository.ErrParentTaskDeleted {
+			return nil, ErrStatusParentTaskDeleted.Err()
+		}
+		logging.FromContext(ctx).Error("cannot restore task", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	if err := tools.SetEtag(ctx, tools.FormatEtag(task.Version)); err != nil {
+		logging.FromContext(ctx).Error("cannot set etag", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	return &pbTodoList.RestoreTaskResponse{
+		Task: getTaskResponse(task),
+	}, nil
+}
+
+func (svc *todoListGRPC) PurgeTask(ctx context.Context, in *pbTodoList.PurgeTaskRequest) (*emptypb.Empty, error) {
+	taskId, err := tools.GetValidUUID(in.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = svc.authorizeDeleted(ctx, taskId, model.RoleOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := svc.taskRepository.PurgeTask(ctx, taskId); err != nil {
+		if err == repository.ErrTaskNotFound {
+			return nil, ErrStatusTaskNotFound.Err()
+		}
+		logging.FromContext(ctx).Error("cannot purge task", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	if err := tools.SetStatusCode(ctx, http.StatusNoContent); err != nil {
+		logging.FromContext(ctx).Error("cannot set new status_code", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,407 @@
+package todolist
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"testing"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+	"github.com/overridesh/sgg-todolist-service/internal/repository"
+	mockRepository "github.com/overridesh/sgg-todolist-service/pkg/mock"
+	pbTodoList "github.com/overridesh/sgg-todolist-service/proto"
+	"github.com/overridesh/sgg-todolist-service/tools"
+)
+
+// deletedSharedWith returns the members of a deleted task of taskOwner that was shared with the caller with the role
+func deletedSharedWith(role string) *mockRepository.MemberRepository {
+	memberRepository := new(mockRepository.MemberRepository)
+	memberRepository.On("GetDeletedTaskAccess", mock.Anything, mock.Anything).Return(&model.TaskAccess{
+		OwnerId: taskOwner,
+		Role:    role,
+	}, nil)
+	return memberRepository
+}
+
+func TestListDeletedTasks(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  func() (*pbTodoList.ListDeletedTasksResponse, error)
+		output *status.Status
+	}{
+		{
+			name: "ListDeletedTasks_Success",
+			input: func() (*pbTodoList.ListDeletedTasksResponse, error) {
+				task := model.Task{
+					Id: uuid.NewV4(),
+					DeletedAt: sql.NullTime{
+						Time:  time.Now(),
+						Valid: true,
+					},
+				}
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetDeletedTasks", mock.Anything, int32(2), uint64(10)).Return([]*model.Task{&task}, nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				response, err := client.ListDeletedTasks(context.Background(), &pbTodoList.ListDeletedTasksRequest{
+					Page:     2,
+					PageSize: 10,
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				if len(response.GetTasks()) != 1 || response.GetTasks()[0].GetDeletedAt() != tools.FormatDate(task.DeletedAt.Time) {
+					t.Errorf("expect one deleted task, but got %v", response)
+				}
+
+				return response, nil
+			},
+			output: nil,
+		},
+		{
+			name: "ListDeletedTasks_ErrStatusInvalidPageSize",
+			input: func() (*pbTodoList.ListDeletedTasksResponse, error) {
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.ListDeletedTasks(context.Background(), &pbTodoList.ListDeletedTasksRequest{
+					PageSize: 1000,
+				})
+			},
+			output: ErrStatusInvalidPageSize,
+		},
+		{
+			name: "ListDeletedTasks_ErrStatusInternalServerError",
+			input: func() (*pbTodoList.ListDeletedTasksResponse, error) {
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("GetDeletedTasks", mock.Anything, int32(0), uint64(0)).Return(nil, errors.New("unknown_error"))
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.ListDeletedTasks(context.Background(), &pbTodoList.ListDeletedTasksRequest{})
+			},
+			output: ErrStatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.input()
+			if err != nil {
+				if er, ok := status.FromError(err); ok {
+					if er.Code() != tt.output.Code() {
+						t.Errorf("error code: expected %v, received %v", codes.InvalidArgument, er.Code())
+					}
+					if er.Message() != tt.output.Message() {
+						t.Errorf("error message: expected %v, received %v", tt.output.Message(), er.Message())
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestRestoreTask(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  func() (*pbTodoList.RestoreTaskResponse, error)
+		output *status.Status
+	}{
+		{
+			name: "RestoreTask_ErrGetValidUUID",
+			input: func() (*pbTodoList.RestoreTaskResponse, error) {
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.RestoreTask(context.Background(), &pbTodoList.RestoreTaskRequest{
+					Id: "ASD",
+				})
+			},
+			output: tools.ErrStatusIdMustBeUUID,
+		},
+		{
+			name: "RestoreTask_ErrStatusTaskNotFound",
+			input: func() (*pbTodoList.RestoreTaskResponse, error) {
+				id := uuid.NewV4()
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("RestoreTask", mock.Anything, id).Return(nil, repository.ErrTaskNotFound)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.RestoreTask(context.Background(), &pbTodoList.RestoreTaskRequest{
+					Id: id.String(),
+				})
+			},
+			output: ErrStatusTaskNotFound,
+		},
+		{
+			name: "RestoreTask_ErrStatusParentTaskDeleted",
+			input: func() (*pbTodoList.RestoreTaskResponse, error) {
+				id := uuid.NewV4()
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("RestoreTask", mock.Anything, id).Return(nil, repository.ErrParentTaskDeleted)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.RestoreTask(context.Background(), &pbTodoList.RestoreTaskRequest{
+					Id: id.String(),
+				})
+			},
+			output: ErrStatusParentTaskDeleted,
+		},
+		{
+			name: "RestoreTask_ErrStatusPermissionDenied",
+			input: func() (*pbTodoList.RestoreTaskResponse, error) {
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{MemberRepository: deletedSharedWith(model.RoleCommenter)})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.RestoreTask(context.Background(), &pbTodoList.RestoreTaskRequest{
+					Id: uuid.NewV4().String(),
+				})
+			},
+			output: ErrStatusPermissionDenied,
+		},
+		{
+			name: "RestoreTask_SuccessAsEditor",
+			input: func() (*pbTodoList.RestoreTaskResponse, error) {
+				task := model.Task{
+					Id:      uuid.NewV4(),
+					Version: 2,
+				}
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("RestoreTask", asOwner, task.Id).Return(&task, nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository, MemberRepository: deletedSharedWith(model.RoleEditor)})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.RestoreTask(context.Background(), &pbTodoList.RestoreTaskRequest{
+					Id: task.Id.String(),
+				})
+			},
+			output: nil,
+		},
+		{
+			name: "RestoreTask_Success",
+			input: func() (*pbTodoList.RestoreTaskResponse, error) {
+				task := model.Task{
+					Id:      uuid.NewV4(),
+					Version: 4,
+				}
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("RestoreTask", mock.Anything, task.Id).Return(&task, nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				response, err := client.RestoreTask(context.Background(), &pbTodoList.RestoreTaskRequest{
+					Id: task.Id.String(),
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				if response.GetTask().GetId() != task.Id.String() || response.GetTask().GetEtag() != tools.FormatEtag(task.Version) {
+					t.Errorf("expect the restored task, but got %v", response)
+				}
+
+				return response, nil
+			},
+			output: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.input()
+			if err != nil {
+				if er, ok := status.FromError(err); ok {
+					if er.Code() != tt.output.Code() {
+						t.Errorf("error code: expected %v, received %v", codes.InvalidArgument, er.Code())
+					}
+					if er.Message() != tt.output.Message() {
+						t.Errorf("error message: expected %v, received %v", tt.output.Message(), er.Message())
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestPurgeTask(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  func() error
+		output *status.Status
+	}{
+		{
+			name: "PurgeTask_ErrStatusTaskNotFound",
+			input: func() error {
+				id := uuid.NewV4()
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("PurgeTask", mock.Anything, id).Return(repository.ErrTaskNotFound)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				_, err = client.PurgeTask(context.Background(), &pbTodoList.PurgeTaskRequest{
+					Id: id.String(),
+				})
+				return err
+			},
+			output: ErrStatusTaskNotFound,
+		},
+		{
+			name: "PurgeTask_ErrStatusInternalServerError",
+			input: func() error {
+				id := uuid.NewV4()
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("PurgeTask", mock.Anything, id).Return(errors.New("unknown_error"))
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				_, err = client.PurgeTask(context.Background(), &pbTodoList.PurgeTaskRequest{
+					Id: id.String(),
+				})
+				return err
+			},
+			output: ErrStatusInternalServerError,
+		},
+		{
+			name: "PurgeTask_ErrStatusPermissionDenied",
+			input: func() error {
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{MemberRepository: deletedSharedWith(model.RoleEditor)})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				_, err = client.PurgeTask(context.Background(), &pbTodoList.PurgeTaskRequest{
+					Id: uuid.NewV4().String(),
+				})
+				return err
+			},
+			output: ErrStatusPermissionDenied,
+		},
+		{
+			name: "PurgeTask_Success",
+			input: func() error {
+				id := uuid.NewV4()
+
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("PurgeTask", mock.Anything, id).Return(nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				_, err = client.PurgeTask(context.Background(), &pbTodoList.PurgeTaskRequest{
+					Id: id.String(),
+				})
+				return err
+			},
+			output: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.input()
+			if err != nil {
+				if tt.output == nil {
+					t.Fatalf("expect no error, but got %v", err)
+				}
+				if er, ok := status.FromError(err); ok {
+					if er.Code() != tt.output.Code() {
+						t.Errorf("error code: expected %v, received %v", tt.output.Code(), er.Code())
+					}
+					if er.Message() != tt.output.Message() {
+						t.Errorf("error message: expected %v, received %v", tt.output.Message(), er.Message())
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package todolist
+
+import (
+	"context"
+
+	uuid "github.com/satori/go.uuid"
+	"go.uber.org/zap"
+
+	"github.com/overridesh/sgg-todolist-service/internal/auth"
+	"github.com/overridesh/sgg-todolist-service/internal/broker"
+	"github.com/overridesh/sgg-todolist-service/internal/logging"
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+	"github.com/overridesh/sgg-todolist-service/internal/taskevent"
+	pbTodoList "github.com/overridesh/sgg-todolist-service/proto"
+	"github.com/overridesh/sgg-todolist-service/tools"
+)
+
+// watchReplayBatchSize is how many events are read at a time when a watch is resumed
+const watchReplayBatchSize uint64 = 100
+
+// watchFilter keeps the events of a watch that are about the tasks of its caller, and about its task or its label
+type watchFilter struct {
+	identity *auth.Identity
+	taskId   uuid.UUID
+	label    string
+	// labeled tells which of the tasks seen so far have the label
+	labeled map[uuid.UUID]bool
+}
+
+func (svc *todoListGRPC) WatchTasks(in *pbTodoList.WatchTasksRequest, stream pbTodoList.TodoListService_WatchTasksServer) error {
+	ctx := stream.Context()
+
+	identity, ok := auth.FromContext(ctx)
+	if !ok {
+		return ErrStatusUnauthenticated.Err()
+	}
+
+	filter := watchFilter{
+		identity: identity,
+		label:    normalizeLabel(in.GetLabel()),
+		labeled:  map[uuid.UUID]bool{},
+	}
+
+	if len(in.GetTaskId()) > 0 {
+		taskId, err := tools.GetValidUUID(in.GetTaskId())
+		if err != nil {
+			return err
+		}
+
+		// The members of a shared task watch the events written by its owner
+		taskCtx, err := svc.authorize(ctx, taskId, model.RoleViewer)
+		if err != nil {
+			return err
+		}
+
+		filter.identity, _ = auth.FromContext(taskCtx)
+		filter.taskId = taskId
+	}
+
+	if in.GetAfterSequence() < 0 {
+		return ErrStatusInvalidAfterSequence.Err()
+	}
+
+	// Subscribe before the replay, so the events written in the meantime are not lost
+	subscription := svc.subscriber.Subscribe()
+	defer subscription.Close()
+
+	// The headers tell the client the watch was accepted, before any event is sent
+	if err := tools.SetWatchAccepted(stream); err != nil {
+		return err
+	}
+
+	// The live events can repeat the replayed ones. Both come in the order of the outbox, so the live events up to the
+	// last replayed one are all repeats and the ones after it were never replayed.
+	var replayed *model.Event
+
+	for after := in.GetAfterSequence(); after > 0; {
+		events, err := svc.eventRepository.GetEventsAfter(ctx, after, watchReplayBatchSize)
+		if err != nil {
+			logging.FromContext(ctx).Error("cannot get events", zap.Error(err))
+			return ErrStatusInternalServerError.Err()
+		}
+
+		for _, event := range events {
+			replayed = event
+			if err := svc.sendTaskEvent(stream, &filter, event); err != nil {
+				return err
+			}
+		}
+
+		if uint64(len(events)) < watchReplayBatchSize {
+			break
+		}
+		after = events[len(events)-1].Sequence
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-subscription.Events():
+			if !ok {
+				if subscription.Err() == broker.ErrSlowSubscription {
+					return ErrStatusWatchFellBehind.Err()
+				}
+				return ErrStatusWatchUnavailable.Err()
+			}
+
+			if replayed != nil && !event.After(replayed) {
+				continue
+			}
+
+			if err := svc.sendTaskEvent(stream, &filter, event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// sendTaskEvent sends an event to the stream when it passes the filter
+func (svc *todoListGRPC) sendTaskEvent(stream pbTodoList.TodoListService_WatchTasksServer, filter *watchFilter, event *model.Event) error {
+	ok, err := svc.watchMatches(stream.Context(), filter, event)
+	if err != nil {
+		logging.FromContext(stream.Context()).Error("cannot filter event", zap.Error(err))
+		return ErrStatusInternalServerError.Err()
+	}
+
+	if !ok {
+		return nil
+	}
+
+	response, err := taskevent.Response(event)
+	if err != nil {
+		logging.FromContext(stream.Context()).Error("cannot read event", zap.Int64("sequence", event.Sequence), zap.Error(err))
+		return nil
+	}
+
+	return stream.Send(response)
+}
+
+// watchMatches tells if an event passes the filter. The label events pass when the task has the label before or after
+// them, so the watch sees the label being added and removed.
+func (svc *todoListGRPC) watchMatches(ctx context.Context, filter *watchFilter, event *model.Event) (bool, error) {
+	if event.OwnerId != filter.identity.Subject || event.TenantId != filter.identity.TenantId {
+		return false, nil
+	}
+
+	if filter.taskId != uuid.Nil && filter.taskId != event.TaskId {
+		return false, nil
+	}
+
+	if len(filter.label) == 0 {
+		return true, nil
+	}
+
+	labeled, ok := filter.labeled[event.TaskId]
+	if ok && event.Type != model.EventLabelCreated && event.Type != model.EventLabelDeleted {
+		return labeled, nil
+	}
+
+	labels, err := svc.labelRepository.GetLabelsByTaskId(auth.NewContext(ctx, filter.identity), event.TaskId)
+	if err != nil {
+		return false, err
+	}
+
+	filter.labeled[event.TaskId] = false
+	for _, label := range labels {
+		if label.Value == filter.label {
+			filter.labeled[event.TaskId] = true
+		}
+	}
+
+	return labeled || filter.labeled[event.TaskId], nil
+}
@@ -0,0 +1,250 @@
+package todolist
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/overridesh/sgg-todolist-service/internal/auth"
+	"github.com/overridesh/sgg-todolist-service/internal/broker"
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+	mockRepository "github.com/overridesh/sgg-todolist-service/pkg/mock"
+	pbTodoList "github.com/overridesh/sgg-todolist-service/proto"
+)
+
+// newStoredEvent returns an event as it is read back from the outbox, written by the default identity of the test server
+func newStoredEvent(sequence int64, eventType string, taskId uuid.UUID, data interface{}) *model.Event {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	event := model.NewEvent(eventType, taskId, json.RawMessage(raw))
+	event.Sequence = sequence
+	event.OwnerId = auth.DefaultSubject
+	event.TenantId = auth.DefaultTenant
+	return &event
+}
+
+// newSubscription returns a subscription that receives the events and then ends with err
+func newSubscription(err error, events ...*model.Event) *mockRepository.Subscription {
+	channel := make(chan *model.Event, len(events))
+	for _, event := range events {
+		channel <- event
+	}
+	close(channel)
+
+	subscription := new(mockRepository.Subscription)
+	subscription.On("Events").Return((<-chan *model.Event)(channel))
+	subscription.On("Err").Return(err)
+	subscription.On("Close").Return()
+	return subscription
+}
+
+func newSubscriber(subscription broker.Subscription) *mockRepository.Subscriber {
+	subscriber := new(mockRepository.Subscriber)
+	subscriber.On("Subscribe").Return(subscription)
+	return subscriber
+}
+
+// watch returns the events sent to a WatchTasks stream until it ends, and the error it ended with
+func watch(t *testing.T, conn *grpc.ClientConn, in *pbTodoList.WatchTasksRequest) ([]*pbTodoList.TaskEvent, error) {
+	stream, err := pbTodoList.NewTodoListServiceClient(conn).WatchTasks(context.Background(), in)
+	if err != nil {
+		t.Fatalf("expect no error, but got %v", err)
+	}
+
+	var events []*pbTodoList.TaskEvent
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			return events, err
+		}
+		events = append(events, event)
+	}
+}
+
+func TestWatchTasks(t *testing.T) {
+	t.Run("WatchTasks_ResumeFromSequence", func(t *testing.T) {
+		taskId := uuid.NewV4()
+		commentId := uuid.NewV4()
+
+		replayed := newStoredEvent(4, model.EventTaskCreated, taskId, model.TaskData{Id: taskId, Value: "task", Version: 1})
+		live := newStoredEvent(5, model.EventCommentDeleted, taskId, model.DeletedData{Id: commentId})
+
+		eventRepository := new(mockRepository.EventRepository)
+		eventRepository.On("GetEventsAfter", mock.Anything, int64(3), watchReplayBatchSize).Return([]*model.Event{replayed}, nil)
+
+		// The replayed event is also received live, it is not sent twice
+		subscriber := newSubscriber(newSubscription(broker.ErrBrokerStopped, replayed, live))
+
+		conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{EventRepository: eventRepository, Subscriber: subscriber})))
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer conn.Close()
+
+		events, err := watch(t, conn, &pbTodoList.WatchTasksRequest{AfterSequence: 3})
+		if er, ok := status.FromError(err); !ok || er.Code() != ErrStatusWatchUnavailable.Code() {
+			t.Errorf("expect %v, but got %v", ErrStatusWatchUnavailable.Code(), err)
+		}
+
+		if len(events) != 2 {
+			t.Fatalf("expect 2 events, but got %d", len(events))
+		}
+
+		if events[0].GetSequence() != 4 || events[0].GetTask().GetValue() != "task" || events[0].GetTask().GetEtag() == "" {
+			t.Errorf("expect the task created event, but got %v", events[0])
+		}
+
+		if events[1].GetSequence() != 5 || events[1].GetDeletedId() != commentId.String() {
+			t.Errorf("expect the comment deleted event, but got %v", events[1])
+		}
+	})
+
+	t.Run("WatchTasks_ResumeInOutboxOrder", func(t *testing.T) {
+		taskId := uuid.NewV4()
+
+		replayed := newStoredEvent(6, model.EventTaskCreated, taskId, model.TaskData{Id: taskId, Value: "task", Version: 1})
+		replayed.TransactionId = 100
+
+		// The event 5 was written before but its transaction ended later, it comes after the replayed one
+		late := newStoredEvent(5, model.EventTaskUpdated, taskId, model.TaskData{Id: taskId, Value: "late", Version: 2})
+		late.TransactionId = 101
+
+		eventRepository := new(mockRepository.EventRepository)
+		eventRepository.On("GetEventsAfter", mock.Anything, int64(3), watchReplayBatchSize).Return([]*model.Event{replayed}, nil)
+
+		subscriber := newSubscriber(newSubscription(broker.ErrBrokerStopped, replayed, late))
+
+		conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{EventRepository: eventRepository, Subscriber: subscriber})))
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer conn.Close()
+
+		events, _ := watch(t, conn, &pbTodoList.WatchTasksRequest{AfterSequence: 3})
+
+		if len(events) != 2 || events[0].GetSequence() != 6 || events[1].GetSequence() != 5 {
+			t.Errorf("expect the events 6 and 5, but got %v", events)
+		}
+	})
+
+	t.Run("WatchTasks_FilterByTaskId", func(t *testing.T) {
+		task := model.Task{Id: uuid.NewV4()}
+		otherTaskId := uuid.NewV4()
+
+		taskRepository := new(mockRepository.TaskRepository)
+		taskRepository.On("GetTask", mock.Anything, task.Id).Return(&task, nil)
+
+		subscriber := newSubscriber(newSubscription(broker.ErrBrokerStopped,
+			newStoredEvent(1, model.EventTaskDeleted, otherTaskId, model.DeletedData{Id: otherTaskId}),
+			newStoredEvent(2, model.EventLabelCreated, task.Id, model.LabelData{Id: uuid.NewV4(), Name: "home"}),
+		))
+
+		conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{TaskRepository: taskRepository, Subscriber: subscriber})))
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer conn.Close()
+
+		events, _ := watch(t, conn, &pbTodoList.WatchTasksRequest{TaskId: task.Id.String()})
+
+		if len(events) != 1 || events[0].GetLabel().GetName() != "home" {
+			t.Errorf("expect only the label created event, but got %v", events)
+		}
+	})
+
+	t.Run("WatchTasks_FilterByLabel", func(t *testing.T) {
+		labeledTaskId := uuid.NewV4()
+		otherTaskId := uuid.NewV4()
+
+		labelRepository := new(mockRepository.LabelRepository)
+		labelRepository.On("GetLabelsByTaskId", mock.Anything, labeledTaskId).Return([]*model.Label{{Value: "home"}}, nil).Once()
+		labelRepository.On("GetLabelsByTaskId", mock.Anything, otherTaskId).Return([]*model.Label{{Value: "work"}}, nil).Once()
+
+		subscriber := newSubscriber(newSubscription(broker.ErrBrokerStopped,
+			newStoredEvent(1, model.EventTaskUpdated, labeledTaskId, model.TaskData{Id: labeledTaskId}),
+			newStoredEvent(2, model.EventTaskUpdated, otherTaskId, model.TaskData{Id: otherTaskId}),
+			newStoredEvent(3, model.EventTaskCompleted, labeledTaskId, model.TaskData{Id: labeledTaskId, Completed: true}),
+		))
+
+		conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{LabelRepository: labelRepository, Subscriber: subscriber})))
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer conn.Close()
+
+		// The label of the filter is lowercased like the stored labels
+		events, _ := watch(t, conn, &pbTodoList.WatchTasksRequest{Label: " Home "})
+
+		if len(events) != 2 || events[0].GetSequence() != 1 || events[1].GetSequence() != 3 {
+			t.Errorf("expect the events 1 and 3, but got %v", events)
+		}
+
+		// The labels of a task are read once while they do not change
+		labelRepository.AssertExpectations(t)
+	})
+
+	t.Run("WatchTasks_OnlyOwnEvents", func(t *testing.T) {
+		taskId := uuid.NewV4()
+
+		otherOwner := newStoredEvent(1, model.EventTaskDeleted, taskId, model.DeletedData{Id: taskId})
+		otherOwner.OwnerId = "user-2"
+
+		otherTenant := newStoredEvent(2, model.EventTaskDeleted, taskId, model.DeletedData{Id: taskId})
+		otherTenant.TenantId = "tenant-2"
+
+		subscriber := newSubscriber(newSubscription(broker.ErrBrokerStopped,
+			otherOwner,
+			otherTenant,
+			newStoredEvent(3, model.EventTaskDeleted, taskId, model.DeletedData{Id: taskId}),
+		))
+
+		conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{Subscriber: subscriber})))
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer conn.Close()
+
+		events, _ := watch(t, conn, &pbTodoList.WatchTasksRequest{})
+
+		if len(events) != 1 || events[0].GetSequence() != 3 {
+			t.Errorf("expect only the event 3, but got %v", events)
+		}
+	})
+
+	t.Run("WatchTasks_FellBehind", func(t *testing.T) {
+		subscriber := newSubscriber(newSubscription(broker.ErrSlowSubscription))
+
+		conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{Subscriber: subscriber})))
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer conn.Close()
+
+		_, err = watch(t, conn, &pbTodoList.WatchTasksRequest{})
+		if er, ok := status.FromError(err); !ok || er.Code() != ErrStatusWatchFellBehind.Code() {
+			t.Errorf("expect %v, but got %v", ErrStatusWatchFellBehind.Code(), err)
+		}
+	})
+
+	t.Run("WatchTasks_InvalidAfterSequence", func(t *testing.T) {
+		conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{})))
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer conn.Close()
+
+		_, err = watch(t, conn, &pbTodoList.WatchTasksRequest{AfterSequence: -1})
+		if er, ok := status.FromError(err); !ok || er.Message() != ErrStatusInvalidAfterSequence.Message() {
+			t.Errorf("expect %v, but got %v", ErrStatusInvalidAfterSequence.Message(), err)
+		}
+	})
+}
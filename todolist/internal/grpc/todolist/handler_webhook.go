@@ -0,0 +1,257 @@
+package todolist
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/overridesh/sgg-todolist-service/internal/logging"
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+	"github.com/overridesh/sgg-todolist-service/internal/repository"
+	"github.com/overridesh/sgg-todolist-service/internal/webhook"
+	pbTodoList "github.com/overridesh/sgg-todolist-service/proto"
+	"github.com/overridesh/sgg-todolist-service/tools"
+)
+
+var (
+	deliveryStatuses map[pbTodoList.WebhookDeliveryStatus]string = map[pbTodoList.WebhookDeliveryStatus]string{
+		pbTodoList.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED: "",
+		pbTodoList.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING:     model.WebhookDeliveryPending,
+		pbTodoList.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DELIVERED:   model.WebhookDeliveryDelivered,
+		pbTodoList.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD:        model.WebhookDeliveryDead,
+	}
+)
+
+func (svc *todoListGRPC) ListWebhooks(ctx context.Context, in *pbTodoList.ListWebhooksRequest) (*pbTodoList.ListWebhooksResponse, error) {
+	if err := svc.authorizeCaller(ctx); err != nil {
+		return nil, err
+	}
+
+	webhooks, err := svc.webhookRepository.GetWebhooks(ctx)
+	if err != nil {
+		logging.FromContext(ctx).Error("cannot get webhooks", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	response := pbTodoList.ListWebhooksResponse{
+		Webhooks: []*pbTodoList.Webhook{},
+	}
+
+	for _, webhook := range webhooks {
+		response.Webhooks = append(response.Webhooks, getWebhookResponse(webhook))
+	}
+
+	return &response, nil
+}
+
+func (svc *todoListGRPC) CreateWebhook(ctx context.Context, in *pbTodoList.CreateWebhookRequest) (*pbTodoList.CreateWebhookResponse, error) {
+	if err := svc.authorizeCaller(ctx); err != nil {
+		return nil, err
+	}
+
+	webhookURL, err := url.Parse(strings.TrimSpace(in.GetUrl()))
+	if err != nil || (webhookURL.Scheme != "http" && webhookURL.Scheme != "https") || webhookURL.Host == "" {
+		return nil, ErrStatusInvalidWebhookURL.Err()
+	}
+
+	if svc.webhookGuard != nil {
+		if err := svc.webhookGuard.CheckURL(ctx, webhookURL); err != nil {
+			if err == webhook.ErrPrivateAddress {
+				return nil, ErrStatusPrivateWebhookURL.Err()
+			}
+			return nil, ErrStatusInvalidWebhookURL.Err()
+		}
+	}
+
+	if len(in.GetSecret()) == 0 {
+		return nil, ErrStatusWebhookSecretRequired.Err()
+	}
+
+	var events []string = []string{}
+
+	for _, event := range in.GetEvents() {
+		if !model.EventTypes[event] {
+			return nil, ErrStatusInvalidWebhookEvent.Err()
+		}
+		events = append(events, event)
+	}
+
+	webhook, err := svc.webhookRepository.CreateWebhook(ctx, model.Webhook{
+		Url:    webhookURL.String(),
+		Secret: in.GetSecret(),
+		Events: events,
+	})
+	if err != nil {
+		logging.FromContext(ctx).Error("cannot create webhook", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	if err := tools.SetStatusCode(ctx, http.StatusCreated); err != nil {
+		logging.FromContext(ctx).Error("cannot set new status_code", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	return &pbTodoList.CreateWebhookResponse{
+		Webhook: getWebhookResponse(webhook),
+	}, nil
+}
+
+func (svc *todoListGRPC) DeleteWebhook(ctx context.Context, in *pbTodoList.DeleteWebhookRequest) (*emptypb.Empty, error) {
+	if err := svc.authorizeCaller(ctx); err != nil {
+		return nil, err
+	}
+
+	webhookId, err := tools.GetValidUUID(in.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := svc.webhookRepository.DeleteWebhook(ctx, webhookId); err != nil {
+		if err == repository.ErrWebhookNotFound {
+			return nil, ErrStatusWebhookNotFound.Err()
+		}
+		logging.FromContext(ctx).Error("cannot delete webhook", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	if err := tools.SetStatusCode(ctx, http.StatusNoContent); err != nil {
+		logging.FromContext(ctx).Error("cannot set new status_code", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (svc *todoListGRPC) ListWebhookDeliveries(ctx context.Context, in *pbTodoList.ListWebhookDeliveriesRequest) (*pbTodoList.ListWebhookDeliveriesResponse, error) {
+	if err := svc.authorizeCaller(ctx); err != nil {
+		return nil, err
+	}
+
+	webhookId, err := tools.GetValidUUID(in.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	status, ok := deliveryStatuses[in.GetStatus()]
+	if !ok {
+		return nil, ErrStatusInvalidDeliveryStatus.Err()
+	}
+
+	if in.GetPageSize() < 0 || in.GetPageSize() > maxPageSize {
+		return nil, ErrStatusInvalidPageSize.Err()
+	}
+
+	pageSize := in.GetPageSize()
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+
+	webhook, err := svc.webhookRepository.GetWebhook(ctx, webhookId)
+	if err != nil {
+		if err == repository.ErrWebhookNotFound {
+			return nil, ErrStatusWebhookNotFound.Err()
+		}
+		logging.FromContext(ctx).Error("cannot get webhook", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	deliveries, err := svc.webhookRepository.GetDeliveries(ctx, webhook.Id, status, uint64(pageSize))
+	if err != nil {
+		logging.FromContext(ctx).Error("cannot get webhook deliveries", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	response := pbTodoList.ListWebhookDeliveriesResponse{
+		Deliveries: []*pbTodoList.WebhookDelivery{},
+	}
+
+	for _, delivery := range deliveries {
+		response.Deliveries = append(response.Deliveries, getWebhookDeliveryResponse(delivery))
+	}
+
+	return &response, nil
+}
+
+func (svc *todoListGRPC) RedeliverWebhookDelivery(ctx context.Context, in *pbTodoList.RedeliverWebhookDeliveryRequest) (*emptypb.Empty, error) {
+	if err := svc.authorizeCaller(ctx); err != nil {
+		return nil, err
+	}
+
+	webhookId, err := tools.GetValidUUID(in.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	deliveryId, err := tools.GetValidUUID(in.GetDeliveryId())
+	if err != nil {
+		return nil, err
+	}
+
+	webhook, err := svc.webhookRepository.GetWebhook(ctx, webhookId)
+	if err != nil {
+		if err == repository.ErrWebhookNotFound {
+			return nil, ErrStatusWebhookNotFound.Err()
+		}
+		logging.FromContext(ctx).Error("cannot get webhook", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	if err := svc.webhookRepository.RedeliverDelivery(ctx, webhook.Id, deliveryId); err != nil {
+		if err == repository.ErrWebhookDeliveryNotFound {
+			return nil, ErrStatusWebhookDeliveryNotFound.Err()
+		}
+		logging.FromContext(ctx).Error("cannot redeliver webhook delivery", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	if err := tools.SetStatusCode(ctx, http.StatusAccepted); err != nil {
+		logging.FromContext(ctx).Error("cannot set new status_code", zap.Error(err))
+		return nil, ErrStatusInternalServerError.Err()
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// getWebhookResponse map a webhook from the repository into the protobuf webhook, without its secret
+func getWebhookResponse(webhook *model.Webhook) *pbTodoList.Webhook {
+	return &pbTodoList.Webhook{
+		Id:        webhook.Id.String(),
+		Url:       webhook.Url,
+		Events:    webhook.Events,
+		CreatedAt: tools.FormatDate(webhook.CreatedAt),
+	}
+}
+
+// getWebhookDeliveryResponse map a delivery from the repository into the protobuf delivery
+func getWebhookDeliveryResponse(delivery *model.WebhookDelivery) *pbTodoList.WebhookDelivery {
+	response := pbTodoList.WebhookDelivery{
+		Id:           delivery.Id.String(),
+		WebhookId:    delivery.WebhookId.String(),
+		Event:        delivery.Event,
+		Payload:      string(delivery.Payload),
+		Attempts:     delivery.Attempts,
+		ResponseCode: delivery.ResponseCode.Int32,
+		LastError:    delivery.LastError.String,
+		CreatedAt:    tools.FormatDate(delivery.CreatedAt),
+	}
+
+	for key, value := range deliveryStatuses {
+		if value == delivery.Status && key != pbTodoList.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED {
+			response.Status = key
+		}
+	}
+
+	if delivery.Status == model.WebhookDeliveryPending {
+		response.NextAttemptAt = tools.FormatDate(delivery.NextAttemptAt)
+	}
+
+	if delivery.DeliveredAt.Valid {
+		response.DeliveredAt = tools.FormatDate(delivery.DeliveredAt.Time)
+	}
+
+	return &response
+}
@@ -0,0 +1,494 @@
+package todolist
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net"
+	"testing"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+	"github.com/overridesh/sgg-todolist-service/internal/repository"
+	"github.com/overridesh/sgg-todolist-service/internal/webhook"
+	mockRepository "github.com/overridesh/sgg-todolist-service/pkg/mock"
+	pbTodoList "github.com/overridesh/sgg-todolist-service/proto"
+	"github.com/overridesh/sgg-todolist-service/tools"
+)
+
+func TestCreateWebhook(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  func() (*pbTodoList.CreateWebhookResponse, error)
+		output *status.Status
+	}{
+		{
+			name: "CreateWebhook_ErrStatusInvalidWebhookURL",
+			input: func() (*pbTodoList.CreateWebhookResponse, error) {
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.CreateWebhook(context.Background(), &pbTodoList.CreateWebhookRequest{
+					Url:    "ftp://example.com/hooks",
+					Secret: "s3cr3t",
+				})
+			},
+			output: ErrStatusInvalidWebhookURL,
+		},
+		{
+			name: "CreateWebhook_ErrStatusPrivateWebhookURL",
+			input: func() (*pbTodoList.CreateWebhookResponse, error) {
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{WebhookGuard: webhook.NewGuard(net.DefaultResolver)})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.CreateWebhook(context.Background(), &pbTodoList.CreateWebhookRequest{
+					Url:    "http://169.254.169.254/latest/meta-data",
+					Secret: "s3cr3t",
+				})
+			},
+			output: ErrStatusPrivateWebhookURL,
+		},
+		{
+			name: "CreateWebhook_ErrStatusWebhookSecretRequired",
+			input: func() (*pbTodoList.CreateWebhookResponse, error) {
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.CreateWebhook(context.Background(), &pbTodoList.CreateWebhookRequest{
+					Url: "https://example.com/hooks",
+				})
+			},
+			output: ErrStatusWebhookSecretRequired,
+		},
+		{
+			name: "CreateWebhook_ErrStatusInvalidWebhookEvent",
+			input: func() (*pbTodoList.CreateWebhookResponse, error) {
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.CreateWebhook(context.Background(), &pbTodoList.CreateWebhookRequest{
+					Url:    "https://example.com/hooks",
+					Secret: "s3cr3t",
+					Events: []string{model.EventTaskCreated, "task.archived"},
+				})
+			},
+			output: ErrStatusInvalidWebhookEvent,
+		},
+		{
+			name: "CreateWebhook_ErrStatusInternalServerError",
+			input: func() (*pbTodoList.CreateWebhookResponse, error) {
+				webhookRepository := new(mockRepository.WebhookRepository)
+				webhookRepository.On("CreateWebhook", mock.Anything, mock.Anything).Return(nil, errors.New("unknown_error"))
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{WebhookRepository: webhookRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.CreateWebhook(context.Background(), &pbTodoList.CreateWebhookRequest{
+					Url:    "https://example.com/hooks",
+					Secret: "s3cr3t",
+				})
+			},
+			output: ErrStatusInternalServerError,
+		},
+		{
+			name: "CreateWebhook_Success",
+			input: func() (*pbTodoList.CreateWebhookResponse, error) {
+				webhook := model.Webhook{
+					Url:    "https://example.com/hooks",
+					Secret: "s3cr3t",
+					Events: []string{model.EventTaskCompleted},
+				}
+
+				webhookRepository := new(mockRepository.WebhookRepository)
+				webhookRepository.On("CreateWebhook", mock.Anything, webhook).Return(&model.Webhook{
+					Id:     uuid.NewV4(),
+					Url:    webhook.Url,
+					Secret: webhook.Secret,
+					Events: webhook.Events,
+				}, nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{WebhookRepository: webhookRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				response, err := client.CreateWebhook(context.Background(), &pbTodoList.CreateWebhookRequest{
+					Url:    " " + webhook.Url,
+					Secret: webhook.Secret,
+					Events: webhook.Events,
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				if response.GetWebhook().GetUrl() != webhook.Url || len(response.GetWebhook().GetEvents()) != 1 {
+					t.Errorf("expect the created webhook, but got %v", response)
+				}
+
+				return response, nil
+			},
+			output: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.input()
+			if err != nil {
+				if tt.output == nil {
+					t.Fatalf("expect no error, but got %v", err)
+				}
+				if er, ok := status.FromError(err); ok {
+					if er.Code() != tt.output.Code() {
+						t.Errorf("error code: expected %v, received %v", tt.output.Code(), er.Code())
+					}
+					if er.Message() != tt.output.Message() {
+						t.Errorf("error message: expected %v, received %v", tt.output.Message(), er.Message())
+					}
+				}
+			} else if tt.output != nil {
+				t.Errorf("expect error %v, but got nil", tt.output.Message())
+			}
+		})
+	}
+}
+
+func TestDeleteWebhook(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  func() error
+		output *status.Status
+	}{
+		{
+			name: "DeleteWebhook_ErrGetValidUUID",
+			input: func() error {
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				_, err = client.DeleteWebhook(context.Background(), &pbTodoList.DeleteWebhookRequest{
+					Id: "ASD",
+				})
+				return err
+			},
+			output: tools.ErrStatusIdMustBeUUID,
+		},
+		{
+			name: "DeleteWebhook_ErrStatusWebhookNotFound",
+			input: func() error {
+				id := uuid.NewV4()
+
+				webhookRepository := new(mockRepository.WebhookRepository)
+				webhookRepository.On("DeleteWebhook", mock.Anything, id).Return(repository.ErrWebhookNotFound)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{WebhookRepository: webhookRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				_, err = client.DeleteWebhook(context.Background(), &pbTodoList.DeleteWebhookRequest{
+					Id: id.String(),
+				})
+				return err
+			},
+			output: ErrStatusWebhookNotFound,
+		},
+		{
+			name: "DeleteWebhook_Success",
+			input: func() error {
+				id := uuid.NewV4()
+
+				webhookRepository := new(mockRepository.WebhookRepository)
+				webhookRepository.On("DeleteWebhook", mock.Anything, id).Return(nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{WebhookRepository: webhookRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				_, err = client.DeleteWebhook(context.Background(), &pbTodoList.DeleteWebhookRequest{
+					Id: id.String(),
+				})
+				return err
+			},
+			output: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.input()
+			if err != nil {
+				if tt.output == nil {
+					t.Fatalf("expect no error, but got %v", err)
+				}
+				if er, ok := status.FromError(err); ok {
+					if er.Code() != tt.output.Code() {
+						t.Errorf("error code: expected %v, received %v", tt.output.Code(), er.Code())
+					}
+					if er.Message() != tt.output.Message() {
+						t.Errorf("error message: expected %v, received %v", tt.output.Message(), er.Message())
+					}
+				}
+			} else if tt.output != nil {
+				t.Errorf("expect error %v, but got nil", tt.output.Message())
+			}
+		})
+	}
+}
+
+func TestListWebhookDeliveries(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  func() (*pbTodoList.ListWebhookDeliveriesResponse, error)
+		output *status.Status
+	}{
+		{
+			name: "ListWebhookDeliveries_ErrStatusInvalidPageSize",
+			input: func() (*pbTodoList.ListWebhookDeliveriesResponse, error) {
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.ListWebhookDeliveries(context.Background(), &pbTodoList.ListWebhookDeliveriesRequest{
+					Id:       uuid.NewV4().String(),
+					PageSize: 101,
+				})
+			},
+			output: ErrStatusInvalidPageSize,
+		},
+		{
+			name: "ListWebhookDeliveries_ErrStatusInvalidDeliveryStatus",
+			input: func() (*pbTodoList.ListWebhookDeliveriesResponse, error) {
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.ListWebhookDeliveries(context.Background(), &pbTodoList.ListWebhookDeliveriesRequest{
+					Id:     uuid.NewV4().String(),
+					Status: pbTodoList.WebhookDeliveryStatus(42),
+				})
+			},
+			output: ErrStatusInvalidDeliveryStatus,
+		},
+		{
+			name: "ListWebhookDeliveries_ErrStatusWebhookNotFound",
+			input: func() (*pbTodoList.ListWebhookDeliveriesResponse, error) {
+				id := uuid.NewV4()
+
+				webhookRepository := new(mockRepository.WebhookRepository)
+				webhookRepository.On("GetWebhook", mock.Anything, id).Return(nil, repository.ErrWebhookNotFound)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{WebhookRepository: webhookRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				return client.ListWebhookDeliveries(context.Background(), &pbTodoList.ListWebhookDeliveriesRequest{
+					Id: id.String(),
+				})
+			},
+			output: ErrStatusWebhookNotFound,
+		},
+		{
+			name: "ListWebhookDeliveries_Success",
+			input: func() (*pbTodoList.ListWebhookDeliveriesResponse, error) {
+				webhook := model.Webhook{Id: uuid.NewV4()}
+				dead := model.WebhookDelivery{
+					Id:        uuid.NewV4(),
+					WebhookId: webhook.Id,
+					Event:     model.EventTaskDeleted,
+					Payload:   []byte(`{"event":"task.deleted"}`),
+					Status:    model.WebhookDeliveryDead,
+					Attempts:  8,
+				}
+
+				webhookRepository := new(mockRepository.WebhookRepository)
+				webhookRepository.On("GetWebhook", mock.Anything, webhook.Id).Return(&webhook, nil)
+				webhookRepository.On("GetDeliveries", mock.Anything, webhook.Id, model.WebhookDeliveryDead, uint64(defaultPageSize)).
+					Return([]*model.WebhookDelivery{&dead}, nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{WebhookRepository: webhookRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				response, err := client.ListWebhookDeliveries(context.Background(), &pbTodoList.ListWebhookDeliveriesRequest{
+					Id:     webhook.Id.String(),
+					Status: pbTodoList.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD,
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				if len(response.GetDeliveries()) != 1 ||
+					response.GetDeliveries()[0].GetStatus() != pbTodoList.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD ||
+					response.GetDeliveries()[0].GetNextAttemptAt() != "" {
+					t.Errorf("expect the dead delivery, but got %v", response)
+				}
+
+				return response, nil
+			},
+			output: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.input()
+			if err != nil {
+				if tt.output == nil {
+					t.Fatalf("expect no error, but got %v", err)
+				}
+				if er, ok := status.FromError(err); ok {
+					if er.Code() != tt.output.Code() {
+						t.Errorf("error code: expected %v, received %v", tt.output.Code(), er.Code())
+					}
+					if er.Message() != tt.output.Message() {
+						t.Errorf("error message: expected %v, received %v", tt.output.Message(), er.Message())
+					}
+				}
+			} else if tt.output != nil {
+				t.Errorf("expect error %v, but got nil", tt.output.Message())
+			}
+		})
+	}
+}
+
+func TestRedeliverWebhookDelivery(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  func() error
+		output *status.Status
+	}{
+		{
+			name: "RedeliverWebhookDelivery_ErrStatusWebhookDeliveryNotFound",
+			input: func() error {
+				webhook := model.Webhook{Id: uuid.NewV4()}
+				deliveryId := uuid.NewV4()
+
+				webhookRepository := new(mockRepository.WebhookRepository)
+				webhookRepository.On("GetWebhook", mock.Anything, webhook.Id).Return(&webhook, nil)
+				webhookRepository.On("RedeliverDelivery", mock.Anything, webhook.Id, deliveryId).Return(repository.ErrWebhookDeliveryNotFound)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{WebhookRepository: webhookRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				_, err = client.RedeliverWebhookDelivery(context.Background(), &pbTodoList.RedeliverWebhookDeliveryRequest{
+					Id:         webhook.Id.String(),
+					DeliveryId: deliveryId.String(),
+				})
+				return err
+			},
+			output: ErrStatusWebhookDeliveryNotFound,
+		},
+		{
+			name: "RedeliverWebhookDelivery_Success",
+			input: func() error {
+				webhook := model.Webhook{Id: uuid.NewV4()}
+				deliveryId := uuid.NewV4()
+
+				webhookRepository := new(mockRepository.WebhookRepository)
+				webhookRepository.On("GetWebhook", mock.Anything, webhook.Id).Return(&webhook, nil)
+				webhookRepository.On("RedeliverDelivery", mock.Anything, webhook.Id, deliveryId).Return(nil)
+
+				conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(Dependencies{WebhookRepository: webhookRepository})))
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer conn.Close()
+
+				client := pbTodoList.NewTodoListServiceClient(conn)
+
+				_, err = client.RedeliverWebhookDelivery(context.Background(), &pbTodoList.RedeliverWebhookDeliveryRequest{
+					Id:         webhook.Id.String(),
+					DeliveryId: deliveryId.String(),
+				})
+				return err
+			},
+			output: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.input()
+			if err != nil {
+				if tt.output == nil {
+					t.Fatalf("expect no error, but got %v", err)
+				}
+				if er, ok := status.FromError(err); ok {
+					if er.Code() != tt.output.Code() {
+						t.Errorf("error code: expected %v, received %v", tt.output.Code(), er.Code())
+					}
+					if er.Message() != tt.output.Message() {
+						t.Errorf("error message: expected %v, received %v", tt.output.Message(), er.Message())
+					}
+				}
+			} else if tt.output != nil {
+				t.Errorf("expect error %v, but got nil", tt.output.Message())
+			}
+		})
+	}
+}
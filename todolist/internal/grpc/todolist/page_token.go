@@ -0,0 +1,115 @@
+package todolist
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+)
+
+var (
+	errPageTokenWithoutCursor = errors.New("page token without cursor")
+	errPageTokenOtherQuery    = errors.New("page token of another query")
+)
+
+// pageToken is the payload behind the opaque page_token of GetTasks
+type pageToken struct {
+	CreatedAt time.Time `json:"c"`
+	Id        uuid.UUID `json:"i"`
+	// Query is the hash of the sort and the filters of the request that returned the token
+	Query string `json:"q"`
+}
+
+// pageTokenQuery is what a page token is bound to, the pages of a token only follow each other with the same sort
+// direction and filters
+type pageTokenQuery struct {
+	SortDirection string       `json:"d"`
+	Label         string       `json:"l"`
+	Completed     *bool        `json:"c,omitempty"`
+	DueBefore     sql.NullTime `json:"db"`
+	DueAfter      sql.NullTime `json:"da"`
+	CreatedAfter  sql.NullTime `json:"ca"`
+	CreatedBefore sql.NullTime `json:"cb"`
+}
+
+// encodePageToken build an opaque token from the last task of a page, for the next page of the same filter
+func encodePageToken(task *model.Task, filter model.TaskFilter) (string, error) {
+	query, err := hashPageTokenQuery(filter)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := json.Marshal(pageToken{
+		CreatedAt: task.CreatedAt,
+		Id:        task.Id,
+		Query:     query,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodePageToken get the cursor from a token created by encodePageToken, the token must be of the same filter
+func decodePageToken(token string, filter model.TaskFilter) (*model.TaskCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, err
+	}
+
+	var payload pageToken
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, err
+	}
+
+	if payload.Id == uuid.Nil || payload.CreatedAt.IsZero() {
+		return nil, errPageTokenWithoutCursor
+	}
+
+	query, err := hashPageTokenQuery(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if payload.Query != query {
+		return nil, errPageTokenOtherQuery
+	}
+
+	return &model.TaskCursor{
+		CreatedAt: payload.CreatedAt,
+		Id:        payload.Id,
+	}, nil
+}
+
+// hashPageTokenQuery hashes the sort direction and the filters of filter, an unset direction is ascending
+func hashPageTokenQuery(filter model.TaskFilter) (string, error) {
+	query := pageTokenQuery{
+		SortDirection: filter.SortDirection,
+		Label:         filter.Label,
+		DueBefore:     filter.DueBefore,
+		DueAfter:      filter.DueAfter,
+		CreatedAfter:  filter.CreatedAfter,
+		CreatedBefore: filter.CreatedBefore,
+	}
+	if query.SortDirection != model.SortDirectionDesc {
+		query.SortDirection = model.SortDirectionAsc
+	}
+	if filter.Completed.Valid {
+		query.Completed = &filter.Completed.Bool
+	}
+
+	data, err := json.Marshal(query)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+	return base64.RawURLEncoding.EncodeToString(sum[:16]), nil
+}
@@ -0,0 +1,95 @@
+package todolist
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+)
+
+func TestPageToken(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   func() (*model.Task, string)
+		wantErr bool
+	}{
+		{
+			name: "PageToken_Success",
+			input: func() (*model.Task, string) {
+				task := model.Task{
+					Id:        uuid.NewV4(),
+					CreatedAt: time.Now().UTC(),
+				}
+
+				token, err := encodePageToken(&task, model.TaskFilter{Label: "work"})
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when encoding the page token", err)
+				}
+
+				return &task, token
+			},
+			wantErr: false,
+		},
+		{
+			name: "PageToken_OtherFilter",
+			input: func() (*model.Task, string) {
+				token, err := encodePageToken(&model.Task{Id: uuid.NewV4(), CreatedAt: time.Now().UTC()}, model.TaskFilter{Label: "home"})
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when encoding the page token", err)
+				}
+
+				return nil, token
+			},
+			wantErr: true,
+		},
+		{
+			name: "PageToken_OtherSortDirection",
+			input: func() (*model.Task, string) {
+				token, err := encodePageToken(&model.Task{Id: uuid.NewV4(), CreatedAt: time.Now().UTC()}, model.TaskFilter{Label: "work", SortDirection: model.SortDirectionDesc})
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when encoding the page token", err)
+				}
+
+				return nil, token
+			},
+			wantErr: true,
+		},
+		{
+			name: "PageToken_InvalidBase64",
+			input: func() (*model.Task, string) {
+				return nil, "%%%"
+			},
+			wantErr: true,
+		},
+		{
+			name: "PageToken_InvalidJSON",
+			input: func() (*model.Task, string) {
+				return nil, base64.RawURLEncoding.EncodeToString([]byte("task"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "PageToken_WithoutCursor",
+			input: func() (*model.Task, string) {
+				return nil, base64.RawURLEncoding.EncodeToString([]byte("{}"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task, token := tt.input()
+			cursor, err := decodePageToken(token, model.TaskFilter{Label: "work"})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expect error %v, but got %v", tt.wantErr, err)
+			}
+			if task != nil && (cursor.Id != task.Id || !cursor.CreatedAt.Equal(task.CreatedAt)) {
+				t.Errorf("expect cursor from task %v, but got %v", task, cursor)
+			}
+		})
+	}
+}
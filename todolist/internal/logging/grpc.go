@@ -0,0 +1,146 @@
+package logging
+
+import (
+	"context"
+	"time"
+
+	grpcMiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// CallerFunc returns the caller of a request, it is empty for an unknown caller
+type CallerFunc func(ctx context.Context) string
+
+// callKey is the context key for the access log entry of a request
+type callKey struct{}
+
+// call is the access log entry of a request, the caller is only known after the authentication
+type call struct {
+	logger *zap.Logger
+	caller string
+}
+
+// UnaryServerInterceptor gives every request an id, from the x-request-id metadata of the caller or a new one, and sends
+// it back in the headers. The handlers get a logger with the id in their context, and every request is logged once it
+// ends.
+func UnaryServerInterceptor(logger *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+
+		ctx, entry := newCall(ctx, logger)
+		if err := grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, RequestID(ctx))); err != nil {
+			entry.logger.Warn("cannot set request id header", zap.Error(err))
+		}
+
+		resp, err := handler(ctx, req)
+		entry.log(ctx, info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor gives every stream an id and logs it once it ends, like UnaryServerInterceptor
+func StreamServerInterceptor(logger *zap.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+
+		ctx, entry := newCall(stream.Context(), logger)
+		if err := stream.SetHeader(metadata.Pairs(RequestIDHeader, RequestID(ctx))); err != nil {
+			entry.logger.Warn("cannot set request id header", zap.Error(err))
+		}
+
+		wrapped := grpcMiddleware.WrapServerStream(stream)
+		wrapped.WrappedContext = ctx
+
+		err := handler(srv, wrapped)
+		entry.log(ctx, info.FullMethod, start, err)
+		return err
+	}
+}
+
+// UnaryCallerInterceptor adds the caller to the logger and to the access log of every request, it runs after the
+// authentication
+func UnaryCallerInterceptor(callerOf CallerFunc) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(withCaller(ctx, callerOf(ctx)), req)
+	}
+}
+
+// StreamCallerInterceptor adds the caller to the logger and to the access log of every stream, it runs after the
+// authentication
+func StreamCallerInterceptor(callerOf CallerFunc) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		wrapped := grpcMiddleware.WrapServerStream(stream)
+		wrapped.WrappedContext = withCaller(stream.Context(), callerOf(stream.Context()))
+		return handler(srv, wrapped)
+	}
+}
+
+// newCall starts the access log entry of a request, with the id sent by the caller or a new one
+func newCall(ctx context.Context, logger *zap.Logger) (context.Context, *call) {
+	var id string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if vals := md.Get(RequestIDHeader); len(vals) > 0 {
+			id = vals[0]
+		}
+	}
+	id = requestID(id)
+
+	entry := &call{logger: logger.With(zap.String("request_id", id))}
+
+	ctx = context.WithValue(ctx, requestIDKey{}, id)
+	ctx = context.WithValue(ctx, callKey{}, entry)
+	return NewContext(ctx, entry.logger), entry
+}
+
+// withCaller adds caller to the access log entry and to the logger of ctx
+func withCaller(ctx context.Context, caller string) context.Context {
+	if len(caller) == 0 {
+		return ctx
+	}
+
+	if entry, ok := ctx.Value(callKey{}).(*call); ok {
+		entry.caller = caller
+	}
+	return NewContext(ctx, FromContext(ctx).With(zap.String("caller", caller)))
+}
+
+// log writes the access log of the request, at the level of its code
+func (c *call) log(ctx context.Context, method string, start time.Time, err error) {
+	code := status.Code(err)
+
+	fields := []zap.Field{
+		zap.String("method", method),
+		zap.String("code", code.String()),
+		zap.Duration("duration", time.Since(start)),
+	}
+	if len(c.caller) > 0 {
+		fields = append(fields, zap.String("caller", c.caller))
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		fields = append(fields, zap.String("peer", p.Addr.String()))
+	}
+	if err != nil {
+		fields = append(fields, zap.Error(err))
+	}
+
+	if entry := c.logger.Check(codeLevel(code), "grpc request"); entry != nil {
+		entry.Write(fields...)
+	}
+}
+
+// codeLevel is the log level of a request that ended with code
+func codeLevel(code codes.Code) zapcore.Level {
+	switch code {
+	case codes.Unknown, codes.Internal, codes.DataLoss, codes.Unimplemented:
+		return zapcore.ErrorLevel
+	case codes.DeadlineExceeded, codes.Unavailable:
+		return zapcore.WarnLevel
+	}
+	return zapcore.InfoLevel
+}
@@ -0,0 +1,71 @@
+package logging
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// NewHandler gives every request served by next an id, from the X-Request-Id header of the caller or a new one, and
+// sends it back in the response. The id is forwarded to the gRPC server with the request, and every request is logged
+// once it ends.
+func NewHandler(next http.Handler, logger *zap.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		id := requestID(r.Header.Get(RequestIDHeader))
+		r.Header.Set(RequestIDHeader, id)
+		w.Header().Set(RequestIDHeader, id)
+
+		requestLogger := logger.With(zap.String("request_id", id))
+		ctx := context.WithValue(r.Context(), requestIDKey{}, id)
+
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r.WithContext(NewContext(ctx, requestLogger)))
+
+		level := zapcore.InfoLevel
+		if recorder.status >= http.StatusInternalServerError {
+			level = zapcore.ErrorLevel
+		}
+
+		if entry := requestLogger.Check(level, "http request"); entry != nil {
+			entry.Write(
+				zap.String("method", r.Method),
+				zap.String("path", r.URL.Path),
+				zap.Int("code", recorder.status),
+				zap.Duration("duration", time.Since(start)),
+				zap.String("peer", r.RemoteAddr),
+			)
+		}
+	})
+}
+
+// statusRecorder keeps the status code written to the response
+type statusRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+func (r *statusRecorder) WriteHeader(code int) {
+	if !r.wroteHeader {
+		r.status = code
+		r.wroteHeader = true
+	}
+	r.ResponseWriter.WriteHeader(code)
+}
+
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	r.wroteHeader = true
+	return r.ResponseWriter.Write(b)
+}
+
+// Flush keeps the events streams working, they flush every event
+func (r *statusRecorder) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
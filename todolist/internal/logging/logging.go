@@ -0,0 +1,95 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+
+	uuid "github.com/satori/go.uuid"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+const (
+	// ModeProduction logs JSON lines, ModeDevelopment logs readable lines with the stack of the warnings
+	ModeProduction  = "production"
+	ModeDevelopment = "development"
+
+	// RequestIDHeader is the header and the metadata of the id of a request, it is kept from the caller or generated
+	RequestIDHeader = "x-request-id"
+
+	// maxRequestIDLength bounds the ids of the callers, a longer id is replaced by a generated one
+	maxRequestIDLength = 128
+)
+
+type (
+	// loggerKey is the context key for the logger of a request
+	loggerKey struct{}
+	// requestIDKey is the context key for the id of a request
+	requestIDKey struct{}
+)
+
+// New returns the logger of mode, it logs from level on, like debug, info, warn or error
+func New(mode string, level string) (*zap.Logger, error) {
+	var config zap.Config
+	switch mode {
+	case ModeProduction:
+		config = zap.NewProductionConfig()
+		config.EncoderConfig.TimeKey = "time"
+		// the caller of the access logs is the caller of the request, the line of the code is the source
+		config.EncoderConfig.CallerKey = "source"
+		config.EncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
+	case ModeDevelopment:
+		config = zap.NewDevelopmentConfig()
+	default:
+		return nil, fmt.Errorf("unknown log mode %q, it must be production or development", mode)
+	}
+
+	if len(level) > 0 {
+		if err := config.Level.UnmarshalText([]byte(level)); err != nil {
+			return nil, fmt.Errorf("unknown log level %q, it must be debug, info, warn or error", level)
+		}
+	}
+
+	return config.Build()
+}
+
+// NewContext returns a copy of ctx with the logger of the request
+func NewContext(ctx context.Context, logger *zap.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, logger)
+}
+
+// FromContext returns the logger of the request, with its id and its caller, or the global logger outside of a request
+func FromContext(ctx context.Context) *zap.Logger {
+	if logger, ok := ctx.Value(loggerKey{}).(*zap.Logger); ok {
+		return logger
+	}
+	return zap.L()
+}
+
+// RequestID returns the id of the request of ctx, it is empty outside of a request
+func RequestID(ctx context.Context) string {
+	if id, ok := ctx.Value(requestIDKey{}).(string); ok {
+		return id
+	}
+	return ""
+}
+
+// NewRequestID returns a new random request id
+func NewRequestID() string {
+	return uuid.NewV4().String()
+}
+
+// requestID keeps the id sent by the caller when it is a valid one, otherwise it generates a new one
+func requestID(id string) string {
+	if len(id) == 0 || len(id) > maxRequestIDLength {
+		return NewRequestID()
+	}
+
+	for _, r := range id {
+		if r < 0x21 || r > 0x7e {
+			return NewRequestID()
+		}
+	}
+
+	return id
+}
@@ -0,0 +1,184 @@
+package logging_test
+
+import (
+	"context"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	grpcMiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/overridesh/sgg-todolist-service/internal/grpc/healthcheck"
+	"github.com/overridesh/sgg-todolist-service/internal/logging"
+	pbTodoList "github.com/overridesh/sgg-todolist-service/proto"
+)
+
+func TestNew(t *testing.T) {
+	logger, err := logging.New(logging.ModeProduction, "warn")
+	assert.NoError(t, err)
+	assert.False(t, logger.Core().Enabled(zapcore.InfoLevel))
+	assert.True(t, logger.Core().Enabled(zapcore.WarnLevel))
+
+	logger, err = logging.New(logging.ModeDevelopment, "")
+	assert.NoError(t, err)
+	assert.True(t, logger.Core().Enabled(zapcore.DebugLevel))
+
+	_, err = logging.New("verbose", "info")
+	assert.EqualError(t, err, `unknown log mode "verbose", it must be production or development`)
+
+	_, err = logging.New(logging.ModeProduction, "loud")
+	assert.EqualError(t, err, `unknown log level "loud", it must be debug, info, warn or error`)
+}
+
+func TestFromContext(t *testing.T) {
+	assert.Equal(t, zap.L(), logging.FromContext(context.Background()))
+
+	logger := zap.NewNop()
+	assert.Equal(t, logger, logging.FromContext(logging.NewContext(context.Background(), logger)))
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+
+	var handlerRequestID string
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer(grpc.UnaryInterceptor(grpcMiddleware.ChainUnaryServer(
+		logging.UnaryServerInterceptor(zap.New(core)),
+		func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			handlerRequestID = logging.RequestID(ctx)
+			return handler(ctx, req)
+		},
+		logging.UnaryCallerInterceptor(func(context.Context) string {
+			return "user-1"
+		}),
+	)))
+	pbTodoList.RegisterHealthcheckServiceServer(server, healthcheck.NewGRPC(nil))
+
+	go func() {
+		if err := server.Serve(listener); err != nil {
+			log.Fatal(err)
+		}
+	}()
+	defer server.Stop()
+
+	conn, err := grpc.DialContext(context.Background(), "",
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return listener.Dial()
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	client := pbTodoList.NewHealthcheckServiceClient(conn)
+
+	t.Run("keeps the request id of the caller", func(t *testing.T) {
+		var header metadata.MD
+		ctx := metadata.AppendToOutgoingContext(context.Background(), logging.RequestIDHeader, "abc-123")
+
+		_, err := client.GetHealthcheck(ctx, &emptypb.Empty{}, grpc.Header(&header))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"abc-123"}, header.Get(logging.RequestIDHeader))
+		assert.Equal(t, "abc-123", handlerRequestID)
+
+		entries := logs.TakeAll()
+		if !assert.Len(t, entries, 1) {
+			return
+		}
+
+		fields := entries[0].ContextMap()
+		assert.Equal(t, "grpc request", entries[0].Message)
+		assert.Equal(t, zapcore.InfoLevel, entries[0].Level)
+		assert.Equal(t, "abc-123", fields["request_id"])
+		assert.Equal(t, "/healthcheck.v1.HealthcheckService/GetHealthcheck", fields["method"])
+		assert.Equal(t, "OK", fields["code"])
+		assert.Equal(t, "user-1", fields["caller"])
+		assert.Contains(t, fields, "duration")
+	})
+
+	t.Run("generates a request id", func(t *testing.T) {
+		var header metadata.MD
+		ctx := metadata.AppendToOutgoingContext(context.Background(), logging.RequestIDHeader, "not a valid id")
+
+		_, err := client.GetHealthcheck(ctx, &emptypb.Empty{}, grpc.Header(&header))
+		assert.NoError(t, err)
+
+		ids := header.Get(logging.RequestIDHeader)
+		if !assert.Len(t, ids, 1) {
+			return
+		}
+		assert.Len(t, ids[0], 36)
+		assert.Equal(t, ids[0], handlerRequestID)
+
+		entries := logs.TakeAll()
+		if assert.Len(t, entries, 1) {
+			assert.Equal(t, ids[0], entries[0].ContextMap()["request_id"])
+		}
+	})
+}
+
+func TestNewHandler(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+
+	var forwarded, ctxRequestID string
+	handler := logging.NewHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		forwarded = r.Header.Get(logging.RequestIDHeader)
+		ctxRequestID = logging.RequestID(r.Context())
+		logging.FromContext(r.Context()).Info("handled")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}), zap.New(core))
+
+	t.Run("keeps the request id of the caller", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "/api/healthcheck", nil)
+		request.Header.Set("X-Request-Id", "abc-123")
+
+		response := httptest.NewRecorder()
+		handler.ServeHTTP(response, request)
+
+		assert.Equal(t, "abc-123", response.Header().Get("X-Request-Id"))
+		assert.Equal(t, "abc-123", forwarded)
+		assert.Equal(t, "abc-123", ctxRequestID)
+
+		entries := logs.TakeAll()
+		if !assert.Len(t, entries, 2) {
+			return
+		}
+
+		assert.Equal(t, "handled", entries[0].Message)
+		assert.Equal(t, "abc-123", entries[0].ContextMap()["request_id"])
+
+		fields := entries[1].ContextMap()
+		assert.Equal(t, "http request", entries[1].Message)
+		assert.Equal(t, zapcore.ErrorLevel, entries[1].Level)
+		assert.Equal(t, "abc-123", fields["request_id"])
+		assert.Equal(t, http.MethodGet, fields["method"])
+		assert.Equal(t, "/api/healthcheck", fields["path"])
+		assert.Equal(t, int64(http.StatusServiceUnavailable), fields["code"])
+	})
+
+	t.Run("generates a request id", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "/api/healthcheck", nil)
+		request.Header.Set("X-Request-Id", strings.Repeat("a", 129))
+
+		response := httptest.NewRecorder()
+		handler.ServeHTTP(response, request)
+
+		id := response.Header().Get("X-Request-Id")
+		assert.Len(t, id, 36)
+		assert.Equal(t, id, forwarded)
+		logs.TakeAll()
+	})
+}
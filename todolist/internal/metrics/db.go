@@ -0,0 +1,219 @@
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	storage "github.com/overridesh/sgg-todolist-service/pkg/storage/sql"
+)
+
+// StatsDB is a database that reports the statistics of its pool, like *sql.DB
+type StatsDB interface {
+	Stats() sql.DBStats
+}
+
+// db records the queries of a storage.DB by the repository method that runs them
+type db struct {
+	storage.DB
+	duration *prometheus.HistogramVec
+	errors   *prometheus.CounterVec
+}
+
+// NewDB decorates database with the metrics of its queries, and of its connection pool when it reports them. The
+// queries, the ones of its transactions too, are labeled by the repository method of their context, like
+// taskRepository.GetTask, see storage.WithMethod.
+func NewDB(database storage.DB, registerer prometheus.Registerer) storage.DB {
+	d := &db{
+		DB: database,
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "db",
+			Name:      "query_duration_seconds",
+			Help:      "Latency of the queries, by repository method.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "db",
+			Name:      "query_errors_total",
+			Help:      "Queries that failed, by repository method.",
+		}, []string{"method"}),
+	}
+
+	registerer.MustRegister(d.duration, d.errors)
+
+	if stats, ok := database.(StatsDB); ok {
+		registerer.MustRegister(NewDBStatsCollector(stats))
+	}
+
+	return d
+}
+
+func (d *db) BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error) {
+	defer d.observe(ctx, time.Now())
+
+	tx, err := d.DB.BeginTx(ctx, opts)
+	d.fail(ctx, err)
+	return tx, err
+}
+
+func (d *db) ExecContext(ctx context.Context, query string, arguments ...interface{}) (sql.Result, error) {
+	return exec(ctx, d, d.DB, query, arguments)
+}
+
+func (d *db) QueryContext(ctx context.Context, query string, optionsAndArgs ...interface{}) (*sql.Rows, error) {
+	return queryRows(ctx, d, d.DB, query, optionsAndArgs)
+}
+
+// QueryRowContext records the errors of the query, sql.ErrNoRows is only returned by the scan and is not one of them
+func (d *db) QueryRowContext(ctx context.Context, query string, optionsAndArgs ...interface{}) *sql.Row {
+	return queryRow(ctx, d, d.DB, query, optionsAndArgs)
+}
+
+// DecorateTx records the statements of a transaction and its commit, by the repository method it began in
+func (d *db) DecorateTx(ctx context.Context, tx storage.Tx) storage.Tx {
+	if decorator, ok := d.DB.(storage.TxDecorator); ok {
+		tx = decorator.DecorateTx(ctx, tx)
+	}
+
+	return &dbTx{Tx: tx, db: d, ctx: ctx}
+}
+
+func (d *db) observe(ctx context.Context, start time.Time) {
+	d.duration.WithLabelValues(method(ctx)).Observe(time.Since(start).Seconds())
+}
+
+func (d *db) fail(ctx context.Context, err error) {
+	if err != nil {
+		d.errors.WithLabelValues(method(ctx)).Inc()
+	}
+}
+
+// dbTx records the statements of a transaction like the ones of its db
+type dbTx struct {
+	storage.Tx
+	db  *db
+	ctx context.Context
+}
+
+func (t *dbTx) ExecContext(ctx context.Context, query string, arguments ...interface{}) (sql.Result, error) {
+	return exec(ctx, t.db, t.Tx, query, arguments)
+}
+
+func (t *dbTx) QueryContext(ctx context.Context, query string, optionsAndArgs ...interface{}) (*sql.Rows, error) {
+	return queryRows(ctx, t.db, t.Tx, query, optionsAndArgs)
+}
+
+func (t *dbTx) QueryRowContext(ctx context.Context, query string, optionsAndArgs ...interface{}) *sql.Row {
+	return queryRow(ctx, t.db, t.Tx, query, optionsAndArgs)
+}
+
+func (t *dbTx) Commit() error {
+	defer t.db.observe(t.ctx, time.Now())
+
+	err := t.Tx.Commit()
+	t.db.fail(t.ctx, err)
+	return err
+}
+
+// querier runs the statements of a database or of a transaction
+type querier interface {
+	ExecContext(ctx context.Context, query string, arguments ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, optionsAndArgs ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, optionsAndArgs ...interface{}) *sql.Row
+}
+
+func exec(ctx context.Context, d *db, q querier, query string, arguments []interface{}) (sql.Result, error) {
+	defer d.observe(ctx, time.Now())
+
+	result, err := q.ExecContext(ctx, query, arguments...)
+	d.fail(ctx, err)
+	return result, err
+}
+
+func queryRows(ctx context.Context, d *db, q querier, query string, optionsAndArgs []interface{}) (*sql.Rows, error) {
+	defer d.observe(ctx, time.Now())
+
+	rows, err := q.QueryContext(ctx, query, optionsAndArgs...)
+	d.fail(ctx, err)
+	return rows, err
+}
+
+func queryRow(ctx context.Context, d *db, q querier, query string, optionsAndArgs []interface{}) *sql.Row {
+	defer d.observe(ctx, time.Now())
+
+	row := q.QueryRowContext(ctx, query, optionsAndArgs...)
+	d.fail(ctx, row.Err())
+	return row
+}
+
+// method is the repository method of the statements of ctx, unknown outside of one
+func method(ctx context.Context) string {
+	if method := storage.Method(ctx); len(method) > 0 {
+		return method
+	}
+	return "unknown"
+}
+
+// dbStatsCollector reports the statistics of the connection pool of a database
+type dbStatsCollector struct {
+	db                StatsDB
+	maxOpen           *prometheus.Desc
+	open              *prometheus.Desc
+	inUse             *prometheus.Desc
+	idle              *prometheus.Desc
+	waitCount         *prometheus.Desc
+	waitDuration      *prometheus.Desc
+	maxIdleClosed     *prometheus.Desc
+	maxIdleTimeClosed *prometheus.Desc
+	maxLifetimeClosed *prometheus.Desc
+}
+
+// NewDBStatsCollector collects the sql.DBStats of database every time the metrics are gathered
+func NewDBStatsCollector(database StatsDB) prometheus.Collector {
+	desc := func(name string, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, "db", name), help, nil, nil)
+	}
+
+	return &dbStatsCollector{
+		db:                database,
+		maxOpen:           desc("max_open_connections", "Maximum number of open connections to the database."),
+		open:              desc("open_connections", "Established connections, in use and idle."),
+		inUse:             desc("in_use_connections", "Connections currently in use."),
+		idle:              desc("idle_connections", "Idle connections."),
+		waitCount:         desc("wait_count_total", "Connections waited for."),
+		waitDuration:      desc("wait_duration_seconds_total", "Time blocked waiting for a new connection."),
+		maxIdleClosed:     desc("max_idle_closed_total", "Connections closed due to the maximum of idle connections."),
+		maxIdleTimeClosed: desc("max_idle_time_closed_total", "Connections closed due to the maximum idle time."),
+		maxLifetimeClosed: desc("max_lifetime_closed_total", "Connections closed due to the maximum lifetime."),
+	}
+}
+
+func (c *dbStatsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.maxOpen
+	ch <- c.open
+	ch <- c.inUse
+	ch <- c.idle
+	ch <- c.waitCount
+	ch <- c.waitDuration
+	ch <- c.maxIdleClosed
+	ch <- c.maxIdleTimeClosed
+	ch <- c.maxLifetimeClosed
+}
+
+func (c *dbStatsCollector) Collect(ch chan<- prometheus.Metric) {
+	stats := c.db.Stats()
+
+	ch <- prometheus.MustNewConstMetric(c.maxOpen, prometheus.GaugeValue, float64(stats.MaxOpenConnections))
+	ch <- prometheus.MustNewConstMetric(c.open, prometheus.GaugeValue, float64(stats.OpenConnections))
+	ch <- prometheus.MustNewConstMetric(c.inUse, prometheus.GaugeValue, float64(stats.InUse))
+	ch <- prometheus.MustNewConstMetric(c.idle, prometheus.GaugeValue, float64(stats.Idle))
+	ch <- prometheus.MustNewConstMetric(c.waitCount, prometheus.CounterValue, float64(stats.WaitCount))
+	ch <- prometheus.MustNewConstMetric(c.waitDuration, prometheus.CounterValue, stats.WaitDuration.Seconds())
+	ch <- prometheus.MustNewConstMetric(c.maxIdleClosed, prometheus.CounterValue, float64(stats.MaxIdleClosed))
+	ch <- prometheus.MustNewConstMetric(c.maxIdleTimeClosed, prometheus.CounterValue, float64(stats.MaxIdleTimeClosed))
+	ch <- prometheus.MustNewConstMetric(c.maxLifetimeClosed, prometheus.CounterValue, float64(stats.MaxLifetimeClosed))
+}
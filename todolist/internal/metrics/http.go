@@ -0,0 +1,105 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/metadata"
+)
+
+// UnmatchedRoute is the route of the requests that match no rpc of the gateway
+const UnmatchedRoute string = "unmatched"
+
+// routeKey is the context key for the route of the request, it is set by Route once the gateway matches it
+type routeKey struct{}
+
+// HTTP records the requests of the gateway by method, route and status code
+type HTTP struct {
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+}
+
+// NewHTTP creates the metrics of the gateway in registerer
+func NewHTTP(registerer prometheus.Registerer) *HTTP {
+	m := &HTTP{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "requests_total",
+			Help:      "Requests handled by the gateway, by method, route and status code.",
+		}, []string{"method", "route", "code"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "request_duration_seconds",
+			Help:      "Latency of the requests of the gateway, by method and route.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route"}),
+	}
+
+	registerer.MustRegister(m.requests, m.duration)
+	return m
+}
+
+// Handler records the requests of next, a gateway mux with the Route annotator. The route is the path pattern of the
+// rpc, like /api/v1/task/{id}, so the ids of the paths do not become labels.
+func (m *HTTP) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var (
+			start    time.Time       = time.Now()
+			route    string          = UnmatchedRoute
+			recorder *statusRecorder = &statusRecorder{ResponseWriter: w, code: http.StatusOK}
+		)
+
+		next.ServeHTTP(recorder, r.WithContext(context.WithValue(r.Context(), routeKey{}, &route)))
+
+		m.requests.WithLabelValues(r.Method, route, strconv.Itoa(recorder.code)).Inc()
+		m.duration.WithLabelValues(r.Method, route).Observe(time.Since(start).Seconds())
+	})
+}
+
+// Route is a metadata annotator of the gateway mux, it names the route of the request recorded by Handler with the
+// path pattern of the matched rpc. It adds no metadata.
+func Route(ctx context.Context, r *http.Request) metadata.MD {
+	route, ok := ctx.Value(routeKey{}).(*string)
+	if !ok {
+		return nil
+	}
+
+	if pattern, ok := runtime.HTTPPathPattern(ctx); ok {
+		*route = pattern
+	}
+
+	return nil
+}
+
+// statusRecorder keeps the status code written to the response
+type statusRecorder struct {
+	http.ResponseWriter
+	code        int
+	wroteHeader bool
+}
+
+func (r *statusRecorder) WriteHeader(code int) {
+	if !r.wroteHeader {
+		r.code = code
+		r.wroteHeader = true
+	}
+	r.ResponseWriter.WriteHeader(code)
+}
+
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	r.wroteHeader = true
+	return r.ResponseWriter.Write(b)
+}
+
+// Flush keeps the streams of the gateway flushing
+func (r *statusRecorder) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
@@ -0,0 +1,37 @@
+// Package metrics records the requests of the gRPC server and the gateway, and the queries of the database, as
+// Prometheus metrics served on an admin port.
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	namespace string = "todolist"
+	// Path is where the admin server serves the metrics
+	Path string = "/metrics"
+)
+
+// NewRegistry returns a registry with the metrics of the go runtime and of the process
+func NewRegistry() *prometheus.Registry {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		prometheus.NewGoCollector(),
+		prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}),
+	)
+	return registry
+}
+
+// NewServer returns the admin server of the metrics, it is kept apart from the public port
+func NewServer(addr string, gatherer prometheus.Gatherer) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle(Path, promhttp.HandlerFor(gatherer, promhttp.HandlerOpts{}))
+
+	return &http.Server{
+		Addr:    addr,
+		Handler: mux,
+	}
+}
@@ -0,0 +1,212 @@
+package metrics_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/overridesh/sgg-todolist-service/internal/grpc/healthcheck"
+	"github.com/overridesh/sgg-todolist-service/internal/metrics"
+	"github.com/overridesh/sgg-todolist-service/internal/tracing"
+	storage "github.com/overridesh/sgg-todolist-service/pkg/storage/sql"
+	pbTodoList "github.com/overridesh/sgg-todolist-service/proto"
+)
+
+func TestGRPCUnaryServerInterceptor(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	interceptor := metrics.NewGRPC(registry).UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/todolist.v1.TodoListService/GetTask"}
+
+	for _, err := range []error{nil, nil, status.Error(codes.NotFound, "task not found")} {
+		_, _ = interceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, err
+		})
+	}
+
+	families, err := registry.Gather()
+	assert.NoError(t, err)
+	assert.Len(t, families, 2)
+
+	counts := map[string]float64{}
+	for _, family := range families {
+		if family.GetName() != "todolist_grpc_requests_total" {
+			assert.Equal(t, "todolist_grpc_request_duration_seconds", family.GetName())
+			assert.Equal(t, uint64(3), family.GetMetric()[0].GetHistogram().GetSampleCount())
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == "code" {
+					counts[label.GetValue()] = metric.GetCounter().GetValue()
+				}
+			}
+		}
+	}
+	assert.Equal(t, map[string]float64{"OK": 2, "NotFound": 1}, counts)
+}
+
+func TestHTTPHandler(t *testing.T) {
+	mux := runtime.NewServeMux(runtime.WithMetadata(metrics.Route))
+	if err := pbTodoList.RegisterHealthcheckServiceHandlerServer(context.Background(), mux, healthcheck.NewGRPC(nil)); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		path  string
+		route string
+		code  string
+	}{
+		{
+			name:  "matched route",
+			path:  "/api/healthcheck",
+			route: "/api/healthcheck",
+			code:  "200",
+		},
+		{
+			name:  "unmatched route",
+			path:  "/api/v1/task/1/unknown",
+			route: metrics.UnmatchedRoute,
+			code:  "404",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := prometheus.NewRegistry()
+			handler := metrics.NewHTTP(registry).Handler(mux)
+
+			handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			output := gather(t, registry)
+			assert.Contains(t, output, `todolist_http_requests_total{code="`+tt.code+`",method="GET",route="`+tt.route+`"} 1`)
+			assert.Contains(t, output, `todolist_http_request_duration_seconds_count{method="GET",route="`+tt.route+`"} 1`)
+		})
+	}
+}
+
+// taskRepository runs its queries like the repositories, they are labeled by its methods
+type taskRepository struct {
+	db storage.DB
+}
+
+func (r *taskRepository) GetTask(ctx context.Context) error {
+	ctx = storage.WithMethod(ctx, "taskRepository.GetTask")
+
+	var id int64
+	return r.db.QueryRowContext(ctx, "SELECT id FROM tasks").Scan(&id)
+}
+
+func (r *taskRepository) DeleteTask(ctx context.Context) error {
+	ctx = storage.WithMethod(ctx, "taskRepository.DeleteTask")
+
+	_, err := r.db.ExecContext(ctx, "DELETE FROM tasks")
+	return err
+}
+
+func (r *taskRepository) MoveTask(ctx context.Context) error {
+	ctx = storage.WithMethod(ctx, "taskRepository.MoveTask")
+
+	tx, err := storage.BeginTx(ctx, r.db, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, "UPDATE tasks SET position = 1"); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func TestDB(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id FROM tasks")).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM tasks")).WillReturnError(errors.New("connection reset"))
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE tasks SET position = 1")).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	// the decorators around the metrics keep the ones of the transactions
+	registry := prometheus.NewRegistry()
+	repository := &taskRepository{db: tracing.NewDB(metrics.NewDB(db, registry), nil)}
+
+	assert.NoError(t, repository.GetTask(context.Background()))
+	assert.Error(t, repository.DeleteTask(context.Background()))
+	assert.NoError(t, repository.MoveTask(context.Background()))
+	assert.NoError(t, mock.ExpectationsWereMet())
+
+	output := gather(t, registry)
+	assert.Contains(t, output, `todolist_db_query_duration_seconds_count{method="taskRepository.GetTask"} 1`)
+	assert.Contains(t, output, `todolist_db_query_duration_seconds_count{method="taskRepository.DeleteTask"} 1`)
+	assert.Contains(t, output, `todolist_db_query_errors_total{method="taskRepository.DeleteTask"} 1`)
+	assert.NotContains(t, output, `todolist_db_query_errors_total{method="taskRepository.GetTask"}`)
+	// the begin, the statement and the commit of the transaction
+	assert.Contains(t, output, `todolist_db_query_duration_seconds_count{method="taskRepository.MoveTask"} 3`)
+	// sqlmock is a *sql.DB, so the pool is reported too
+	assert.Contains(t, output, "todolist_db_open_connections 1")
+	assert.Contains(t, output, "todolist_db_max_open_connections 0")
+
+	t.Run("DB_WithoutMethod", func(t *testing.T) {
+		mock.ExpectExec(regexp.QuoteMeta("DELETE FROM tasks")).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		_, err := repository.db.ExecContext(context.Background(), "DELETE FROM tasks")
+		assert.NoError(t, err)
+		assert.Contains(t, gather(t, registry), `todolist_db_query_duration_seconds_count{method="unknown"} 1`)
+	})
+}
+
+func TestServer(t *testing.T) {
+	registry := metrics.NewRegistry()
+	metrics.NewGRPC(registry)
+
+	server := httptest.NewServer(metrics.NewServer("", registry).Handler)
+	defer server.Close()
+
+	response, err := http.Get(server.URL + metrics.Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+
+	body, err := ioutil.ReadAll(response.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Contains(t, string(body), "go_goroutines")
+
+	response, err = http.Get(server.URL + "/api/healthcheck")
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+}
+
+// gather returns the metrics of registry in the text format
+func gather(t *testing.T, registry *prometheus.Registry) string {
+	recorder := httptest.NewRecorder()
+	metrics.NewServer("", registry).Handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, metrics.Path, nil))
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("cannot gather the metrics, status %d", recorder.Code)
+	}
+	return strings.TrimSpace(recorder.Body.String())
+}
@@ -0,0 +1,23 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// ApiKey lets a service call the api as its owner, only with its scopes. Only the hash of its secret is stored, the
+// prefix tells the keys apart.
+type ApiKey struct {
+	Id         uuid.UUID
+	Name       string
+	Prefix     string
+	KeyHash    string
+	Scopes     []string
+	OwnerId    string
+	TenantId   string
+	CreatedAt  time.Time
+	LastUsedAt sql.NullTime
+	RevokedAt  sql.NullTime
+}
@@ -0,0 +1,143 @@
+package model
+
+import (
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	EventTaskCreated    string = "task.created"
+	EventTaskUpdated    string = "task.updated"
+	EventTaskCompleted  string = "task.completed"
+	EventTaskDeleted    string = "task.deleted"
+	EventTaskRestored   string = "task.restored"
+	EventCommentCreated string = "comment.created"
+	EventCommentDeleted string = "comment.deleted"
+	EventLabelCreated   string = "label.created"
+	EventLabelDeleted   string = "label.deleted"
+)
+
+// EventTypes are all the events that can be subscribed to
+var EventTypes map[string]bool = map[string]bool{
+	EventTaskCreated:    true,
+	EventTaskUpdated:    true,
+	EventTaskCompleted:  true,
+	EventTaskDeleted:    true,
+	EventTaskRestored:   true,
+	EventCommentCreated: true,
+	EventCommentDeleted: true,
+	EventLabelCreated:   true,
+	EventLabelDeleted:   true,
+}
+
+// Event is something that happened to a task or to its comments and labels. Events are written to the events outbox
+// where TransactionId and then Sequence order them, Data is one of the *Data structs below or the raw JSON when it is
+// read back. OwnerId and TenantId are the ones of the task, they are only known once the event is read back.
+type Event struct {
+	Id            uuid.UUID   `json:"id"`
+	Sequence      int64       `json:"-"`
+	TransactionId int64       `json:"-"`
+	Type          string      `json:"event"`
+	TaskId        uuid.UUID   `json:"task_id"`
+	OccurredAt    time.Time   `json:"occurred_at"`
+	Data          interface{} `json:"data"`
+	OwnerId       string      `json:"-"`
+	TenantId      string      `json:"-"`
+}
+
+// TaskData is the data of the task events
+type TaskData struct {
+	Id         uuid.UUID  `json:"id"`
+	Value      string     `json:"value"`
+	Completed  bool       `json:"completed"`
+	DueDate    *time.Time `json:"due_date"`
+	ParentId   *uuid.UUID `json:"parent_id"`
+	Priority   int32      `json:"priority"`
+	Position   float64    `json:"position"`
+	Recurrence string     `json:"recurrence"`
+	Version    int64      `json:"version"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+// CommentData is the data of the comment events
+type CommentData struct {
+	Id        uuid.UUID `json:"id"`
+	Message   string    `json:"message"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// LabelData is the data of the label events
+type LabelData struct {
+	Id        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// DeletedData is the data of the events about deleted resources, only their id is left
+type DeletedData struct {
+	Id uuid.UUID `json:"id"`
+}
+
+// NewEvent initializes an event that happens now, its Sequence and TransactionId are given by the outbox
+func NewEvent(eventType string, taskId uuid.UUID, data interface{}) Event {
+	return Event{
+		Id:         uuid.NewV4(),
+		Type:       eventType,
+		TaskId:     taskId,
+		OccurredAt: time.Now().UTC(),
+		Data:       data,
+	}
+}
+
+// NewTaskEvent initializes an event about a task
+func NewTaskEvent(eventType string, task *Task) Event {
+	data := TaskData{
+		Id:         task.Id,
+		Value:      task.Value,
+		Completed:  task.Completed,
+		Priority:   task.Priority,
+		Position:   task.Position,
+		Recurrence: task.Recurrence,
+		Version:    task.Version,
+		CreatedAt:  task.CreatedAt,
+		UpdatedAt:  task.UpdatedAt,
+	}
+
+	if task.DueDate.Valid {
+		data.DueDate = &task.DueDate.Time
+	}
+
+	if task.ParentId.Valid {
+		data.ParentId = &task.ParentId.UUID
+	}
+
+	return NewEvent(eventType, task.Id, data)
+}
+
+// NewCommentEvent initializes an event about a comment of a task
+func NewCommentEvent(eventType string, comment *Comment) Event {
+	return NewEvent(eventType, comment.TaskId, CommentData{
+		Id:        comment.Id,
+		Message:   comment.Value,
+		CreatedAt: comment.CreatedAt,
+	})
+}
+
+// NewLabelEvent initializes an event about a label of a task
+func NewLabelEvent(eventType string, label *Label) Event {
+	return NewEvent(eventType, label.TaskId, LabelData{
+		Id:        label.Id,
+		Name:      label.Value,
+		CreatedAt: label.CreatedAt,
+	})
+}
+
+// After tells if the event comes after other in the outbox
+func (e *Event) After(other *Event) bool {
+	if e.TransactionId != other.TransactionId {
+		return e.TransactionId > other.TransactionId
+	}
+	return e.Sequence > other.Sequence
+}
@@ -0,0 +1,43 @@
+package model
+
+import (
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	RoleViewer    string = "viewer"
+	RoleCommenter string = "commenter"
+	RoleEditor    string = "editor"
+	// RoleOwner is the role of the owner of a task, it cannot be shared
+	RoleOwner string = "owner"
+)
+
+// RoleLevels orders the roles, a role can do everything the roles below it can do
+var RoleLevels map[string]int = map[string]int{
+	RoleViewer:    1,
+	RoleCommenter: 2,
+	RoleEditor:    3,
+	RoleOwner:     4,
+}
+
+// TaskMember is a teammate of the tenant a task is shared with, the subtasks of the task are shared with it too
+type TaskMember struct {
+	TaskId    uuid.UUID
+	MemberId  string
+	Role      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// TaskAccess is how the caller reaches a task, the owner of the task and the role of the caller on it
+type TaskAccess struct {
+	OwnerId string
+	Role    string
+}
+
+// Allows checks the role of the access is at least the given role
+func (a TaskAccess) Allows(role string) bool {
+	return RoleLevels[a.Role] >= RoleLevels[role]
+}
@@ -0,0 +1,22 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+type Reminder struct {
+	Id        uuid.UUID
+	TaskId    uuid.UUID
+	RemindAt  time.Time
+	SentAt    sql.NullTime
+	Attempts  int32
+	LastError sql.NullString
+	CreatedAt time.Time
+	DeletedAt sql.NullTime
+}
+
+// ReminderMaxAttempts is the number of times a reminder is notified before giving up on it
+const ReminderMaxAttempts int32 = 5
@@ -0,0 +1,28 @@
+package model
+
+// Where a search term was found
+const (
+	SearchSourceTask    string = "task"
+	SearchSourceComment string = "comment"
+	SearchSourceLabel   string = "label"
+)
+
+// TaskSearch is a full-text search over tasks, comments and labels
+type TaskSearch struct {
+	Query    string
+	Page     int32
+	PageSize uint64
+}
+
+// SearchMatch is a highlighted fragment of the text that matched the search
+type SearchMatch struct {
+	Source  string
+	Snippet string
+}
+
+// TaskSearchResult is a task ranked by its matches
+type TaskSearchResult struct {
+	Task    Task
+	Rank    float64
+	Matches []*SearchMatch
+}
@@ -8,13 +8,103 @@ import (
 )
 
 type Task struct {
-	Id        uuid.UUID
-	Value     string
-	Completed bool
-	DueDate   sql.NullTime
+	Id         uuid.UUID
+	Value      string
+	Completed  bool
+	DueDate    sql.NullTime
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	DeletedAt  sql.NullTime
+	Version    int64
+	ParentId   uuid.NullUUID
+	Priority   int32
+	Position   float64
+	Recurrence string
+	Comments   []*Comment
+	Labels     []*Label
+	Subtasks   []*Task
+}
+
+// TaskMaxDepth is the maximum number of levels of a task hierarchy, a task without parent is the first level
+const TaskMaxDepth int = 5
+
+// Columns of a task that can be updated
+const (
+	TaskFieldValue      string = "value"
+	TaskFieldCompleted  string = "completed"
+	TaskFieldDueDate    string = "due_date"
+	TaskFieldParentId   string = "parent_id"
+	TaskFieldPriority   string = "priority"
+	TaskFieldRecurrence string = "recurrence"
+)
+
+// Priorities of a task, from the lowest to the highest
+const (
+	TaskPriorityNone int32 = iota
+	TaskPriorityLow
+	TaskPriorityMedium
+	TaskPriorityHigh
+	TaskPriorityUrgent
+)
+
+// TaskUpdatableFields are the columns updated when no fields are given
+var TaskUpdatableFields []string = []string{
+	TaskFieldValue,
+	TaskFieldCompleted,
+	TaskFieldDueDate,
+}
+
+// What happens to the incomplete subtasks of a task when it is completed, nothing when it is empty
+const (
+	SubtaskCompletionRequire string = "require"
+	SubtaskCompletionCascade string = "cascade"
+)
+
+// TaskUpdate is how a task is updated, Fields are the columns written, all the updatable ones when it is empty.
+// SubtaskCompletion applies when the update completes the task, and NextOccurrence is created with the update when
+// it completes a recurring task, it gets the values of the created task. WasCompleted is the status of the task
+// before the update, it tells if the update completes it.
+type TaskUpdate struct {
+	Fields            []string
+	SubtaskCompletion string
+	NextOccurrence    *Task
+	WasCompleted      bool
+}
+
+// Columns that tasks can be sorted by
+const (
+	TaskSortByCreatedAt string = "created_at"
+	TaskSortByUpdatedAt string = "updated_at"
+	TaskSortByDueDate   string = "due_date"
+	TaskSortByValue     string = "value"
+	TaskSortByPriority  string = "priority"
+	TaskSortByPosition  string = "position"
+)
+
+// Directions that tasks can be sorted in
+const (
+	SortDirectionAsc  string = "ASC"
+	SortDirectionDesc string = "DESC"
+)
+
+// TaskCursor is the position of the last task seen with keyset pagination
+type TaskCursor struct {
 	CreatedAt time.Time
-	UpdatedAt time.Time
-	DeletedAt sql.NullTime
-	Comments  []*Comment
-	Labels    []*Label
+	Id        uuid.UUID
+}
+
+// TaskFilter narrows and orders the tasks returned by GetTasks.
+// When Limit is set, keyset pagination from After is used instead of Page.
+type TaskFilter struct {
+	Page          int32
+	Limit         uint64
+	After         *TaskCursor
+	Completed     sql.NullBool
+	DueBefore     sql.NullTime
+	DueAfter      sql.NullTime
+	CreatedAfter  sql.NullTime
+	CreatedBefore sql.NullTime
+	Label         string
+	SortBy        string
+	SortDirection string
 }
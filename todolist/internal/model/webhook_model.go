@@ -0,0 +1,39 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	WebhookDeliveryPending   string = "pending"
+	WebhookDeliveryDelivered string = "delivered"
+	WebhookDeliveryDead      string = "dead"
+)
+
+// Webhook is a subscription to the events in Events, all of them when it is empty
+type Webhook struct {
+	Id        uuid.UUID
+	Url       string
+	Secret    string
+	Events    []string
+	CreatedAt time.Time
+	DeletedAt sql.NullTime
+}
+
+// WebhookDelivery is an event posted to a webhook, it stays pending until it is delivered or it runs out of attempts
+type WebhookDelivery struct {
+	Id            uuid.UUID
+	WebhookId     uuid.UUID
+	Event         string
+	Payload       []byte
+	Status        string
+	Attempts      int32
+	NextAttemptAt time.Time
+	ResponseCode  sql.NullInt32
+	LastError     sql.NullString
+	DeliveredAt   sql.NullTime
+	CreatedAt     time.Time
+}
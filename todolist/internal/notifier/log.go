@@ -0,0 +1,34 @@
+package notifier
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+	"github.com/overridesh/sgg-todolist-service/tools"
+)
+
+// logNotifier writes the reminders to the service log, useful for development or as a fallback
+type logNotifier struct{}
+
+// NewLogNotifier initializes a notifier that only logs the reminders
+func NewLogNotifier() Notifier {
+	return &logNotifier{}
+}
+
+func (n *logNotifier) Notify(ctx context.Context, reminder *model.Reminder, task *model.Task) error {
+	fields := []interface{}{
+		"reminder_id", reminder.Id.String(),
+		"task_id", task.Id.String(),
+		"task", task.Value,
+		"remind_at", tools.FormatDate(reminder.RemindAt),
+	}
+
+	if task.DueDate.Valid {
+		fields = append(fields, "due_date", tools.FormatDate(task.DueDate.Time))
+	}
+
+	zap.S().Infow("task reminder", fields...)
+	return nil
+}
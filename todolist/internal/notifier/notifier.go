@@ -0,0 +1,12 @@
+package notifier
+
+import (
+	"context"
+
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+)
+
+// Notifier sends the reminders of a task when they are due
+type Notifier interface {
+	Notify(ctx context.Context, reminder *model.Reminder, task *model.Task) error
+}
@@ -0,0 +1,74 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+	"github.com/overridesh/sgg-todolist-service/tools"
+)
+
+// WebhookPayload is the JSON body posted by the webhook notifier
+type WebhookPayload struct {
+	ReminderId string `json:"reminder_id"`
+	TaskId     string `json:"task_id"`
+	Task       string `json:"task"`
+	RemindAt   string `json:"remind_at"`
+	DueDate    string `json:"due_date,omitempty"`
+}
+
+// webhookNotifier posts the reminders as JSON to an url, any status but 2xx is an error
+type webhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookNotifier initializes a notifier that posts the reminders to the url, waiting up to timeout for a response
+func NewWebhookNotifier(url string, timeout time.Duration) Notifier {
+	return &webhookNotifier{
+		url: url,
+		client: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+func (n *webhookNotifier) Notify(ctx context.Context, reminder *model.Reminder, task *model.Task) error {
+	payload := WebhookPayload{
+		ReminderId: reminder.Id.String(),
+		TaskId:     task.Id.String(),
+		Task:       task.Value,
+		RemindAt:   tools.FormatDate(reminder.RemindAt),
+	}
+
+	if task.DueDate.Valid {
+		payload.DueDate = tools.FormatDate(task.DueDate.Time)
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := n.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("webhook responded with status %d", response.StatusCode)
+	}
+
+	return nil
+}
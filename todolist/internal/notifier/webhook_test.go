@@ -0,0 +1,80 @@
+package notifier
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+)
+
+func TestWebhookNotifier(t *testing.T) {
+	reminder := model.Reminder{
+		Id:       uuid.NewV4(),
+		RemindAt: time.Date(2022, time.April, 4, 9, 0, 0, 0, time.UTC),
+	}
+	task := model.Task{
+		Id:      uuid.NewV4(),
+		Value:   "monthly report",
+		DueDate: sql.NullTime{Time: time.Date(2022, time.April, 4, 10, 0, 0, 0, time.UTC), Valid: true},
+	}
+
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{
+			name:    "Notify_Success",
+			status:  http.StatusNoContent,
+			wantErr: false,
+		},
+		{
+			name:    "Notify_ErrStatus",
+			status:  http.StatusBadGateway,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var payload WebhookPayload
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" {
+					t.Errorf("expect a json post, but got %s %s", r.Method, r.Header.Get("Content-Type"))
+				}
+				if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+					t.Errorf("cannot decode payload, error: %v", err)
+				}
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			err := NewWebhookNotifier(server.URL, time.Second).Notify(context.Background(), &reminder, &task)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expect error %v, but got %v", tt.wantErr, err)
+			}
+
+			if payload.ReminderId != reminder.Id.String() || payload.TaskId != task.Id.String() ||
+				payload.Task != task.Value || payload.DueDate != "2022-04-04T10:00:00.000Z" {
+				t.Errorf("unexpected payload %+v", payload)
+			}
+		})
+	}
+
+	t.Run("Notify_ErrUnreachable", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		server.Close()
+
+		if err := NewWebhookNotifier(server.URL, time.Second).Notify(context.Background(), &reminder, &task); err == nil {
+			t.Error("expect an error when the webhook is unreachable")
+		}
+	})
+}
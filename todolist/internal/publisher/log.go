@@ -0,0 +1,27 @@
+package publisher
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+)
+
+// logPublisher writes the events to the service log, useful for development
+type logPublisher struct{}
+
+// NewLogPublisher initializes a publisher that only logs the events
+func NewLogPublisher() EventPublisher {
+	return &logPublisher{}
+}
+
+func (p *logPublisher) Publish(ctx context.Context, event *model.Event) error {
+	zap.S().Infow("task event",
+		"event_id", event.Id.String(),
+		"sequence", event.Sequence,
+		"event", event.Type,
+		"task_id", event.TaskId.String(),
+	)
+	return nil
+}
@@ -0,0 +1,29 @@
+package publisher
+
+import (
+	"context"
+
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+)
+
+// multiPublisher publishes the events to several publishers in order
+type multiPublisher struct {
+	publishers []EventPublisher
+}
+
+// NewMultiPublisher initializes a publisher that fails as soon as one of the publishers fails, so the event is
+// published again to all of them
+func NewMultiPublisher(publishers ...EventPublisher) EventPublisher {
+	return &multiPublisher{
+		publishers: publishers,
+	}
+}
+
+func (p *multiPublisher) Publish(ctx context.Context, event *model.Event) error {
+	for _, publisher := range p.publishers {
+		if err := publisher.Publish(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,13 @@
+package publisher
+
+import (
+	"context"
+
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+)
+
+// EventPublisher publishes the events relayed from the outbox. An event can be published more than once, the
+// consumers should use its id to ignore the repeated ones.
+type EventPublisher interface {
+	Publish(ctx context.Context, event *model.Event) error
+}
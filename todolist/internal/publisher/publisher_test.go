@@ -0,0 +1,73 @@
+package publisher
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+	mockRepository "github.com/overridesh/sgg-todolist-service/pkg/mock"
+)
+
+func TestWebhookPublisher(t *testing.T) {
+	var (
+		errEnqueue error        = errors.New("cannot enqueue deliveries")
+		taskId     uuid.UUID    = uuid.NewV4()
+		event      *model.Event = &model.Event{
+			Id:     uuid.NewV4(),
+			Type:   model.EventTaskDeleted,
+			TaskId: taskId,
+			Data:   model.DeletedData{Id: taskId},
+		}
+	)
+
+	// The deliveries get the data as the gateway responds with it
+	delivery := *event
+	delivery.Data = json.RawMessage(`{"id":"` + taskId.String() + `"}`)
+
+	webhookRepository := new(mockRepository.WebhookRepository)
+	webhookRepository.On("EnqueueDeliveries", mock.Anything, delivery).Return(int64(2), nil).Once()
+	webhookRepository.On("EnqueueDeliveries", mock.Anything, delivery).Return(int64(0), errEnqueue).Once()
+
+	svc := NewWebhookPublisher(webhookRepository)
+
+	if err := svc.Publish(context.Background(), event); err != nil {
+		t.Errorf("expect no error, but got %v", err)
+	}
+
+	if err := svc.Publish(context.Background(), event); err != errEnqueue {
+		t.Errorf("expect %v, but got %v", errEnqueue, err)
+	}
+
+	// An event that cannot be read is skipped
+	malformed := &model.Event{Id: uuid.NewV4(), Type: model.EventTaskCreated, Data: json.RawMessage(`[]`)}
+	if err := svc.Publish(context.Background(), malformed); err != nil {
+		t.Errorf("expect no error, but got %v", err)
+	}
+
+	webhookRepository.AssertNumberOfCalls(t, "EnqueueDeliveries", 2)
+}
+
+func TestMultiPublisher(t *testing.T) {
+	var (
+		errPublish error        = errors.New("cannot publish event")
+		event      *model.Event = &model.Event{Id: uuid.NewV4(), Type: model.EventTaskCreated}
+	)
+
+	failing := new(mockRepository.EventPublisher)
+	failing.On("Publish", mock.Anything, event).Return(errPublish)
+
+	next := new(mockRepository.EventPublisher)
+	next.On("Publish", mock.Anything, event).Return(nil)
+
+	if err := NewMultiPublisher(next, failing, next).Publish(context.Background(), event); err != errPublish {
+		t.Errorf("expect %v, but got %v", errPublish, err)
+	}
+
+	// The publishers after the failing one are not called
+	next.AssertNumberOfCalls(t, "Publish", 1)
+}
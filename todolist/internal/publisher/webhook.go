@@ -0,0 +1,39 @@
+package publisher
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+	"github.com/overridesh/sgg-todolist-service/internal/repository"
+	"github.com/overridesh/sgg-todolist-service/internal/taskevent"
+)
+
+// webhookPublisher queues a delivery of the events for every webhook subscribed to them
+type webhookPublisher struct {
+	webhookRepository repository.WebhookRepository
+}
+
+// NewWebhookPublisher initializes a publisher that hands the events to the webhooks
+func NewWebhookPublisher(webhookRepository repository.WebhookRepository) EventPublisher {
+	return &webhookPublisher{
+		webhookRepository: webhookRepository,
+	}
+}
+
+// Publish queues the deliveries of an event with the resource as the gateway responds with it. An event that cannot be
+// read will never be, so it is logged and skipped instead of holding back the next events of its task.
+func (p *webhookPublisher) Publish(ctx context.Context, event *model.Event) error {
+	data, err := taskevent.Data(event)
+	if err != nil {
+		zap.S().Errorf("cannot read %s event %d, error: %v", event.Type, event.Sequence, err)
+		return nil
+	}
+
+	delivery := *event
+	delivery.Data = data
+
+	_, err = p.webhookRepository.EnqueueDeliveries(ctx, delivery)
+	return err
+}
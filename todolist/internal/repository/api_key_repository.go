@@ -0,0 +1,222 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/overridesh/sgg-todolist-service/internal/auth"
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+	storage "github.com/overridesh/sgg-todolist-service/pkg/storage/sql"
+)
+
+var (
+	ErrApiKeyNotFound = errors.New("api key not found")
+)
+
+const (
+	// apiKeyUseSeconds is how often the use of an api key is recorded, the keys are used on every request and
+	// last_used_at only needs to tell the keys that are still used
+	apiKeyUseSeconds int = 60
+
+	// useApiKeyPrefix finds the api key with the hash and records its use when the last one is older than
+	// apiKeyUseSeconds
+	useApiKeyPrefix string = `
+	WITH api_key AS (
+		SELECT id, owner_id, tenant_id, scopes, last_used_at
+		FROM api_keys
+		WHERE key_hash = ? AND revoked_at IS NULL
+	), used AS (
+		UPDATE api_keys SET last_used_at = NOW()
+		FROM api_key
+		WHERE api_keys.id = api_key.id
+		AND (api_key.last_used_at IS NULL OR api_key.last_used_at < NOW() - ? * INTERVAL '1 second')
+	)`
+)
+
+// ApiKeyRepository keeps the api keys of the callers, it is also the key store of the authentication
+type ApiKeyRepository interface {
+	auth.KeyStore
+	CreateApiKey(context.Context, model.ApiKey) (*model.ApiKey, error)
+	GetApiKeys(context.Context) ([]*model.ApiKey, error)
+	RevokeApiKey(context.Context, uuid.UUID) error
+}
+
+type apiKeyRepository struct {
+	db storage.DB
+}
+
+func NewApiKeyRepository(db storage.DB) ApiKeyRepository {
+	return &apiKeyRepository{
+		db: db,
+	}
+}
+
+// CreateApiKey creates an api key that belongs to the scope
+func (ar *apiKeyRepository) CreateApiKey(ctx context.Context, newApiKey model.ApiKey) (*model.ApiKey, error) {
+	ctx = storage.WithMethod(ctx, "apiKeyRepository.CreateApiKey")
+
+	scope, err := getScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query, args, err := psql.
+		Insert("api_keys").
+		Columns("name", "prefix", "key_hash", "scopes", "owner_id", "tenant_id").
+		Values(newApiKey.Name, newApiKey.Prefix, newApiKey.KeyHash, pq.Array(newApiKey.Scopes), scope.ownerId, scope.tenantId).
+		Suffix("RETURNING \"id\", \"created_at\"").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey := newApiKey
+	apiKey.OwnerId = scope.ownerId
+	apiKey.TenantId = scope.tenantId
+
+	if err := ar.db.QueryRowContext(ctx, query, args...).Scan(
+		&apiKey.Id,
+		&apiKey.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return &apiKey, nil
+}
+
+// GetApiKeys get the api keys of the scope that were not revoked
+func (ar *apiKeyRepository) GetApiKeys(ctx context.Context) ([]*model.ApiKey, error) {
+	ctx = storage.WithMethod(ctx, "apiKeyRepository.GetApiKeys")
+
+	scope, err := getScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query, args, err := psql.
+		Select(`
+			id,
+			name,
+			prefix,
+			scopes,
+			created_at,
+			last_used_at
+		`).
+		From("api_keys").
+		Where(sq.Eq{
+			"owner_id":   scope.ownerId,
+			"revoked_at": nil,
+			"tenant_id":  scope.tenantId,
+		}).
+		OrderBy("created_at", "id").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := ar.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var apiKeys []*model.ApiKey = []*model.ApiKey{}
+
+	for rows.Next() {
+		apiKey := model.ApiKey{
+			OwnerId:  scope.ownerId,
+			TenantId: scope.tenantId,
+		}
+
+		if err := rows.Scan(
+			&apiKey.Id,
+			&apiKey.Name,
+			&apiKey.Prefix,
+			pq.Array(&apiKey.Scopes),
+			&apiKey.CreatedAt,
+			&apiKey.LastUsedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		apiKeys = append(apiKeys, &apiKey)
+	}
+
+	return apiKeys, rows.Err()
+}
+
+// RevokeApiKey revokes an api key of the scope, it is not accepted anymore
+func (ar *apiKeyRepository) RevokeApiKey(ctx context.Context, id uuid.UUID) error {
+	ctx = storage.WithMethod(ctx, "apiKeyRepository.RevokeApiKey")
+
+	scope, err := getScope(ctx)
+	if err != nil {
+		return err
+	}
+
+	query, args, err := psql.
+		Update("api_keys").
+		Set("revoked_at", sq.Expr("NOW()")).
+		Where(sq.Eq{
+			"id":         id,
+			"owner_id":   scope.ownerId,
+			"revoked_at": nil,
+			"tenant_id":  scope.tenantId,
+		}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	result, err := ar.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return ErrApiKeyNotFound
+	}
+
+	return nil
+}
+
+// UseApiKey get the identity of the api key with the hash and records when it was used, at most once every
+// apiKeyUseSeconds. The caller is not authenticated yet, so it is not scoped.
+func (ar *apiKeyRepository) UseApiKey(ctx context.Context, keyHash string) (*auth.Identity, error) {
+	ctx = storage.WithMethod(ctx, "apiKeyRepository.UseApiKey")
+
+	query, args, err := psql.
+		Select("owner_id", "tenant_id", "scopes").
+		Prefix(useApiKeyPrefix, keyHash, apiKeyUseSeconds).
+		From("api_key").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var identity auth.Identity
+
+	if err := ar.db.QueryRowContext(ctx, query, args...).Scan(
+		&identity.Subject,
+		&identity.TenantId,
+		pq.Array(&identity.Scopes),
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, auth.ErrUnknownApiKey
+		}
+		return nil, err
+	}
+
+	return &identity, nil
+}
@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/overridesh/sgg-todolist-service/internal/auth"
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+)
+
+func TestCreateApiKey(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	newApiKey := model.ApiKey{
+		Name:    "ci",
+		Prefix:  "tdl_1234abcd",
+		KeyHash: auth.HashApiKey("tdl_1234abcd"),
+		Scopes:  []string{auth.ScopeReadTasks},
+	}
+
+	query, args, err := psql.
+		Insert("api_keys").
+		Columns("name", "prefix", "key_hash", "scopes", "owner_id", "tenant_id").
+		Values(newApiKey.Name, newApiKey.Prefix, newApiKey.KeyHash, pq.Array(newApiKey.Scopes), caller.Subject, caller.TenantId).
+		Suffix("RETURNING \"id\", \"created_at\"").
+		ToSql()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when creating a new query", err)
+	}
+
+	id := uuid.NewV4()
+
+	mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(driverArgs(args)...).WillReturnRows(
+		sqlmock.NewRows([]string{"id", "created_at"}).AddRow(id, time.Now()),
+	)
+
+	apiKey, err := NewApiKeyRepository(db).CreateApiKey(callerContext(), newApiKey)
+	if err != nil {
+		t.Fatalf("expect no error, but got %v", err)
+	}
+
+	if apiKey.Id != id || apiKey.OwnerId != caller.Subject || apiKey.TenantId != caller.TenantId {
+		t.Errorf("expect the api key of the caller, but got %v", apiKey)
+	}
+}
+
+func TestRevokeApiKey(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	id := uuid.NewV4()
+
+	query, args, err := psql.
+		Update("api_keys").
+		Set("revoked_at", sq.Expr("NOW()")).
+		Where(sq.Eq{
+			"id":         id,
+			"owner_id":   caller.Subject,
+			"revoked_at": nil,
+			"tenant_id":  caller.TenantId,
+		}).
+		ToSql()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when creating a new query", err)
+	}
+
+	mock.ExpectExec(regexp.QuoteMeta(query)).WithArgs(driverArgs(args)...).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if err := NewApiKeyRepository(db).RevokeApiKey(callerContext(), id); err != ErrApiKeyNotFound {
+		t.Errorf("expect %v, but got %v", ErrApiKeyNotFound, err)
+	}
+}
+
+func TestUseApiKey(t *testing.T) {
+	keyHash := auth.HashApiKey("tdl_1234abcd")
+
+	query, args, err := psql.
+		Select("owner_id", "tenant_id", "scopes").
+		Prefix(useApiKeyPrefix, keyHash, apiKeyUseSeconds).
+		From("api_key").
+		ToSql()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when creating a new query", err)
+	}
+
+	t.Run("UseApiKey_Identity", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		}
+		defer db.Close()
+
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(driverArgs(args)...).WillReturnRows(
+			sqlmock.NewRows([]string{"owner_id", "tenant_id", "scopes"}).AddRow(caller.Subject, caller.TenantId, "{read:tasks,write:tasks}"),
+		)
+
+		identity, err := NewApiKeyRepository(db).UseApiKey(callerContext(), keyHash)
+		if err != nil {
+			t.Fatalf("expect no error, but got %v", err)
+		}
+
+		if identity.Subject != caller.Subject || len(identity.Scopes) != 2 || identity.Scopes[1] != auth.ScopeWriteTasks {
+			t.Errorf("expect the identity of the api key, but got %v", identity)
+		}
+	})
+
+	t.Run("UseApiKey_ErrUnknownApiKey", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		}
+		defer db.Close()
+
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(driverArgs(args)...).WillReturnError(sql.ErrNoRows)
+
+		if _, err := NewApiKeyRepository(db).UseApiKey(callerContext(), keyHash); err != auth.ErrUnknownApiKey {
+			t.Errorf("expect %v, but got %v", auth.ErrUnknownApiKey, err)
+		}
+	})
+}
@@ -8,7 +8,6 @@ import (
 
 	sq "github.com/Masterminds/squirrel"
 	uuid "github.com/satori/go.uuid"
-	"go.uber.org/zap"
 
 	"github.com/overridesh/sgg-todolist-service/internal/model"
 	storage "github.com/overridesh/sgg-todolist-service/pkg/storage/sql"
@@ -34,57 +33,44 @@ func NewCommentRepository(db storage.DB) CommentRepository {
 	}
 }
 
+// CreateComment adds a comment to a task of the scope, ErrTaskNotFound when it is not found
 func (cr *commentRepository) CreateComment(ctx context.Context, newComment model.Comment) (*model.Comment, error) {
-	var (
-		err error
-		tx  *sql.Tx
-	)
+	ctx = storage.WithMethod(ctx, "commentRepository.CreateComment")
 
-	tx, err = cr.db.BeginTx(ctx, nil)
+	scope, err := getScope(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	defer func() {
-		if err != nil {
-			if err := tx.Rollback(); err != nil {
-				zap.S().Errorf("cannot do a rollback, error: %v", err)
-			}
-		} else {
-			if err := tx.Commit(); err != nil {
-				zap.S().Errorf("cannot do a commit, error: %v", err)
-			}
-		}
-	}()
-
 	query, args, err := psql.
 		Insert("comments").
 		Columns("task_id", "value").
-		Values(newComment.TaskId, newComment.Value).
+		Select(scope.task(newComment.TaskId, newComment.Value)).
 		Suffix("RETURNING \"id\", \"task_id\", \"value\", \"created_at\", \"deleted_at\"").
 		ToSql()
 	if err != nil {
 		return nil, err
 	}
 
-	row := tx.QueryRowContext(
-		ctx,
-		query,
-		args...,
-	)
-	if err := row.Err(); err != nil {
-		return nil, err
-	}
-
 	var comment model.Comment
 
-	if err := row.Scan(
-		&comment.Id,
-		&comment.TaskId,
-		&comment.Value,
-		&comment.CreatedAt,
-		&comment.DeletedAt,
-	); err != nil {
+	err = withTx(ctx, cr.db, func(tx storage.Tx) error {
+		if err := tx.QueryRowContext(ctx, query, args...).Scan(
+			&comment.Id,
+			&comment.TaskId,
+			&comment.Value,
+			&comment.CreatedAt,
+			&comment.DeletedAt,
+		); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrTaskNotFound
+			}
+			return err
+		}
+
+		return insertEvent(ctx, tx, model.NewCommentEvent(model.EventCommentCreated, &comment))
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -92,6 +78,13 @@ func (cr *commentRepository) CreateComment(ctx context.Context, newComment model
 }
 
 func (cr *commentRepository) GetCommentsByTaskId(ctx context.Context, taskId uuid.UUID) ([]*model.Comment, error) {
+	ctx = storage.WithMethod(ctx, "commentRepository.GetCommentsByTaskId")
+
+	scope, err := getScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	query, args, err := psql.
 		Select(`
 			id,
@@ -105,6 +98,7 @@ func (cr *commentRepository) GetCommentsByTaskId(ctx context.Context, taskId uui
 			"deleted_at": nil,
 			"task_id":    taskId,
 		}).
+		Where(scope.taskIds()).
 		ToSql()
 	if err != nil {
 		return nil, err
@@ -139,6 +133,13 @@ func (cr *commentRepository) GetCommentsByTaskId(ctx context.Context, taskId uui
 }
 
 func (cr *commentRepository) DeleteCommentByTaskIdAndCommentId(ctx context.Context, taskId uuid.UUID, commentId uuid.UUID) error {
+	ctx = storage.WithMethod(ctx, "commentRepository.DeleteCommentByTaskIdAndCommentId")
+
+	scope, err := getScope(ctx)
+	if err != nil {
+		return err
+	}
+
 	query, args, err := psql.
 		Update("comments").
 		Set("deleted_at", time.Now()).
@@ -146,24 +147,28 @@ func (cr *commentRepository) DeleteCommentByTaskIdAndCommentId(ctx context.Conte
 			"deleted_at": nil,
 			"task_id":    taskId,
 			"id":         commentId,
-		}).ToSql()
+		}).
+		Where(scope.taskIds()).
+		ToSql()
 	if err != nil {
 		return err
 	}
 
-	result, err := cr.db.ExecContext(ctx, query, args...)
-	if err != nil {
-		return err
-	}
+	return withTx(ctx, cr.db, func(tx storage.Tx) error {
+		result, err := tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			return err
+		}
 
-	affected, err := result.RowsAffected()
-	if err != nil {
-		return err
-	}
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
 
-	if affected == 0 {
-		return ErrCommentNotFound
-	}
+		if affected == 0 {
+			return ErrCommentNotFound
+		}
 
-	return nil
+		return insertEvent(ctx, tx, model.NewEvent(model.EventCommentDeleted, taskId, model.DeletedData{Id: commentId}))
+	})
 }
@@ -1,7 +1,6 @@
 package repository
 
 import (
-	"context"
 	"database/sql"
 	"errors"
 	"regexp"
@@ -46,7 +45,7 @@ func TestCreateComment(t *testing.T) {
 				query, args, err := psql.
 					Insert("comments").
 					Columns("task_id", "value").
-					Values(newComment.TaskId, newComment.Value).
+					Select(callerScope.task(newComment.TaskId, newComment.Value)).
 					Suffix("RETURNING \"id\", \"task_id\", \"value\", \"created_at\", \"deleted_at\"").
 					ToSql()
 				if err != nil {
@@ -55,7 +54,7 @@ func TestCreateComment(t *testing.T) {
 
 				mock.ExpectBegin()
 
-				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(args[0], args[1]).WillReturnRows(sqlmock.NewRows(
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(driverArgs(args)...).WillReturnRows(sqlmock.NewRows(
 					[]string{
 						"id",
 						"task_id",
@@ -71,11 +70,13 @@ func TestCreateComment(t *testing.T) {
 					newComment.DeletedAt,
 				))
 
+				expectInsertEvent(mock, model.EventCommentCreated, newComment.TaskId)
+
 				mock.ExpectCommit()
 
 				svc := NewCommentRepository(db)
 
-				return svc.CreateComment(context.Background(), model.Comment{
+				return svc.CreateComment(callerContext(), model.Comment{
 					TaskId: newComment.TaskId,
 					Value:  newComment.Value,
 				})
@@ -94,7 +95,7 @@ func TestCreateComment(t *testing.T) {
 				mock.ExpectBegin().WillReturnError(errCannotCreateTransaction)
 				svc := NewCommentRepository(db)
 
-				return svc.CreateComment(context.Background(), model.Comment{})
+				return svc.CreateComment(callerContext(), model.Comment{})
 			},
 			expect: errCannotCreateTransaction,
 		},
@@ -118,7 +119,7 @@ func TestCreateComment(t *testing.T) {
 				query, args, err := psql.
 					Insert("comments").
 					Columns("task_id", "value").
-					Values(newComment.TaskId, newComment.Value).
+					Select(callerScope.task(newComment.TaskId, newComment.Value)).
 					Suffix("RETURNING \"id\", \"task_id\", \"value\", \"created_at\", \"deleted_at\"").
 					ToSql()
 				if err != nil {
@@ -129,16 +130,36 @@ func TestCreateComment(t *testing.T) {
 
 				mock.ExpectQuery(
 					regexp.QuoteMeta(query)).
-					WithArgs(args[0], args[1]).
+					WithArgs(driverArgs(args)...).
 					WillReturnError(ErrCommentNotFound)
 
 				mock.ExpectRollback()
 
 				svc := NewCommentRepository(db)
-				return svc.CreateComment(context.Background(), newComment)
+				return svc.CreateComment(callerContext(), newComment)
 			},
 			expect: ErrCommentNotFound,
 		},
+		{
+			name: "CreateComment_ErrTaskNotFound",
+			input: func() (*model.Comment, error) {
+				db, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+				}
+				defer db.Close()
+
+				// The task of another owner or tenant is not selected, so nothing is inserted
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO comments (task_id,value) SELECT id, $1 FROM tasks WHERE deleted_at IS NULL AND id = $2 AND owner_id = $3 AND tenant_id = $4")).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "task_id", "value", "created_at", "deleted_at"}))
+				mock.ExpectCommit()
+
+				svc := NewCommentRepository(db)
+				return svc.CreateComment(callerContext(), model.Comment{TaskId: uuid.NewV4(), Value: "value"})
+			},
+			expect: ErrTaskNotFound,
+		},
 	}
 
 	for _, tt := range tests {
@@ -187,12 +208,13 @@ func TestGetCommentsByTaskId(t *testing.T) {
 						"deleted_at": nil,
 						"task_id":    newComment.TaskId,
 					}).
+					Where(callerScope.taskIds()).
 					ToSql()
 				if err != nil {
 					t.Fatalf("an error '%s' was not expected when creating a new query", err)
 				}
 
-				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(args[0]).WillReturnRows(sqlmock.NewRows(
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(driverArgs(args)...).WillReturnRows(sqlmock.NewRows(
 					[]string{
 						"id",
 						"task_id",
@@ -212,7 +234,7 @@ func TestGetCommentsByTaskId(t *testing.T) {
 
 				svc := NewCommentRepository(db)
 
-				return svc.GetCommentsByTaskId(context.Background(), newComment.TaskId)
+				return svc.GetCommentsByTaskId(callerContext(), newComment.TaskId)
 			},
 			expect: nil,
 		},
@@ -246,12 +268,13 @@ func TestGetCommentsByTaskId(t *testing.T) {
 						"deleted_at": nil,
 						"task_id":    newComment.TaskId,
 					}).
+					Where(callerScope.taskIds()).
 					ToSql()
 				if err != nil {
 					t.Fatalf("an error '%s' was not expected when creating a new query", err)
 				}
 
-				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(args[0]).WillReturnRows(sqlmock.NewRows(
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(driverArgs(args)...).WillReturnRows(sqlmock.NewRows(
 					[]string{
 						"id",
 						"task_id",
@@ -269,7 +292,7 @@ func TestGetCommentsByTaskId(t *testing.T) {
 
 				svc := NewCommentRepository(db)
 
-				return svc.GetCommentsByTaskId(context.Background(), newComment.TaskId)
+				return svc.GetCommentsByTaskId(callerContext(), newComment.TaskId)
 			},
 			expect: nil,
 		},
@@ -303,15 +326,16 @@ func TestGetCommentsByTaskId(t *testing.T) {
 						"deleted_at": nil,
 						"task_id":    newComment.TaskId,
 					}).
+					Where(callerScope.taskIds()).
 					ToSql()
 				if err != nil {
 					t.Fatalf("an error '%s' was not expected when creating a new query", err)
 				}
 
-				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(args[0]).WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(driverArgs(args)...).WillReturnError(sql.ErrNoRows)
 
 				svc := NewCommentRepository(db)
-				return svc.GetCommentsByTaskId(context.Background(), newComment.TaskId)
+				return svc.GetCommentsByTaskId(callerContext(), newComment.TaskId)
 			},
 			expect: sql.ErrNoRows,
 		},
@@ -362,15 +386,19 @@ func TestDeleteCommentByTaskIdAndCommentId(t *testing.T) {
 						"deleted_at": nil,
 						"task_id":    newComment.TaskId,
 						"id":         newComment.Id,
-					}).ToSql()
+					}).
+					Where(callerScope.taskIds()).
+					ToSql()
 				if err != nil {
 					t.Fatalf("an error '%s' was not expected when creating a new query", err)
 				}
 
-				mock.ExpectExec(regexp.QuoteMeta(query)).WithArgs(args[0], args[1], args[2]).WillReturnError(sql.ErrNoRows)
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(query)).WithArgs(driverArgs(args)...).WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
 
 				svc := NewCommentRepository(db)
-				return svc.DeleteCommentByTaskIdAndCommentId(context.Background(), newComment.TaskId, newComment.Id)
+				return svc.DeleteCommentByTaskIdAndCommentId(callerContext(), newComment.TaskId, newComment.Id)
 			},
 			expect: sql.ErrNoRows,
 		},
@@ -403,15 +431,20 @@ func TestDeleteCommentByTaskIdAndCommentId(t *testing.T) {
 						"deleted_at": nil,
 						"task_id":    newComment.TaskId,
 						"id":         newComment.Id,
-					}).ToSql()
+					}).
+					Where(callerScope.taskIds()).
+					ToSql()
 				if err != nil {
 					t.Fatalf("an error '%s' was not expected when creating a new query", err)
 				}
 
-				mock.ExpectExec(regexp.QuoteMeta(query)).WithArgs(args[0], args[1], args[2]).WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(query)).WithArgs(driverArgs(args)...).WillReturnResult(sqlmock.NewResult(1, 1))
+				expectInsertEvent(mock, model.EventCommentDeleted, newComment.TaskId)
+				mock.ExpectCommit()
 
 				svc := NewCommentRepository(db)
-				return svc.DeleteCommentByTaskIdAndCommentId(context.Background(), newComment.TaskId, newComment.Id)
+				return svc.DeleteCommentByTaskIdAndCommentId(callerContext(), newComment.TaskId, newComment.Id)
 			},
 			expect: nil,
 		},
@@ -444,17 +477,21 @@ func TestDeleteCommentByTaskIdAndCommentId(t *testing.T) {
 						"deleted_at": nil,
 						"task_id":    newComment.TaskId,
 						"id":         newComment.Id,
-					}).ToSql()
+					}).
+					Where(callerScope.taskIds()).
+					ToSql()
 				if err != nil {
 					t.Fatalf("an error '%s' was not expected when creating a new query", err)
 				}
 
 				row := sqlmock.NewResult(0, 0)
 
-				mock.ExpectExec(regexp.QuoteMeta(query)).WithArgs(args[0], args[1], args[2]).WillReturnResult(row)
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(query)).WithArgs(driverArgs(args)...).WillReturnResult(row)
+				mock.ExpectRollback()
 
 				svc := NewCommentRepository(db)
-				return svc.DeleteCommentByTaskIdAndCommentId(context.Background(), newComment.TaskId, newComment.Id)
+				return svc.DeleteCommentByTaskIdAndCommentId(callerContext(), newComment.TaskId, newComment.Id)
 			},
 			expect: ErrCommentNotFound,
 		},
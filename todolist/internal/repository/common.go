@@ -1,10 +1,20 @@
 package repository
 
-import sq "github.com/Masterminds/squirrel"
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.uber.org/zap"
+
+	storage "github.com/overridesh/sgg-todolist-service/pkg/storage/sql"
+)
+
+// DefaultPageSize is the page size of the lists when the caller sets none
+const DefaultPageSize uint64 = 20
 
 var (
-	limitPage uint64 = 20
-	limitOne  uint64 = 1
+	limitOne uint64 = 1
 )
 
 var (
@@ -21,3 +31,50 @@ func GetOffset(page int32, pageSize uint64) uint64 {
 	}
 	return uint64((value - 1)) * pageSize
 }
+
+// withTx run fn in a transaction, it is committed when fn succeeds and rolled back otherwise. Its statements run on
+// the decorators of db, like the ones that are not in a transaction.
+func withTx(ctx context.Context, db storage.DB, fn func(storage.Tx) error) error {
+	tx, err := storage.BeginTx(ctx, db, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(tx); err != nil {
+		if err := tx.Rollback(); err != nil {
+			zap.S().Errorf("cannot do a rollback, error: %v", err)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// renewClaim moves the claim made at claimedAt of the row of table whose column is key to renewedAt, a worker renews
+// the claim of a row right before working on it so the lease only has to outlive one row. It is false when the row is
+// not claimed at claimedAt anymore, because its lease ended and another replica claimed it.
+func renewClaim(ctx context.Context, db storage.DB, table string, column string, key interface{}, claimedAt time.Time, renewedAt time.Time) (bool, error) {
+	query, args, err := psql.
+		Update(table).
+		Set("claimed_at", renewedAt).
+		Where(sq.Eq{
+			column:       key,
+			"claimed_at": claimedAt,
+		}).
+		ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	result, err := db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
@@ -0,0 +1,425 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+	storage "github.com/overridesh/sgg-todolist-service/pkg/storage/sql"
+)
+
+const (
+	// outboxLockKey is the advisory lock held while claiming the events, so only one replica claims them at a time
+	outboxLockKey int64 = 0x6f7574626f78
+	// eventClaimLease is how long the events claimed by a replica are skipped by the others, the claim of an event is
+	// renewed right before it is published so the lease only has to outlive the publish of one event
+	eventClaimLease time.Duration = 5 * time.Minute
+)
+
+const (
+	// eventTransactionIdQuery is the transaction_id of a new event of a task, the one of its transaction unless an
+	// earlier event of the task has a higher one, so the events of a task keep their order
+	eventTransactionIdQuery string = "GREATEST(txid_current(), (SELECT MAX(transaction_id) FROM events WHERE task_id = ?))"
+	// settledEventsQuery keeps the events whose transaction_id is lower than the one of every running transaction, the
+	// events written from now on come after them
+	settledEventsQuery string = "transaction_id < txid_snapshot_xmin(txid_current_snapshot())"
+	// waitingEventsQuery leaves out the events that wait for an earlier unpublished event of their task, one that is
+	// not due yet or that is claimed, given the time and the start of the lease of the claims
+	waitingEventsQuery string = `NOT EXISTS (
+		SELECT 1 FROM events AS earlier
+		WHERE earlier.task_id = events.task_id AND earlier.sequence < events.sequence AND earlier.published_at IS NULL
+		AND (earlier.next_attempt_at > ? OR earlier.claimed_at >= ?)
+	)`
+)
+
+// PublishFunc publishes an event of the outbox
+type PublishFunc func(ctx context.Context, event *model.Event) error
+
+// DelayFunc tells how long to wait before the next attempt of a failed event
+type DelayFunc func(attempts int32) time.Duration
+
+type EventRepository interface {
+	GetEventsAfter(ctx context.Context, sequence int64, limit uint64) ([]*model.Event, error)
+	GetLastSequence(ctx context.Context) (int64, error)
+	HasPendingEvents(ctx context.Context) (bool, error)
+	ProcessUnpublishedEvents(ctx context.Context, now time.Time, limit uint64, publish PublishFunc, delay DelayFunc) (int, error)
+}
+
+type eventRepository struct {
+	db storage.DB
+}
+
+func NewEventRepository(db storage.DB) EventRepository {
+	return &eventRepository{
+		db: db,
+	}
+}
+
+// GetEventsAfter returns up to limit events that come after the event of sequence in order, it is how a stream is
+// resumed. Only the settled events are returned, so no event shows up later before the last one returned. Every
+// settled event comes after a sequence that is not in the outbox.
+func (er *eventRepository) GetEventsAfter(ctx context.Context, sequence int64, limit uint64) ([]*model.Event, error) {
+	ctx = storage.WithMethod(ctx, "eventRepository.GetEventsAfter")
+
+	query, args, err := selectEvents().
+		Where(settledEventsQuery).
+		Where("(transaction_id, sequence) > (COALESCE((SELECT transaction_id FROM events WHERE sequence = ?), 0), ?)", sequence, sequence).
+		OrderBy("transaction_id", "sequence").
+		Limit(limit).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := er.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanEvents(rows)
+}
+
+// GetLastSequence returns the sequence of the last settled event, 0 when there is none
+func (er *eventRepository) GetLastSequence(ctx context.Context) (int64, error) {
+	ctx = storage.WithMethod(ctx, "eventRepository.GetLastSequence")
+
+	query, args, err := psql.
+		Select("sequence").
+		From("events").
+		Where(settledEventsQuery).
+		OrderBy("transaction_id DESC", "sequence DESC").
+		Limit(limitOne).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var sequence int64
+
+	if err := er.db.QueryRowContext(ctx, query, args...).Scan(&sequence); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	return sequence, nil
+}
+
+// HasPendingEvents tells if there are events that are written but not settled yet, they wait for an earlier
+// transaction to end
+func (er *eventRepository) HasPendingEvents(ctx context.Context) (bool, error) {
+	ctx = storage.WithMethod(ctx, "eventRepository.HasPendingEvents")
+
+	query, args, err := psql.
+		Select("1").
+		From("events").
+		Where("transaction_id >= txid_snapshot_xmin(txid_current_snapshot())").
+		Prefix("SELECT EXISTS (").
+		Suffix(")").
+		ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	var pending bool
+
+	if err := er.db.QueryRowContext(ctx, query, args...).Scan(&pending); err != nil {
+		return false, err
+	}
+
+	return pending, nil
+}
+
+// ProcessUnpublishedEvents publishes up to limit events of the outbox in order and marks them as published, returning
+// how many were published. An event is published again until it succeeds, so the delivery is at least once. The
+// events are claimed in a short transaction and published outside of it. When an event fails it is published again
+// after the delay given by delay, and the next events of the same task wait for it, which keeps the order of the
+// events of every task while the other tasks go on.
+func (er *eventRepository) ProcessUnpublishedEvents(ctx context.Context, now time.Time, limit uint64, publish PublishFunc, delay DelayFunc) (int, error) {
+	ctx = storage.WithMethod(ctx, "eventRepository.ProcessUnpublishedEvents")
+
+	// postgres keeps microseconds, the claim is told apart by its exact time
+	now = now.Truncate(time.Microsecond)
+	started := time.Now()
+
+	claimed, err := er.claimUnpublishedEvents(ctx, now, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		published int
+		waiting   []int64
+	)
+
+	failed := map[uuid.UUID]bool{}
+
+	for _, item := range claimed {
+		if failed[item.event.TaskId] {
+			waiting = append(waiting, item.event.Sequence)
+			continue
+		}
+
+		renewed, err := renewClaim(ctx, er.db, "events", "sequence", item.event.Sequence, now, now.Add(time.Since(started)).Truncate(time.Microsecond))
+		if err != nil {
+			return published, err
+		}
+
+		// The lease ended and another replica claimed the event, it publishes the next events of the task too
+		if !renewed {
+			failed[item.event.TaskId] = true
+			continue
+		}
+
+		builder := psql.
+			Update("events").
+			Set("claimed_at", nil).
+			Where(sq.Eq{"sequence": item.event.Sequence})
+
+		if err := publish(ctx, &item.event); err != nil {
+			failed[item.event.TaskId] = true
+
+			attempts := item.attempts + 1
+			builder = builder.
+				Set("attempts", attempts).
+				Set("next_attempt_at", now.Add(delay(attempts)))
+		} else {
+			builder = builder.Set("published_at", now)
+			published++
+		}
+
+		query, args, err := builder.ToSql()
+		if err != nil {
+			return published, err
+		}
+
+		if _, err := er.db.ExecContext(ctx, query, args...); err != nil {
+			return published, err
+		}
+	}
+
+	if len(waiting) == 0 {
+		return published, nil
+	}
+
+	// The events that wait for a failed one are given back, unless the lease ended in the meantime
+	query, args, err := psql.
+		Update("events").
+		Set("claimed_at", nil).
+		Where(sq.Eq{
+			"sequence":   waiting,
+			"claimed_at": now,
+		}).
+		ToSql()
+	if err != nil {
+		return published, err
+	}
+
+	_, err = er.db.ExecContext(ctx, query, args...)
+	return published, err
+}
+
+// unpublishedEvent is a claimed event with its failed attempts
+type unpublishedEvent struct {
+	event    model.Event
+	attempts int32
+}
+
+// claimUnpublishedEvents claims up to limit unpublished events due at now in order, that are not claimed by another
+// replica. The events of a task are only claimed while the earlier ones of the task are claimed too, so they are
+// published one after another.
+func (er *eventRepository) claimUnpublishedEvents(ctx context.Context, now time.Time, limit uint64) ([]*unpublishedEvent, error) {
+	var claimed []*unpublishedEvent
+
+	err := withTx(ctx, er.db, func(tx storage.Tx) error {
+		var locked bool
+
+		if err := tx.QueryRowContext(ctx, "SELECT pg_try_advisory_xact_lock($1)", outboxLockKey).Scan(&locked); err != nil {
+			return err
+		}
+
+		// Another replica is claiming the events
+		if !locked {
+			return nil
+		}
+
+		query, args, err := psql.
+			Select(`
+				sequence,
+				transaction_id,
+				id,
+				type,
+				task_id,
+				data,
+				occurred_at,
+				owner_id,
+				tenant_id,
+				attempts
+			`).
+			From("events").
+			Where(sq.Eq{"published_at": nil}).
+			Where(sq.Or{
+				sq.Eq{"next_attempt_at": nil},
+				sq.LtOrEq{"next_attempt_at": now},
+			}).
+			Where(sq.Or{
+				sq.Eq{"claimed_at": nil},
+				sq.Lt{"claimed_at": now.Add(-eventClaimLease)},
+			}).
+			Where(waitingEventsQuery, now, now.Add(-eventClaimLease)).
+			OrderBy("sequence").
+			Limit(limit).
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		rows, err := tx.QueryContext(ctx, query, args...)
+		if err != nil {
+			return err
+		}
+
+		var sequences []int64
+
+		for rows.Next() {
+			var (
+				item unpublishedEvent
+				data json.RawMessage
+			)
+
+			if err := rows.Scan(
+				&item.event.Sequence,
+				&item.event.TransactionId,
+				&item.event.Id,
+				&item.event.Type,
+				&item.event.TaskId,
+				&data,
+				&item.event.OccurredAt,
+				&item.event.OwnerId,
+				&item.event.TenantId,
+				&item.attempts,
+			); err != nil {
+				rows.Close()
+				return err
+			}
+
+			item.event.Data = data
+			claimed = append(claimed, &item)
+			sequences = append(sequences, item.event.Sequence)
+		}
+		rows.Close()
+
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		if len(sequences) == 0 {
+			return nil
+		}
+
+		query, args, err = psql.
+			Update("events").
+			Set("claimed_at", now).
+			Where(sq.Eq{"sequence": sequences}).
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, query, args...)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return claimed, nil
+}
+
+func selectEvents() sq.SelectBuilder {
+	return psql.
+		Select(`
+			sequence,
+			transaction_id,
+			id,
+			type,
+			task_id,
+			data,
+			occurred_at,
+			owner_id,
+			tenant_id
+		`).
+		From("events")
+}
+
+// scanEvents reads and closes the rows of selectEvents, the data of the events is left as raw JSON
+func scanEvents(rows *sql.Rows) ([]*model.Event, error) {
+	defer rows.Close()
+
+	var events []*model.Event = []*model.Event{}
+
+	for rows.Next() {
+		var (
+			event model.Event
+			data  json.RawMessage
+		)
+
+		if err := rows.Scan(
+			&event.Sequence,
+			&event.TransactionId,
+			&event.Id,
+			&event.Type,
+			&event.TaskId,
+			&data,
+			&event.OccurredAt,
+			&event.OwnerId,
+			&event.TenantId,
+		); err != nil {
+			return nil, err
+		}
+
+		event.Data = data
+		events = append(events, &event)
+	}
+
+	return events, rows.Err()
+}
+
+// insertEvent writes an event to the outbox in the transaction of the change it is about, with the scope of the
+// caller so only its watches get the event. The writers of the events of a task take turns until their transaction
+// ends, so the events of a task are in the order they are committed. The events are written last to hold the turn for
+// a short time.
+func insertEvent(ctx context.Context, tx storage.Tx, event model.Event) error {
+	scope, err := getScope(ctx)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(event.Data)
+	if err != nil {
+		return err
+	}
+
+	query, args, err := psql.
+		Insert("events").
+		Columns("id", "type", "task_id", "data", "occurred_at", "owner_id", "tenant_id", "transaction_id").
+		Values(event.Id, event.Type, event.TaskId, data, event.OccurredAt, scope.ownerId, scope.tenantId, sq.Expr(eventTransactionIdQuery, event.TaskId)).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))", event.TaskId.String()); err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	return err
+}
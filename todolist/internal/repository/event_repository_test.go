@@ -0,0 +1,295 @@
+package repository
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+)
+
+var eventColumns []string = []string{
+	"sequence",
+	"transaction_id",
+	"id",
+	"type",
+	"task_id",
+	"data",
+	"occurred_at",
+	"owner_id",
+	"tenant_id",
+}
+
+func TestProcessUnpublishedEvents(t *testing.T) {
+	var (
+		errPublish error     = errors.New("broker is down")
+		now        time.Time = time.Now().Truncate(time.Microsecond)
+	)
+
+	delay := func(attempts int32) time.Duration {
+		return time.Duration(attempts) * time.Minute
+	}
+
+	// expectClaim expects the unpublished events to be claimed, they are rows of eventColumns and their attempts
+	expectClaim := func(mock sqlmock.Sqlmock, rows *sqlmock.Rows, sequences ...driver.Value) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT pg_try_advisory_xact_lock($1)")).
+			WithArgs(outboxLockKey).
+			WillReturnRows(sqlmock.NewRows([]string{"pg_try_advisory_xact_lock"}).AddRow(true))
+		mock.ExpectQuery(regexp.QuoteMeta("FROM events WHERE published_at IS NULL AND (next_attempt_at IS NULL OR next_attempt_at <= $1) AND (claimed_at IS NULL OR claimed_at < $2) AND NOT EXISTS")).
+			WithArgs(now, now.Add(-eventClaimLease), now, now.Add(-eventClaimLease)).
+			WillReturnRows(rows)
+		mock.ExpectExec(regexp.QuoteMeta("UPDATE events SET claimed_at = $1 WHERE sequence IN")).
+			WithArgs(append([]driver.Value{now}, sequences...)...).
+			WillReturnResult(sqlmock.NewResult(0, int64(len(sequences))))
+		mock.ExpectCommit()
+	}
+
+	// expectRenew expects the claim of the event to be renewed, renewed tells if it was still claimed
+	expectRenew := func(mock sqlmock.Sqlmock, sequence int64, renewed bool) {
+		var affected int64
+		if renewed {
+			affected = 1
+		}
+
+		mock.ExpectExec(regexp.QuoteMeta("UPDATE events SET claimed_at = $1 WHERE claimed_at = $2 AND sequence = $3")).
+			WithArgs(sqlmock.AnyArg(), now, sequence).
+			WillReturnResult(sqlmock.NewResult(0, affected))
+	}
+
+	claimColumns := append(eventColumns, "attempts")
+
+	t.Run("ProcessUnpublishedEvents_KeepOrderPerTask", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		}
+		defer db.Close()
+
+		var (
+			failing uuid.UUID = uuid.NewV4()
+			other   uuid.UUID = uuid.NewV4()
+		)
+
+		expectClaim(mock, sqlmock.NewRows(claimColumns).
+			AddRow(1, 100, uuid.NewV4(), model.EventTaskCreated, other, []byte(`{}`), now, caller.Subject, caller.TenantId, 0).
+			AddRow(2, 100, uuid.NewV4(), model.EventTaskCreated, failing, []byte(`{}`), now, caller.Subject, caller.TenantId, 2).
+			AddRow(3, 100, uuid.NewV4(), model.EventTaskUpdated, failing, []byte(`{}`), now, caller.Subject, caller.TenantId, 0).
+			AddRow(4, 100, uuid.NewV4(), model.EventTaskUpdated, other, []byte(`{}`), now, caller.Subject, caller.TenantId, 0),
+			int64(1), int64(2), int64(3), int64(4))
+
+		expectRenew(mock, 1, true)
+		mock.ExpectExec(regexp.QuoteMeta("UPDATE events SET claimed_at = $1, published_at = $2 WHERE sequence = $3")).
+			WithArgs(nil, now, int64(1)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		expectRenew(mock, 2, true)
+		mock.ExpectExec(regexp.QuoteMeta("UPDATE events SET claimed_at = $1, attempts = $2, next_attempt_at = $3 WHERE sequence = $4")).
+			WithArgs(nil, int32(3), now.Add(3*time.Minute), int64(2)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		expectRenew(mock, 4, true)
+		mock.ExpectExec(regexp.QuoteMeta("UPDATE events SET claimed_at = $1, published_at = $2 WHERE sequence = $3")).
+			WithArgs(nil, now, int64(4)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		mock.ExpectExec(regexp.QuoteMeta("UPDATE events SET claimed_at = $1 WHERE claimed_at = $2 AND sequence IN ($3)")).
+			WithArgs(nil, now, int64(3)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		var sequences []int64
+
+		svc := NewEventRepository(db)
+		published, err := svc.ProcessUnpublishedEvents(context.Background(), now, 10, func(ctx context.Context, event *model.Event) error {
+			sequences = append(sequences, event.Sequence)
+			if event.TaskId == failing {
+				return errPublish
+			}
+			return nil
+		}, delay)
+		if err != nil {
+			t.Fatalf("expect no error, but got %v", err)
+		}
+
+		if published != 2 {
+			t.Errorf("expect 2 events published, but got %d", published)
+		}
+
+		// The event 3 waits for the event 2 of the same task
+		if len(sequences) != 3 || sequences[0] != 1 || sequences[1] != 2 || sequences[2] != 4 {
+			t.Errorf("expect the events 1, 2 and 4 to be published, but got %v", sequences)
+		}
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("ProcessUnpublishedEvents_ClaimLost", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		}
+		defer db.Close()
+
+		taskId := uuid.NewV4()
+
+		expectClaim(mock, sqlmock.NewRows(claimColumns).
+			AddRow(1, 100, uuid.NewV4(), model.EventTaskCreated, taskId, []byte(`{}`), now, caller.Subject, caller.TenantId, 0).
+			AddRow(2, 100, uuid.NewV4(), model.EventTaskUpdated, taskId, []byte(`{}`), now, caller.Subject, caller.TenantId, 0),
+			int64(1), int64(2))
+
+		// Another replica claimed the event once the lease ended, it publishes the events of the task
+		expectRenew(mock, 1, false)
+		mock.ExpectExec(regexp.QuoteMeta("UPDATE events SET claimed_at = $1 WHERE claimed_at = $2 AND sequence IN ($3)")).
+			WithArgs(nil, now, int64(2)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		svc := NewEventRepository(db)
+		published, err := svc.ProcessUnpublishedEvents(context.Background(), now, 10, func(ctx context.Context, event *model.Event) error {
+			t.Error("expect no event to be published")
+			return nil
+		}, delay)
+		if err != nil || published != 0 {
+			t.Errorf("expect nothing published, but got %d %v", published, err)
+		}
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("ProcessUnpublishedEvents_LockedByAnotherReplica", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		}
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT pg_try_advisory_xact_lock($1)")).
+			WithArgs(outboxLockKey).
+			WillReturnRows(sqlmock.NewRows([]string{"pg_try_advisory_xact_lock"}).AddRow(false))
+		mock.ExpectCommit()
+
+		svc := NewEventRepository(db)
+		published, err := svc.ProcessUnpublishedEvents(context.Background(), now, 10, func(ctx context.Context, event *model.Event) error {
+			t.Error("expect no event to be published")
+			return nil
+		}, delay)
+		if err != nil || published != 0 {
+			t.Errorf("expect nothing published, but got %d %v", published, err)
+		}
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+}
+
+func TestGetEventsAfter(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("FROM events WHERE transaction_id < txid_snapshot_xmin(txid_current_snapshot()) AND (transaction_id, sequence) > (COALESCE((SELECT transaction_id FROM events WHERE sequence = $1), 0), $2) ORDER BY transaction_id, sequence LIMIT 100")).
+		WithArgs(int64(7), int64(7)).
+		WillReturnRows(sqlmock.NewRows(eventColumns).
+			AddRow(8, 100, uuid.NewV4(), model.EventCommentCreated, uuid.NewV4(), []byte(`{"message":"hi"}`), time.Now(), caller.Subject, caller.TenantId))
+
+	svc := NewEventRepository(db)
+	events, err := svc.GetEventsAfter(context.Background(), 7, 100)
+	if err != nil {
+		t.Fatalf("expect no error, but got %v", err)
+	}
+
+	if len(events) != 1 || events[0].Sequence != 8 || string(events[0].Data.(json.RawMessage)) != `{"message":"hi"}` ||
+		events[0].OwnerId != caller.Subject || events[0].TenantId != caller.TenantId {
+		t.Errorf("expect the event 8, but got %v", events)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestGetLastSequence(t *testing.T) {
+	tests := []struct {
+		name   string
+		rows   *sqlmock.Rows
+		expect int64
+	}{
+		{
+			name:   "GetLastSequence_Success",
+			rows:   sqlmock.NewRows([]string{"sequence"}).AddRow(9),
+			expect: 9,
+		},
+		{
+			name:   "GetLastSequence_NoEvents",
+			rows:   sqlmock.NewRows([]string{"sequence"}),
+			expect: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer db.Close()
+
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT sequence FROM events WHERE transaction_id < txid_snapshot_xmin(txid_current_snapshot()) ORDER BY transaction_id DESC, sequence DESC LIMIT 1")).
+				WillReturnRows(tt.rows)
+
+			sequence, err := NewEventRepository(db).GetLastSequence(context.Background())
+			if err != nil || sequence != tt.expect {
+				t.Errorf("expect the sequence %d, but got %d %v", tt.expect, sequence, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestHasPendingEvents(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT EXISTS ( SELECT 1 FROM events WHERE transaction_id >= txid_snapshot_xmin(txid_current_snapshot()) )")).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+	pending, err := NewEventRepository(db).HasPendingEvents(context.Background())
+	if err != nil || !pending {
+		t.Errorf("expect pending events, but got %v %v", pending, err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+// expectInsertEvent expects the event of eventType to be written for taskId, after the lock that keeps the events of
+// the task in the order of the commits
+func expectInsertEvent(mock sqlmock.Sqlmock, eventType string, taskId uuid.UUID) {
+	mock.ExpectExec(regexp.QuoteMeta("SELECT pg_advisory_xact_lock(hashtext($1))")).
+		WithArgs(taskId.String()).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO events (id,type,task_id,data,occurred_at,owner_id,tenant_id,transaction_id) VALUES ($1,$2,$3,$4,$5,$6,$7,GREATEST(txid_current(), (SELECT MAX(transaction_id) FROM events WHERE task_id = $8)))")).
+		WithArgs(sqlmock.AnyArg(), eventType, taskId, sqlmock.AnyArg(), sqlmock.AnyArg(), caller.Subject, caller.TenantId, taskId).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+}
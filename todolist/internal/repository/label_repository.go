@@ -8,7 +8,6 @@ import (
 
 	sq "github.com/Masterminds/squirrel"
 	uuid "github.com/satori/go.uuid"
-	"go.uber.org/zap"
 
 	"github.com/overridesh/sgg-todolist-service/internal/model"
 	storage "github.com/overridesh/sgg-todolist-service/pkg/storage/sql"
@@ -35,84 +34,66 @@ func NewLabelRepository(db storage.DB) LabelRepository {
 	}
 }
 
+// CreateLabel adds a label to a task of the scope, ErrTaskNotFound when it is not found
 func (cr *labelRepository) CreateLabel(ctx context.Context, newLabel model.Label) (*model.Label, error) {
-	var (
-		err error
-		tx  *sql.Tx
-	)
+	ctx = storage.WithMethod(ctx, "labelRepository.CreateLabel")
 
-	tx, err = cr.db.BeginTx(ctx, nil)
+	scope, err := getScope(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	defer func() {
+	var label model.Label
+
+	err = withTx(ctx, cr.db, func(tx storage.Tx) error {
+		query, args, err := psql.Select("COUNT(id)").
+			From("labels").
+			Where(sq.Eq{
+				"deleted_at": nil,
+				"task_id":    newLabel.TaskId,
+				"value":      newLabel.Value,
+			}).
+			Where(scope.taskIds()).
+			ToSql()
 		if err != nil {
-			if err := tx.Rollback(); err != nil {
-				zap.S().Errorf("cannot do a rollback, error: %v", err)
-			}
-		} else {
-			if err := tx.Commit(); err != nil {
-				zap.S().Errorf("cannot do a commit, error: %v", err)
-			}
+			return err
 		}
-	}()
 
-	query, args, err := psql.Select("COUNT(id)").
-		From("labels").
-		Where(sq.Eq{
-			"deleted_at": nil,
-			"task_id":    newLabel.TaskId,
-			"value":      newLabel.Value,
-		}).ToSql()
-
-	row := tx.QueryRowContext(
-		ctx,
-		query,
-		args...,
-	)
-	if err := row.Err(); err != nil {
-		return nil, err
-	}
-
-	var count int64
-	if err := row.Scan(
-		&count,
-	); err != nil {
-		return nil, err
-	}
+		var count int64
+		if err := tx.QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+			return err
+		}
 
-	if count > 0 {
-		return nil, ErrLabelAlreadyExists
-	}
+		if count > 0 {
+			return ErrLabelAlreadyExists
+		}
 
-	query, args, err = psql.
-		Insert("labels").
-		Columns("task_id", "value").
-		Values(newLabel.TaskId, newLabel.Value).
-		Suffix("RETURNING \"id\", \"task_id\", \"value\", \"created_at\", \"deleted_at\"").
-		ToSql()
-	if err != nil {
-		return nil, err
-	}
+		query, args, err = psql.
+			Insert("labels").
+			Columns("task_id", "value").
+			Select(scope.task(newLabel.TaskId, newLabel.Value)).
+			Suffix("RETURNING \"id\", \"task_id\", \"value\", \"created_at\", \"deleted_at\"").
+			ToSql()
+		if err != nil {
+			return err
+		}
 
-	row = tx.QueryRowContext(
-		ctx,
-		query,
-		args...,
-	)
-	if err := row.Err(); err != nil {
-		return nil, err
-	}
+		if err := tx.QueryRowContext(ctx, query, args...).Scan(
+			&label.Id,
+			&label.TaskId,
+			&label.Value,
+			&label.CreatedAt,
+			&label.DeletedAt,
+		); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrTaskNotFound
+			}
+			return err
+		}
 
-	var label model.Label
-	if err := row.Scan(
-		&label.Id,
-		&label.TaskId,
-		&label.Value,
-		&label.CreatedAt,
-		&label.DeletedAt,
-	); err != nil {
+		return insertEvent(ctx, tx, model.NewLabelEvent(model.EventLabelCreated, &label))
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -120,6 +101,13 @@ func (cr *labelRepository) CreateLabel(ctx context.Context, newLabel model.Label
 }
 
 func (cr *labelRepository) GetLabelsByTaskId(ctx context.Context, taskId uuid.UUID) ([]*model.Label, error) {
+	ctx = storage.WithMethod(ctx, "labelRepository.GetLabelsByTaskId")
+
+	scope, err := getScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	query, args, err := psql.
 		Select(`
 			id,
@@ -133,6 +121,7 @@ func (cr *labelRepository) GetLabelsByTaskId(ctx context.Context, taskId uuid.UU
 			"deleted_at": nil,
 			"task_id":    taskId,
 		}).
+		Where(scope.taskIds()).
 		ToSql()
 	if err != nil {
 		return nil, err
@@ -167,6 +156,13 @@ func (cr *labelRepository) GetLabelsByTaskId(ctx context.Context, taskId uuid.UU
 }
 
 func (cr *labelRepository) DeleteLabelByTaskIdAndLabelId(ctx context.Context, taskId uuid.UUID, labelId uuid.UUID) error {
+	ctx = storage.WithMethod(ctx, "labelRepository.DeleteLabelByTaskIdAndLabelId")
+
+	scope, err := getScope(ctx)
+	if err != nil {
+		return err
+	}
+
 	query, args, err := psql.
 		Update("labels").
 		Set("deleted_at", time.Now()).
@@ -174,24 +170,28 @@ func (cr *labelRepository) DeleteLabelByTaskIdAndLabelId(ctx context.Context, ta
 			"deleted_at": nil,
 			"task_id":    taskId,
 			"id":         labelId,
-		}).ToSql()
+		}).
+		Where(scope.taskIds()).
+		ToSql()
 	if err != nil {
 		return err
 	}
 
-	result, err := cr.db.ExecContext(ctx, query, args...)
-	if err != nil {
-		return err
-	}
+	return withTx(ctx, cr.db, func(tx storage.Tx) error {
+		result, err := tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			return err
+		}
 
-	affected, err := result.RowsAffected()
-	if err != nil {
-		return err
-	}
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
 
-	if affected == 0 {
-		return ErrLabelNotFound
-	}
+		if affected == 0 {
+			return ErrLabelNotFound
+		}
 
-	return nil
+		return insertEvent(ctx, tx, model.NewEvent(model.EventLabelDeleted, taskId, model.DeletedData{Id: labelId}))
+	})
 }
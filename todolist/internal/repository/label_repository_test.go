@@ -1,7 +1,6 @@
 package repository
 
 import (
-	"context"
 	"database/sql"
 	"errors"
 	"regexp"
@@ -48,14 +47,16 @@ func TestCreateLabel(t *testing.T) {
 						"deleted_at": nil,
 						"task_id":    newLabel.TaskId,
 						"value":      newLabel.Value,
-					}).ToSql()
+					}).
+					Where(callerScope.taskIds()).
+					ToSql()
 				if err != nil {
 					t.Fatalf("an error '%s' was not expected when creating a new query", err)
 				}
 
 				mock.ExpectBegin()
 
-				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(args[0], args[1]).WillReturnRows(sqlmock.NewRows(
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(driverArgs(args)...).WillReturnRows(sqlmock.NewRows(
 					[]string{
 						"COUNT",
 					},
@@ -63,9 +64,11 @@ func TestCreateLabel(t *testing.T) {
 					1,
 				))
 
+				mock.ExpectRollback()
+
 				svc := NewLabelRepository(db)
 
-				return svc.CreateLabel(context.Background(), newLabel)
+				return svc.CreateLabel(callerContext(), newLabel)
 			},
 			expect: ErrLabelAlreadyExists,
 		},
@@ -92,14 +95,16 @@ func TestCreateLabel(t *testing.T) {
 						"deleted_at": nil,
 						"task_id":    newLabel.TaskId,
 						"value":      newLabel.Value,
-					}).ToSql()
+					}).
+					Where(callerScope.taskIds()).
+					ToSql()
 				if err != nil {
 					t.Fatalf("an error '%s' was not expected when creating a new query", err)
 				}
 
 				mock.ExpectBegin()
 
-				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(args[0], args[1]).WillReturnRows(sqlmock.NewRows(
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(driverArgs(args)...).WillReturnRows(sqlmock.NewRows(
 					[]string{
 						"COUNT",
 					},
@@ -110,14 +115,14 @@ func TestCreateLabel(t *testing.T) {
 				query, args, err = psql.
 					Insert("labels").
 					Columns("task_id", "value").
-					Values(newLabel.TaskId, newLabel.Value).
+					Select(callerScope.task(newLabel.TaskId, newLabel.Value)).
 					Suffix("RETURNING \"id\", \"task_id\", \"value\", \"created_at\", \"deleted_at\"").
 					ToSql()
 				if err != nil {
 					t.Fatalf("an error '%s' was not expected when creating a new query", err)
 				}
 
-				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(args[0], args[1]).WillReturnRows(sqlmock.NewRows(
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(driverArgs(args)...).WillReturnRows(sqlmock.NewRows(
 					[]string{
 						"id",
 						"task_id",
@@ -133,11 +138,13 @@ func TestCreateLabel(t *testing.T) {
 					newLabel.DeletedAt,
 				))
 
+				expectInsertEvent(mock, model.EventLabelCreated, newLabel.TaskId)
+
 				mock.ExpectCommit()
 
 				svc := NewLabelRepository(db)
 
-				return svc.CreateLabel(context.Background(), newLabel)
+				return svc.CreateLabel(callerContext(), newLabel)
 			},
 			expect: nil,
 		},
@@ -162,7 +169,7 @@ func TestCreateLabel(t *testing.T) {
 
 				svc := NewLabelRepository(db)
 
-				return svc.CreateLabel(context.Background(), newLabel)
+				return svc.CreateLabel(callerContext(), newLabel)
 			},
 			expect: errCannotCreateTransaction,
 		},
@@ -214,12 +221,13 @@ func TestGetLabelsByTaskId(t *testing.T) {
 						"deleted_at": nil,
 						"task_id":    newLabel.TaskId,
 					}).
+					Where(callerScope.taskIds()).
 					ToSql()
 				if err != nil {
 					t.Fatalf("an error '%s' was not expected when creating a new query", err)
 				}
 
-				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(args[0]).WillReturnRows(sqlmock.NewRows(
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(driverArgs(args)...).WillReturnRows(sqlmock.NewRows(
 					[]string{
 						"id",
 						"task_id",
@@ -239,7 +247,7 @@ func TestGetLabelsByTaskId(t *testing.T) {
 
 				svc := NewLabelRepository(db)
 
-				return svc.GetLabelsByTaskId(context.Background(), newLabel.TaskId)
+				return svc.GetLabelsByTaskId(callerContext(), newLabel.TaskId)
 			},
 			expect: nil,
 		},
@@ -273,12 +281,13 @@ func TestGetLabelsByTaskId(t *testing.T) {
 						"deleted_at": nil,
 						"task_id":    newLabel.TaskId,
 					}).
+					Where(callerScope.taskIds()).
 					ToSql()
 				if err != nil {
 					t.Fatalf("an error '%s' was not expected when creating a new query", err)
 				}
 
-				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(args[0]).WillReturnRows(sqlmock.NewRows(
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(driverArgs(args)...).WillReturnRows(sqlmock.NewRows(
 					[]string{
 						"id",
 						"task_id",
@@ -296,7 +305,7 @@ func TestGetLabelsByTaskId(t *testing.T) {
 
 				svc := NewLabelRepository(db)
 
-				return svc.GetLabelsByTaskId(context.Background(), newLabel.TaskId)
+				return svc.GetLabelsByTaskId(callerContext(), newLabel.TaskId)
 			},
 			expect: nil,
 		},
@@ -330,15 +339,16 @@ func TestGetLabelsByTaskId(t *testing.T) {
 						"deleted_at": nil,
 						"task_id":    newLabel.TaskId,
 					}).
+					Where(callerScope.taskIds()).
 					ToSql()
 				if err != nil {
 					t.Fatalf("an error '%s' was not expected when creating a new query", err)
 				}
 
-				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(args[0]).WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(driverArgs(args)...).WillReturnError(sql.ErrNoRows)
 
 				svc := NewLabelRepository(db)
-				return svc.GetLabelsByTaskId(context.Background(), newLabel.TaskId)
+				return svc.GetLabelsByTaskId(callerContext(), newLabel.TaskId)
 			},
 			expect: sql.ErrNoRows,
 		},
@@ -389,15 +399,19 @@ func TestDeleteLabelByTaskIdAndLabelId(t *testing.T) {
 						"deleted_at": nil,
 						"task_id":    newLabel.TaskId,
 						"id":         newLabel.Id,
-					}).ToSql()
+					}).
+					Where(callerScope.taskIds()).
+					ToSql()
 				if err != nil {
 					t.Fatalf("an error '%s' was not expected when creating a new query", err)
 				}
 
-				mock.ExpectExec(regexp.QuoteMeta(query)).WithArgs(args[0], args[1], args[2]).WillReturnError(sql.ErrConnDone)
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(query)).WithArgs(driverArgs(args)...).WillReturnError(sql.ErrConnDone)
+				mock.ExpectRollback()
 
 				svc := NewLabelRepository(db)
-				return svc.DeleteLabelByTaskIdAndLabelId(context.Background(), newLabel.TaskId, newLabel.Id)
+				return svc.DeleteLabelByTaskIdAndLabelId(callerContext(), newLabel.TaskId, newLabel.Id)
 			},
 			expect: sql.ErrConnDone,
 		},
@@ -430,15 +444,20 @@ func TestDeleteLabelByTaskIdAndLabelId(t *testing.T) {
 						"deleted_at": nil,
 						"task_id":    newLabel.TaskId,
 						"id":         newLabel.Id,
-					}).ToSql()
+					}).
+					Where(callerScope.taskIds()).
+					ToSql()
 				if err != nil {
 					t.Fatalf("an error '%s' was not expected when creating a new query", err)
 				}
 
-				mock.ExpectExec(regexp.QuoteMeta(query)).WithArgs(args[0], args[1], args[2]).WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(query)).WithArgs(driverArgs(args)...).WillReturnResult(sqlmock.NewResult(1, 1))
+				expectInsertEvent(mock, model.EventLabelDeleted, newLabel.TaskId)
+				mock.ExpectCommit()
 
 				svc := NewLabelRepository(db)
-				return svc.DeleteLabelByTaskIdAndLabelId(context.Background(), newLabel.TaskId, newLabel.Id)
+				return svc.DeleteLabelByTaskIdAndLabelId(callerContext(), newLabel.TaskId, newLabel.Id)
 			},
 			expect: nil,
 		},
@@ -471,17 +490,21 @@ func TestDeleteLabelByTaskIdAndLabelId(t *testing.T) {
 						"deleted_at": nil,
 						"task_id":    newLabel.TaskId,
 						"id":         newLabel.Id,
-					}).ToSql()
+					}).
+					Where(callerScope.taskIds()).
+					ToSql()
 				if err != nil {
 					t.Fatalf("an error '%s' was not expected when creating a new query", err)
 				}
 
 				row := sqlmock.NewResult(0, 0)
 
-				mock.ExpectExec(regexp.QuoteMeta(query)).WithArgs(args[0], args[1], args[2]).WillReturnResult(row)
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(query)).WithArgs(driverArgs(args)...).WillReturnResult(row)
+				mock.ExpectRollback()
 
 				svc := NewLabelRepository(db)
-				return svc.DeleteLabelByTaskIdAndLabelId(context.Background(), newLabel.TaskId, newLabel.Id)
+				return svc.DeleteLabelByTaskIdAndLabelId(callerContext(), newLabel.TaskId, newLabel.Id)
 			},
 			expect: ErrLabelNotFound,
 		},
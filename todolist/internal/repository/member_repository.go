@@ -0,0 +1,299 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+	storage "github.com/overridesh/sgg-todolist-service/pkg/storage/sql"
+)
+
+var (
+	ErrMemberNotFound = errors.New("member not found")
+)
+
+// taskMembershipsPrefix walk up the hierarchy from a task of the tenant to its root, the members of a task are members
+// of its subtasks too. Unlike taskAncestorsPrefix it is not scoped by the owner, the caller is not the owner of a
+// shared task.
+const taskMembershipsPrefix string = `
+	WITH RECURSIVE ancestors AS (
+		SELECT id, parent_id, ARRAY[id] AS path
+		FROM tasks
+		WHERE id = ? AND deleted_at IS NULL AND tenant_id = ?
+		UNION ALL
+		SELECT tasks.id, tasks.parent_id, ancestors.path || tasks.id
+		FROM tasks
+		JOIN ancestors ON tasks.id = ancestors.parent_id
+		WHERE tasks.deleted_at IS NULL AND NOT tasks.id = ANY(ancestors.path)
+	)`
+
+// deletedTaskMembershipsPrefix walk up the hierarchy from a deleted task of the tenant to its root, like
+// taskMembershipsPrefix, its ancestors can be deleted with it
+const deletedTaskMembershipsPrefix string = `
+	WITH RECURSIVE ancestors AS (
+		SELECT id, parent_id, ARRAY[id] AS path
+		FROM tasks
+		WHERE id = ? AND deleted_at IS NOT NULL AND tenant_id = ?
+		UNION ALL
+		SELECT tasks.id, tasks.parent_id, ancestors.path || tasks.id
+		FROM tasks
+		JOIN ancestors ON tasks.id = ancestors.parent_id
+		WHERE NOT tasks.id = ANY(ancestors.path)
+	)`
+
+type MemberRepository interface {
+	GetTaskAccess(ctx context.Context, taskId uuid.UUID) (*model.TaskAccess, error)
+	GetDeletedTaskAccess(ctx context.Context, taskId uuid.UUID) (*model.TaskAccess, error)
+	ShareTask(context.Context, model.TaskMember) (*model.TaskMember, error)
+	UnshareTask(ctx context.Context, taskId uuid.UUID, memberId string) error
+	GetTaskMembers(ctx context.Context, taskId uuid.UUID) ([]*model.TaskMember, error)
+}
+
+type memberRepository struct {
+	db storage.DB
+}
+
+func NewMemberRepository(db storage.DB) MemberRepository {
+	return &memberRepository{
+		db: db,
+	}
+}
+
+// GetTaskAccess get the owner of a task of the tenant and the role of the caller on it: owner for its own tasks, the
+// highest role it was shared with on the task or its ancestors otherwise. ErrTaskNotFound when the task was not
+// shared with the caller.
+func (mr *memberRepository) GetTaskAccess(ctx context.Context, taskId uuid.UUID) (*model.TaskAccess, error) {
+	ctx = storage.WithMethod(ctx, "memberRepository.GetTaskAccess")
+	return mr.getTaskAccess(ctx, taskId, sq.Eq{"deleted_at": nil}, taskMembershipsPrefix)
+}
+
+// GetDeletedTaskAccess get the owner of a deleted task of the tenant and the role of the caller on it, like
+// GetTaskAccess. ErrTaskNotFound when the task is not in the trash or was not shared with the caller.
+func (mr *memberRepository) GetDeletedTaskAccess(ctx context.Context, taskId uuid.UUID) (*model.TaskAccess, error) {
+	ctx = storage.WithMethod(ctx, "memberRepository.GetDeletedTaskAccess")
+	return mr.getTaskAccess(ctx, taskId, sq.NotEq{"deleted_at": nil}, deletedTaskMembershipsPrefix)
+}
+
+// getTaskAccess get the access of the caller to the task that passes deleted, membershipsPrefix walks up its
+// hierarchy
+func (mr *memberRepository) getTaskAccess(ctx context.Context, taskId uuid.UUID, deleted sq.Sqlizer, membershipsPrefix string) (*model.TaskAccess, error) {
+	scope, err := getScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query, args, err := psql.
+		Select("owner_id").
+		From("tasks").
+		Where(sq.Eq{
+			"id":        taskId,
+			"tenant_id": scope.tenantId,
+		}).
+		Where(deleted).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var access model.TaskAccess
+
+	if err := mr.db.QueryRowContext(ctx, query, args...).Scan(&access.OwnerId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTaskNotFound
+		}
+		return nil, err
+	}
+
+	if access.OwnerId == scope.ownerId {
+		access.Role = model.RoleOwner
+		return &access, nil
+	}
+
+	query, args, err = psql.
+		Select("role").
+		Prefix(membershipsPrefix, taskId, scope.tenantId).
+		From("task_members").
+		Where(sq.Eq{"member_id": scope.ownerId}).
+		Where("task_id IN (SELECT id FROM ancestors)").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := mr.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var role string
+
+		if err := rows.Scan(&role); err != nil {
+			return nil, err
+		}
+
+		if model.RoleLevels[role] > model.RoleLevels[access.Role] {
+			access.Role = role
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(access.Role) == 0 {
+		return nil, ErrTaskNotFound
+	}
+
+	return &access, nil
+}
+
+// ShareTask shares a task of the scope with a member, or changes the role of a member it was already shared with
+func (mr *memberRepository) ShareTask(ctx context.Context, newMember model.TaskMember) (*model.TaskMember, error) {
+	ctx = storage.WithMethod(ctx, "memberRepository.ShareTask")
+
+	scope, err := getScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query, args, err := psql.
+		Insert("task_members").
+		Columns("task_id", "member_id", "role").
+		Select(scope.task(newMember.TaskId, newMember.MemberId, newMember.Role)).
+		Suffix("ON CONFLICT (task_id, member_id) DO UPDATE SET role = EXCLUDED.role, updated_at = NOW()").
+		Suffix("RETURNING \"task_id\", \"member_id\", \"role\", \"created_at\", \"updated_at\"").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var member model.TaskMember
+
+	if err := mr.db.QueryRowContext(ctx, query, args...).Scan(
+		&member.TaskId,
+		&member.MemberId,
+		&member.Role,
+		&member.CreatedAt,
+		&member.UpdatedAt,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTaskNotFound
+		}
+		return nil, err
+	}
+
+	return &member, nil
+}
+
+// UnshareTask stops sharing a task of the scope with a member
+func (mr *memberRepository) UnshareTask(ctx context.Context, taskId uuid.UUID, memberId string) error {
+	ctx = storage.WithMethod(ctx, "memberRepository.UnshareTask")
+
+	scope, err := getScope(ctx)
+	if err != nil {
+		return err
+	}
+
+	query, args, err := psql.
+		Delete("task_members").
+		Where(sq.Eq{
+			"member_id": memberId,
+			"task_id":   taskId,
+		}).
+		Where(scope.taskIds()).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	result, err := mr.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return ErrMemberNotFound
+	}
+
+	return nil
+}
+
+// GetTaskMembers get the members a task of the scope was shared with, on the task or on its ancestors, with the
+// highest role of each member. The task id of a member is the task it got that role on.
+func (mr *memberRepository) GetTaskMembers(ctx context.Context, taskId uuid.UUID) ([]*model.TaskMember, error) {
+	ctx = storage.WithMethod(ctx, "memberRepository.GetTaskMembers")
+
+	scope, err := getScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query, args, err := psql.
+		Select(`
+			task_id,
+			member_id,
+			role,
+			created_at,
+			updated_at
+		`).
+		Prefix(taskMembershipsPrefix, taskId, scope.tenantId).
+		From("task_members").
+		Where("task_id IN (SELECT id FROM ancestors)").
+		Where(scope.taskIds()).
+		OrderBy("created_at", "member_id").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := mr.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var members []*model.TaskMember = []*model.TaskMember{}
+
+	// A member shared on several tasks of the hierarchy keeps its first place and gets its highest role
+	positions := map[string]int{}
+
+	for rows.Next() {
+		var member model.TaskMember
+
+		if err := rows.Scan(
+			&member.TaskId,
+			&member.MemberId,
+			&member.Role,
+			&member.CreatedAt,
+			&member.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		position, found := positions[member.MemberId]
+		if !found {
+			positions[member.MemberId] = len(members)
+			members = append(members, &member)
+			continue
+		}
+
+		if model.RoleLevels[member.Role] > model.RoleLevels[members[position].Role] {
+			members[position] = &member
+		}
+	}
+
+	return members, rows.Err()
+}
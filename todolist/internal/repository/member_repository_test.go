@@ -0,0 +1,300 @@
+package repository
+
+import (
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+	uuid "github.com/satori/go.uuid"
+)
+
+func TestGetTaskAccess(t *testing.T) {
+	taskId := uuid.NewV4()
+
+	ownerQuery, ownerArgs, err := psql.
+		Select("owner_id").
+		From("tasks").
+		Where(sq.Eq{
+			"id":        taskId,
+			"tenant_id": caller.TenantId,
+		}).
+		Where(sq.Eq{"deleted_at": nil}).
+		ToSql()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when creating a new query", err)
+	}
+
+	rolesQuery, rolesArgs, err := psql.
+		Select("role").
+		Prefix(taskMembershipsPrefix, taskId, caller.TenantId).
+		From("task_members").
+		Where(sq.Eq{"member_id": caller.Subject}).
+		Where("task_id IN (SELECT id FROM ancestors)").
+		ToSql()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when creating a new query", err)
+	}
+
+	tests := []struct {
+		name   string
+		owner  string
+		roles  []string
+		expect string
+		err    error
+	}{
+		{
+			name:   "GetTaskAccess_Owner",
+			owner:  caller.Subject,
+			expect: model.RoleOwner,
+		},
+		{
+			name:   "GetTaskAccess_HighestRole",
+			owner:  "user-2",
+			roles:  []string{model.RoleViewer, model.RoleEditor, model.RoleCommenter},
+			expect: model.RoleEditor,
+		},
+		{
+			name:  "GetTaskAccess_NotShared",
+			owner: "user-2",
+			err:   ErrTaskNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer db.Close()
+
+			mock.ExpectQuery(regexp.QuoteMeta(ownerQuery)).WithArgs(driverArgs(ownerArgs)...).WillReturnRows(
+				sqlmock.NewRows([]string{"owner_id"}).AddRow(tt.owner),
+			)
+
+			if tt.owner != caller.Subject {
+				rows := sqlmock.NewRows([]string{"role"})
+				for _, role := range tt.roles {
+					rows.AddRow(role)
+				}
+				mock.ExpectQuery(regexp.QuoteMeta(rolesQuery)).WithArgs(driverArgs(rolesArgs)...).WillReturnRows(rows)
+			}
+
+			access, err := NewMemberRepository(db).GetTaskAccess(callerContext(), taskId)
+			if err != tt.err {
+				t.Fatalf("expect %v, but got %v", tt.err, err)
+			}
+
+			if err == nil && (access.Role != tt.expect || access.OwnerId != tt.owner) {
+				t.Errorf("expect the role %s of %s, but got %v", tt.expect, tt.owner, access)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+
+	t.Run("GetTaskAccess_TaskNotFound", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		}
+		defer db.Close()
+
+		mock.ExpectQuery(regexp.QuoteMeta(ownerQuery)).WithArgs(driverArgs(ownerArgs)...).WillReturnError(sql.ErrNoRows)
+
+		if _, err := NewMemberRepository(db).GetTaskAccess(callerContext(), taskId); err != ErrTaskNotFound {
+			t.Errorf("expect %v, but got %v", ErrTaskNotFound, err)
+		}
+	})
+}
+
+func TestGetDeletedTaskAccess(t *testing.T) {
+	taskId := uuid.NewV4()
+
+	ownerQuery, ownerArgs, err := psql.
+		Select("owner_id").
+		From("tasks").
+		Where(sq.Eq{
+			"id":        taskId,
+			"tenant_id": caller.TenantId,
+		}).
+		Where(sq.NotEq{"deleted_at": nil}).
+		ToSql()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when creating a new query", err)
+	}
+
+	rolesQuery, rolesArgs, err := psql.
+		Select("role").
+		Prefix(deletedTaskMembershipsPrefix, taskId, caller.TenantId).
+		From("task_members").
+		Where(sq.Eq{"member_id": caller.Subject}).
+		Where("task_id IN (SELECT id FROM ancestors)").
+		ToSql()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when creating a new query", err)
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(ownerQuery)).WithArgs(driverArgs(ownerArgs)...).WillReturnRows(
+		sqlmock.NewRows([]string{"owner_id"}).AddRow("user-2"),
+	)
+	mock.ExpectQuery(regexp.QuoteMeta(rolesQuery)).WithArgs(driverArgs(rolesArgs)...).WillReturnRows(
+		sqlmock.NewRows([]string{"role"}).AddRow(model.RoleEditor),
+	)
+
+	access, err := NewMemberRepository(db).GetDeletedTaskAccess(callerContext(), taskId)
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when getting the access", err)
+	}
+
+	if access.Role != model.RoleEditor || access.OwnerId != "user-2" {
+		t.Errorf("expect the role %s of user-2, but got %v", model.RoleEditor, access)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestShareTask(t *testing.T) {
+	newMember := model.TaskMember{
+		TaskId:   uuid.NewV4(),
+		MemberId: "user-2",
+		Role:     model.RoleCommenter,
+	}
+
+	query, args, err := psql.
+		Insert("task_members").
+		Columns("task_id", "member_id", "role").
+		Select(callerScope.task(newMember.TaskId, newMember.MemberId, newMember.Role)).
+		Suffix("ON CONFLICT (task_id, member_id) DO UPDATE SET role = EXCLUDED.role, updated_at = NOW()").
+		Suffix("RETURNING \"task_id\", \"member_id\", \"role\", \"created_at\", \"updated_at\"").
+		ToSql()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when creating a new query", err)
+	}
+
+	t.Run("ShareTask_Success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		}
+		defer db.Close()
+
+		now := time.Now()
+
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(driverArgs(args)...).WillReturnRows(sqlmock.NewRows(
+			[]string{"task_id", "member_id", "role", "created_at", "updated_at"},
+		).AddRow(newMember.TaskId, newMember.MemberId, newMember.Role, now, now))
+
+		member, err := NewMemberRepository(db).ShareTask(callerContext(), newMember)
+		if err != nil {
+			t.Fatalf("expect no error, but got %v", err)
+		}
+
+		if member.MemberId != newMember.MemberId || member.Role != newMember.Role {
+			t.Errorf("expect %v, but got %v", newMember, member)
+		}
+	})
+
+	t.Run("ShareTask_ErrTaskNotFound", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		}
+		defer db.Close()
+
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(driverArgs(args)...).WillReturnError(sql.ErrNoRows)
+
+		if _, err := NewMemberRepository(db).ShareTask(callerContext(), newMember); err != ErrTaskNotFound {
+			t.Errorf("expect %v, but got %v", ErrTaskNotFound, err)
+		}
+	})
+}
+
+func TestUnshareTask(t *testing.T) {
+	t.Run("UnshareTask_ErrMemberNotFound", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		}
+		defer db.Close()
+
+		taskId := uuid.NewV4()
+
+		query, args, err := psql.
+			Delete("task_members").
+			Where(sq.Eq{
+				"member_id": "user-2",
+				"task_id":   taskId,
+			}).
+			Where(callerScope.taskIds()).
+			ToSql()
+		if err != nil {
+			t.Fatalf("an error '%s' was not expected when creating a new query", err)
+		}
+
+		mock.ExpectExec(regexp.QuoteMeta(query)).WithArgs(driverArgs(args)...).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		if err := NewMemberRepository(db).UnshareTask(callerContext(), taskId, "user-2"); err != ErrMemberNotFound {
+			t.Errorf("expect %v, but got %v", ErrMemberNotFound, err)
+		}
+	})
+}
+
+func TestGetTaskMembers(t *testing.T) {
+	t.Run("GetTaskMembers_InheritedRoles", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		}
+		defer db.Close()
+
+		taskId, parentId := uuid.NewV4(), uuid.NewV4()
+		createdAt := time.Now()
+
+		mock.ExpectQuery(regexp.QuoteMeta("WITH RECURSIVE ancestors AS")).
+			WithArgs(taskId, caller.TenantId, caller.Subject, caller.TenantId).
+			WillReturnRows(sqlmock.NewRows([]string{"task_id", "member_id", "role", "created_at", "updated_at"}).
+				AddRow(parentId, "user-2", model.RoleEditor, createdAt, createdAt).
+				AddRow(parentId, "user-3", model.RoleViewer, createdAt, createdAt).
+				AddRow(taskId, "user-2", model.RoleViewer, createdAt.Add(time.Minute), createdAt.Add(time.Minute)).
+				AddRow(taskId, "user-3", model.RoleCommenter, createdAt.Add(time.Minute), createdAt.Add(time.Minute)))
+
+		members, err := NewMemberRepository(db).GetTaskMembers(callerContext(), taskId)
+		if err != nil {
+			t.Fatalf("an error '%s' was not expected when getting the members", err)
+		}
+
+		expect := []model.TaskMember{
+			{TaskId: parentId, MemberId: "user-2", Role: model.RoleEditor},
+			{TaskId: taskId, MemberId: "user-3", Role: model.RoleCommenter},
+		}
+
+		if len(members) != len(expect) {
+			t.Fatalf("expect %d members, but got %d", len(expect), len(members))
+		}
+
+		for i, member := range members {
+			if member.TaskId != expect[i].TaskId || member.MemberId != expect[i].MemberId || member.Role != expect[i].Role {
+				t.Errorf("expect member %d to be %s as %s on %s, but got %s as %s on %s", i, expect[i].MemberId, expect[i].Role, expect[i].TaskId, member.MemberId, member.Role, member.TaskId)
+			}
+		}
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+}
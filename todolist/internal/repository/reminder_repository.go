@@ -0,0 +1,321 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+	storage "github.com/overridesh/sgg-todolist-service/pkg/storage/sql"
+)
+
+var (
+	ErrReminderNotFound = errors.New("reminder not found")
+)
+
+// reminderClaimLease is how long the reminders claimed by a replica are skipped by the others. The claim of a reminder
+// is renewed right before it is notified, so the lease only has to outlive the notification of one reminder and not
+// the one of a whole batch.
+const reminderClaimLease time.Duration = 5 * time.Minute
+
+// NotifyFunc sends a due reminder, the task is the one the reminder belongs to
+type NotifyFunc func(ctx context.Context, reminder *model.Reminder, task *model.Task) error
+
+type ReminderRepository interface {
+	CreateReminder(context.Context, model.Reminder) (*model.Reminder, error)
+	GetRemindersByTaskId(context.Context, uuid.UUID) ([]*model.Reminder, error)
+	DeleteReminder(ctx context.Context, taskId uuid.UUID, reminderId uuid.UUID) error
+	ProcessDueReminders(ctx context.Context, now time.Time, limit uint64, notify NotifyFunc) (int, error)
+}
+
+type reminderRepository struct {
+	db storage.DB
+}
+
+func NewReminderRepository(db storage.DB) ReminderRepository {
+	return &reminderRepository{
+		db: db,
+	}
+}
+
+func (rr *reminderRepository) CreateReminder(ctx context.Context, newReminder model.Reminder) (*model.Reminder, error) {
+	ctx = storage.WithMethod(ctx, "reminderRepository.CreateReminder")
+
+	query, args, err := psql.
+		Insert("reminders").
+		Columns("task_id", "remind_at").
+		Values(newReminder.TaskId, newReminder.RemindAt).
+		Suffix("RETURNING \"id\", \"task_id\", \"remind_at\", \"sent_at\", \"attempts\", \"last_error\", \"created_at\", \"deleted_at\"").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var reminder model.Reminder
+
+	if err := rr.db.QueryRowContext(ctx, query, args...).Scan(
+		&reminder.Id,
+		&reminder.TaskId,
+		&reminder.RemindAt,
+		&reminder.SentAt,
+		&reminder.Attempts,
+		&reminder.LastError,
+		&reminder.CreatedAt,
+		&reminder.DeletedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return &reminder, nil
+}
+
+func (rr *reminderRepository) GetRemindersByTaskId(ctx context.Context, taskId uuid.UUID) ([]*model.Reminder, error) {
+	ctx = storage.WithMethod(ctx, "reminderRepository.GetRemindersByTaskId")
+
+	query, args, err := psql.
+		Select(`
+			id,
+			task_id,
+			remind_at,
+			sent_at,
+			attempts,
+			last_error,
+			created_at,
+			deleted_at
+		`).
+		From("reminders").
+		Where(sq.Eq{
+			"deleted_at": nil,
+			"task_id":    taskId,
+		}).
+		OrderBy("remind_at", "id").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := rr.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var reminders []*model.Reminder = []*model.Reminder{}
+
+	for rows.Next() {
+		var reminder model.Reminder
+
+		if err := rows.Scan(
+			&reminder.Id,
+			&reminder.TaskId,
+			&reminder.RemindAt,
+			&reminder.SentAt,
+			&reminder.Attempts,
+			&reminder.LastError,
+			&reminder.CreatedAt,
+			&reminder.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		reminders = append(reminders, &reminder)
+	}
+
+	return reminders, rows.Err()
+}
+
+func (rr *reminderRepository) DeleteReminder(ctx context.Context, taskId uuid.UUID, reminderId uuid.UUID) error {
+	ctx = storage.WithMethod(ctx, "reminderRepository.DeleteReminder")
+
+	query, args, err := psql.
+		Update("reminders").
+		Set("deleted_at", time.Now()).
+		Where(sq.Eq{
+			"deleted_at": nil,
+			"task_id":    taskId,
+			"id":         reminderId,
+		}).ToSql()
+	if err != nil {
+		return err
+	}
+
+	result, err := rr.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return ErrReminderNotFound
+	}
+
+	return nil
+}
+
+// ProcessDueReminders notify up to limit reminders due at now and mark them as sent, returning how many were sent.
+// A failed notification is retried on the next call until model.ReminderMaxAttempts. The reminders are claimed in a
+// short transaction and notified outside of it, so a slow notification does not hold any lock. The claimed reminders
+// are skipped by the other replicas until reminderClaimLease, after which the reminders of a replica that stopped
+// before recording them are notified again. A reminder whose claim was taken over by another replica is left to it.
+func (rr *reminderRepository) ProcessDueReminders(ctx context.Context, now time.Time, limit uint64, notify NotifyFunc) (int, error) {
+	ctx = storage.WithMethod(ctx, "reminderRepository.ProcessDueReminders")
+
+	// postgres keeps microseconds, the claim is told apart by its exact time
+	now = now.Truncate(time.Microsecond)
+	started := time.Now()
+
+	due, err := rr.claimDueReminders(ctx, now, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	var sent int
+
+	for _, item := range due {
+		renewed, err := renewClaim(ctx, rr.db, "reminders", "id", item.reminder.Id, now, now.Add(time.Since(started)).Truncate(time.Microsecond))
+		if err != nil {
+			return sent, err
+		}
+
+		if !renewed {
+			continue
+		}
+
+		builder := psql.
+			Update("reminders").
+			Set("attempts", sq.Expr("attempts + 1")).
+			Set("claimed_at", nil).
+			Where(sq.Eq{"id": item.reminder.Id})
+
+		if err := notify(ctx, &item.reminder, &item.task); err != nil {
+			builder = builder.Set("last_error", err.Error())
+		} else {
+			builder = builder.Set("sent_at", now).Set("last_error", nil)
+			sent++
+		}
+
+		query, args, err := builder.ToSql()
+		if err != nil {
+			return sent, err
+		}
+
+		if _, err := rr.db.ExecContext(ctx, query, args...); err != nil {
+			return sent, err
+		}
+	}
+
+	return sent, nil
+}
+
+// dueReminder is a claimed reminder with the task it belongs to
+type dueReminder struct {
+	reminder model.Reminder
+	task     model.Task
+}
+
+// claimDueReminders locks up to limit reminders due at now that are not claimed by another replica, and claims them
+func (rr *reminderRepository) claimDueReminders(ctx context.Context, now time.Time, limit uint64) ([]*dueReminder, error) {
+	var due []*dueReminder
+
+	err := withTx(ctx, rr.db, func(tx storage.Tx) error {
+		query, args, err := psql.
+			Select(`
+				reminders.id,
+				reminders.task_id,
+				reminders.remind_at,
+				reminders.sent_at,
+				reminders.attempts,
+				reminders.last_error,
+				reminders.created_at,
+				reminders.deleted_at,
+				tasks.value,
+				tasks.due_date
+			`).
+			From("reminders").
+			Join("tasks ON tasks.id = reminders.task_id").
+			Where(sq.Eq{
+				"reminders.sent_at":    nil,
+				"reminders.deleted_at": nil,
+				"tasks.deleted_at":     nil,
+				"tasks.completed":      false,
+			}).
+			Where(sq.LtOrEq{"reminders.remind_at": now}).
+			Where(sq.Lt{"reminders.attempts": model.ReminderMaxAttempts}).
+			Where(sq.Or{
+				sq.Eq{"reminders.claimed_at": nil},
+				sq.Lt{"reminders.claimed_at": now.Add(-reminderClaimLease)},
+			}).
+			OrderBy("reminders.remind_at").
+			Limit(limit).
+			Suffix("FOR UPDATE OF reminders SKIP LOCKED").
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		rows, err := tx.QueryContext(ctx, query, args...)
+		if err != nil {
+			return err
+		}
+
+		var ids []uuid.UUID
+
+		for rows.Next() {
+			var item dueReminder
+
+			if err := rows.Scan(
+				&item.reminder.Id,
+				&item.reminder.TaskId,
+				&item.reminder.RemindAt,
+				&item.reminder.SentAt,
+				&item.reminder.Attempts,
+				&item.reminder.LastError,
+				&item.reminder.CreatedAt,
+				&item.reminder.DeletedAt,
+				&item.task.Value,
+				&item.task.DueDate,
+			); err != nil {
+				rows.Close()
+				return err
+			}
+
+			item.task.Id = item.reminder.TaskId
+			due = append(due, &item)
+			ids = append(ids, item.reminder.Id)
+		}
+		rows.Close()
+
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		if len(ids) == 0 {
+			return nil
+		}
+
+		query, args, err = psql.
+			Update("reminders").
+			Set("claimed_at", now).
+			Where(sq.Eq{"id": ids}).
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, query, args...)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return due, nil
+}
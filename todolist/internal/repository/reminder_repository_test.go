@@ -0,0 +1,239 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+)
+
+var reminderColumns []string = []string{
+	"id",
+	"task_id",
+	"remind_at",
+	"sent_at",
+	"attempts",
+	"last_error",
+	"created_at",
+	"deleted_at",
+}
+
+func TestCreateReminder(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  func() (*model.Reminder, error)
+		expect error
+	}{
+		{
+			name: "CreateReminder_Success",
+			input: func() (*model.Reminder, error) {
+				db, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+				}
+				defer db.Close()
+
+				reminder := model.Reminder{
+					Id:        uuid.NewV4(),
+					TaskId:    uuid.NewV4(),
+					RemindAt:  time.Now().Add(time.Hour),
+					CreatedAt: time.Now(),
+				}
+
+				mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO reminders (task_id,remind_at) VALUES ($1,$2) RETURNING")).
+					WithArgs(reminder.TaskId, reminder.RemindAt).
+					WillReturnRows(sqlmock.NewRows(reminderColumns).AddRow(
+						reminder.Id,
+						reminder.TaskId,
+						reminder.RemindAt,
+						reminder.SentAt,
+						reminder.Attempts,
+						reminder.LastError,
+						reminder.CreatedAt,
+						reminder.DeletedAt,
+					))
+
+				svc := NewReminderRepository(db)
+				return svc.CreateReminder(context.Background(), reminder)
+			},
+			expect: nil,
+		},
+		{
+			name: "CreateReminder_Err",
+			input: func() (*model.Reminder, error) {
+				db, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+				}
+				defer db.Close()
+
+				mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO reminders")).WillReturnError(sql.ErrConnDone)
+
+				svc := NewReminderRepository(db)
+				return svc.CreateReminder(context.Background(), model.Reminder{})
+			},
+			expect: sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.input()
+			if err != tt.expect {
+				t.Errorf("expect values are equals, but got diferent, output: %v, expect: %v", err, tt.expect)
+			}
+		})
+	}
+}
+
+func TestGetRemindersByTaskId(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	taskId := uuid.NewV4()
+
+	mock.ExpectQuery(regexp.QuoteMeta("FROM reminders WHERE deleted_at IS NULL AND task_id = $1 ORDER BY remind_at, id")).
+		WithArgs(taskId).
+		WillReturnRows(sqlmock.NewRows(reminderColumns).
+			AddRow(uuid.NewV4(), taskId, time.Now(), nil, 0, nil, time.Now(), nil).
+			AddRow(uuid.NewV4(), taskId, time.Now(), time.Now(), 1, nil, time.Now(), nil))
+
+	svc := NewReminderRepository(db)
+	reminders, err := svc.GetRemindersByTaskId(context.Background(), taskId)
+	if err != nil {
+		t.Fatalf("expect no error, but got %v", err)
+	}
+
+	if len(reminders) != 2 || reminders[0].SentAt.Valid || !reminders[1].SentAt.Valid {
+		t.Errorf("expect a pending and a sent reminder, but got %v", reminders)
+	}
+}
+
+func TestDeleteReminder(t *testing.T) {
+	tests := []struct {
+		name     string
+		affected int64
+		expect   error
+	}{
+		{
+			name:     "DeleteReminder_Success",
+			affected: 1,
+			expect:   nil,
+		},
+		{
+			name:     "DeleteReminder_ErrReminderNotFound",
+			affected: 0,
+			expect:   ErrReminderNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer db.Close()
+
+			taskId, reminderId := uuid.NewV4(), uuid.NewV4()
+
+			mock.ExpectExec(regexp.QuoteMeta("UPDATE reminders SET deleted_at = $1 WHERE deleted_at IS NULL AND id = $2 AND task_id = $3")).
+				WithArgs(sqlmock.AnyArg(), reminderId, taskId).
+				WillReturnResult(sqlmock.NewResult(0, tt.affected))
+
+			svc := NewReminderRepository(db)
+			if err := svc.DeleteReminder(context.Background(), taskId, reminderId); err != tt.expect {
+				t.Errorf("expect values are equals, but got diferent, output: %v, expect: %v", err, tt.expect)
+			}
+		})
+	}
+}
+
+func TestProcessDueReminders(t *testing.T) {
+	var (
+		errNotify error = errors.New("webhook is down")
+	)
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	var (
+		now      time.Time = time.Now().Truncate(time.Microsecond)
+		sentId   uuid.UUID = uuid.NewV4()
+		failedId uuid.UUID = uuid.NewV4()
+		lostId   uuid.UUID = uuid.NewV4()
+		taskId   uuid.UUID = uuid.NewV4()
+	)
+
+	// expectRenew expects the claim of the reminder to be renewed, renewed tells if it was still claimed
+	expectRenew := func(id uuid.UUID, renewed bool) {
+		var affected int64
+		if renewed {
+			affected = 1
+		}
+
+		mock.ExpectExec(regexp.QuoteMeta("UPDATE reminders SET claimed_at = $1 WHERE claimed_at = $2 AND id = $3")).
+			WithArgs(sqlmock.AnyArg(), now, id).
+			WillReturnResult(sqlmock.NewResult(0, affected))
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("FROM reminders JOIN tasks ON tasks.id = reminders.task_id WHERE reminders.deleted_at IS NULL AND reminders.sent_at IS NULL AND tasks.completed = $1 AND tasks.deleted_at IS NULL AND reminders.remind_at <= $2 AND reminders.attempts < $3 AND (reminders.claimed_at IS NULL OR reminders.claimed_at < $4) ORDER BY reminders.remind_at LIMIT 10 FOR UPDATE OF reminders SKIP LOCKED")).
+		WithArgs(false, now, model.ReminderMaxAttempts, now.Add(-reminderClaimLease)).
+		WillReturnRows(sqlmock.NewRows(append(reminderColumns, "value", "due_date")).
+			AddRow(sentId, taskId, now, nil, 0, nil, now, nil, "monthly report", now).
+			AddRow(failedId, taskId, now, nil, 2, nil, now, nil, "monthly report", now).
+			AddRow(lostId, taskId, now, nil, 0, nil, now, nil, "monthly report", now))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE reminders SET claimed_at = $1 WHERE id IN ($2,$3,$4)")).
+		WithArgs(now, sentId, failedId, lostId).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectCommit()
+	expectRenew(sentId, true)
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE reminders SET attempts = attempts + 1, claimed_at = $1, sent_at = $2, last_error = $3 WHERE id = $4")).
+		WithArgs(nil, now, nil, sentId).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectRenew(failedId, true)
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE reminders SET attempts = attempts + 1, claimed_at = $1, last_error = $2 WHERE id = $3")).
+		WithArgs(nil, errNotify.Error(), failedId).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	// The lease of the last reminder ended while the others were notified and another replica claimed it
+	expectRenew(lostId, false)
+
+	svc := NewReminderRepository(db)
+	sent, err := svc.ProcessDueReminders(context.Background(), now, 10, func(ctx context.Context, reminder *model.Reminder, task *model.Task) error {
+		if task.Id != taskId || task.Value != "monthly report" {
+			t.Errorf("expect the task of the reminder, but got %v", task)
+		}
+		if reminder.Id == lostId {
+			t.Error("expect the reminder claimed by another replica not to be notified")
+		}
+		if reminder.Id == failedId {
+			return errNotify
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("expect no error, but got %v", err)
+	}
+
+	if sent != 1 {
+		t.Errorf("expect 1 reminder sent, but got %d", sent)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/overridesh/sgg-todolist-service/internal/auth"
+)
+
+var ErrIdentityRequired = errors.New("identity is required")
+
+// scope is the owner and the tenant of the caller, the queries of tasks, comments and labels only reach their tasks.
+// The tasks of any other owner or tenant are not found.
+type scope struct {
+	ownerId  string
+	tenantId string
+}
+
+// getScope get the scope of the identity in the context
+func getScope(ctx context.Context) (scope, error) {
+	identity, ok := auth.FromContext(ctx)
+	if !ok || len(identity.Subject) == 0 || len(identity.TenantId) == 0 {
+		return scope{}, ErrIdentityRequired
+	}
+
+	return scope{
+		ownerId:  identity.Subject,
+		tenantId: identity.TenantId,
+	}, nil
+}
+
+// tasks adds the scope to the conditions of a query of tasks
+func (s scope) tasks(conditions sq.Eq) sq.Eq {
+	conditions["owner_id"] = s.ownerId
+	conditions["tenant_id"] = s.tenantId
+	return conditions
+}
+
+// webhooks adds the scope to the conditions of a query of webhooks or of their deliveries
+func (s scope) webhooks(conditions sq.Eq) sq.Eq {
+	conditions["owner_id"] = s.ownerId
+	conditions["tenant_id"] = s.tenantId
+	return conditions
+}
+
+// taskIds is the condition of a query of comments or labels, they are scoped by their task
+func (s scope) taskIds() sq.Sqlizer {
+	return sq.Expr("task_id IN (SELECT id FROM tasks WHERE owner_id = ? AND tenant_id = ?)", s.ownerId, s.tenantId)
+}
+
+// task selects the id of a non deleted task of the scope, an insert from it writes nothing when the task is not found
+func (s scope) task(id interface{}, columns ...interface{}) sq.SelectBuilder {
+	builder := psql.Select().Column(sq.Expr("id"))
+	for _, column := range columns {
+		builder = builder.Column(sq.Expr("?", column))
+	}
+
+	return builder.
+		From("tasks").
+		Where(s.tasks(sq.Eq{
+			"deleted_at": nil,
+			"id":         id,
+		}))
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/overridesh/sgg-todolist-service/internal/auth"
+)
+
+// caller is the identity the scoped queries of the tests run as
+var caller *auth.Identity = &auth.Identity{Subject: "user-1", TenantId: "tenant-1"}
+
+func callerContext() context.Context {
+	return auth.NewContext(context.Background(), caller)
+}
+
+func TestScope(t *testing.T) {
+	t.Run("GetScope_IdentityRequired", func(t *testing.T) {
+		if _, err := getScope(context.Background()); err != ErrIdentityRequired {
+			t.Errorf("expect %v, but got %v", ErrIdentityRequired, err)
+		}
+	})
+
+	t.Run("Tasks_Conditions", func(t *testing.T) {
+		scope, err := getScope(callerContext())
+		if err != nil {
+			t.Fatalf("expect no error, but got %v", err)
+		}
+
+		query, args, err := psql.Select("id").From("tasks").Where(scope.tasks(sq.Eq{"id": 1})).ToSql()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if query != "SELECT id FROM tasks WHERE id = $1 AND owner_id = $2 AND tenant_id = $3" {
+			t.Errorf("unexpected query %s", query)
+		}
+
+		if len(args) != 3 || args[1] != caller.Subject || args[2] != caller.TenantId {
+			t.Errorf("unexpected args %v", args)
+		}
+	})
+
+	t.Run("GetTask_NoIdentity", func(t *testing.T) {
+		svc := NewTaskRepository(nil)
+		if _, err := svc.GetTask(context.Background(), [16]byte{}); err != ErrIdentityRequired {
+			t.Errorf("expect %v, but got %v", ErrIdentityRequired, err)
+		}
+	})
+}
+
+// callerScope is the scope of the caller, the tests build the expected queries with it
+var callerScope scope = scope{ownerId: caller.Subject, tenantId: caller.TenantId}
+
+// driverArgs returns the args of a query as the expected args of sqlmock
+func driverArgs(args []interface{}) []driver.Value {
+	values := make([]driver.Value, len(args))
+	for i, arg := range args {
+		values[i] = arg
+	}
+	return values
+}
@@ -4,28 +4,153 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	uuid "github.com/satori/go.uuid"
-	"go.uber.org/zap"
 
 	"github.com/overridesh/sgg-todolist-service/internal/model"
 	storage "github.com/overridesh/sgg-todolist-service/pkg/storage/sql"
 )
 
 var (
-	ErrTaskNotFound = errors.New("task not found")
+	ErrTaskNotFound        = errors.New("task not found")
+	ErrTaskInvalidField    = errors.New("task field cannot be updated")
+	ErrTaskVersionMismatch = errors.New("task was modified by another request")
+	ErrMoveTargetNotFound  = errors.New("target task not found")
+	ErrIncompleteSubtasks  = errors.New("task has incomplete subtasks")
+	ErrParentTaskDeleted   = errors.New("parent task is deleted")
 )
 
 type TaskRepository interface {
 	GetTask(context.Context, uuid.UUID) (*model.Task, error)
-	GetTasks(context.Context, int32) ([]*model.Task, error)
+	GetTasks(context.Context, model.TaskFilter) ([]*model.Task, error)
+	CountTasks(context.Context, model.TaskFilter) (int64, error)
+	SearchTasks(context.Context, model.TaskSearch) ([]*model.TaskSearchResult, error)
 	CreateTask(context.Context, model.Task) (*model.Task, error)
-	UpdateTask(context.Context, *model.Task) error
-	DeleteTask(context.Context, uuid.UUID) error
+	UpdateTask(context.Context, *model.Task, model.TaskUpdate) error
+	DeleteTask(context.Context, uuid.UUID, sql.NullInt64) error
+	GetDeletedTasks(ctx context.Context, page int32, pageSize uint64) ([]*model.Task, error)
+	RestoreTask(context.Context, uuid.UUID) (*model.Task, error)
+	PurgeTask(context.Context, uuid.UUID) error
+	PurgeDeletedTasks(ctx context.Context, before time.Time) (int64, error)
+	GetSubtasks(ctx context.Context, parentId uuid.UUID) ([]*model.Task, error)
+	GetTaskAncestors(context.Context, uuid.UUID) ([]uuid.UUID, error)
+	GetSubtaskHeight(context.Context, uuid.UUID) (int, error)
+	MoveTask(ctx context.Context, id uuid.UUID, targetId uuid.UUID, after bool) (*model.Task, error)
 }
 
+var (
+	// Allowed columns to sort tasks, anything else falls back to created_at
+	taskSortColumns map[string]string = map[string]string{
+		model.TaskSortByCreatedAt: "created_at",
+		model.TaskSortByUpdatedAt: "updated_at",
+		model.TaskSortByDueDate:   "due_date",
+		model.TaskSortByValue:     "value",
+		model.TaskSortByPriority:  "priority",
+		model.TaskSortByPosition:  "position",
+	}
+)
+
+const (
+	// searchTasksPrefix find the matches of the search in tasks, comments and labels
+	// and rank the non deleted tasks by the sum of their matches. The text is HTML escaped before it is highlighted,
+	// so the <mark> tags are the only markup of a snippet.
+	searchTasksPrefix string = `
+		WITH search AS (
+			SELECT websearch_to_tsquery('english', ?) AS query
+		),
+		matches AS (
+			SELECT tasks.id AS task_id, 'task' AS source,
+				ts_headline('english', replace(replace(replace(replace(tasks.value, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'), search.query, 'StartSel=<mark>, StopSel=</mark>') AS snippet,
+				ts_rank(tasks.search_vector, search.query) AS rank
+			FROM tasks, search
+			WHERE tasks.deleted_at IS NULL AND tasks.search_vector @@ search.query
+			UNION ALL
+			SELECT comments.task_id, 'comment',
+				ts_headline('english', replace(replace(replace(replace(comments.value, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'), search.query, 'StartSel=<mark>, StopSel=</mark>'),
+				ts_rank(comments.search_vector, search.query)
+			FROM comments, search
+			WHERE comments.deleted_at IS NULL AND comments.search_vector @@ search.query
+			UNION ALL
+			SELECT labels.task_id, 'label',
+				ts_headline('english', replace(replace(replace(replace(labels.value, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'), search.query, 'StartSel=<mark>, StopSel=</mark>'),
+				ts_rank(labels.search_vector, search.query)
+			FROM labels, search
+			WHERE labels.deleted_at IS NULL AND labels.search_vector @@ search.query
+		),
+		ranked AS (
+			SELECT matches.task_id, SUM(matches.rank) AS rank
+			FROM matches
+			JOIN tasks ON tasks.id = matches.task_id AND tasks.deleted_at IS NULL
+				AND tasks.owner_id = ? AND tasks.tenant_id = ?
+			GROUP BY matches.task_id
+			ORDER BY rank DESC, matches.task_id
+			LIMIT ? OFFSET ?
+		)`
+)
+
+// The hierarchies are walked from a task of the scope, its parent and subtasks always have the same owner and tenant
+const (
+	// taskAncestorsPrefix walk up the hierarchy from a task to its root, the path stops a corrupted hierarchy with a cycle
+	taskAncestorsPrefix string = `
+		WITH RECURSIVE ancestors AS (
+			SELECT id, parent_id, 1 AS depth, ARRAY[id] AS path
+			FROM tasks
+			WHERE id = ? AND deleted_at IS NULL AND owner_id = ? AND tenant_id = ?
+			UNION ALL
+			SELECT tasks.id, tasks.parent_id, ancestors.depth + 1, ancestors.path || tasks.id
+			FROM tasks
+			JOIN ancestors ON tasks.id = ancestors.parent_id
+			WHERE tasks.deleted_at IS NULL AND NOT tasks.id = ANY(ancestors.path)
+		)`
+	// subtasksPrefix walk down the hierarchy from a task to all its non deleted subtasks, the task itself has depth 1
+	subtasksPrefix string = `
+		WITH RECURSIVE subtasks AS (
+			SELECT id, completed, 1 AS depth, ARRAY[id] AS path
+			FROM tasks
+			WHERE id = ? AND deleted_at IS NULL AND owner_id = ? AND tenant_id = ?
+			UNION ALL
+			SELECT tasks.id, tasks.completed, subtasks.depth + 1, subtasks.path || tasks.id
+			FROM tasks
+			JOIN subtasks ON tasks.parent_id = subtasks.id
+			WHERE tasks.deleted_at IS NULL AND NOT tasks.id = ANY(subtasks.path)
+		)`
+	// subtreePrefix walk down the hierarchy from a task, deleted or not, to the subtasks with the given deletion time,
+	// the non deleted ones when it is null. A task is deleted, restored and purged with the subtasks it reaches,
+	// the task itself has depth 1
+	subtreePrefix string = `
+		WITH RECURSIVE subtree AS (
+			SELECT id, 1 AS depth, ARRAY[id] AS path
+			FROM tasks
+			WHERE id = ? AND owner_id = ? AND tenant_id = ?
+			UNION ALL
+			SELECT tasks.id, subtree.depth + 1, subtree.path || tasks.id
+			FROM tasks
+			JOIN subtree ON tasks.parent_id = subtree.id
+			WHERE tasks.deleted_at IS NOT DISTINCT FROM ? AND NOT tasks.id = ANY(subtree.path)
+		)`
+)
+
+const (
+	// positionStep is the gap between positions of tasks appended at the end or placed after a rebalance
+	positionStep float64 = 1024
+	// lastPositionQuery get the position one step after the last task of an owner and a tenant, new tasks go to the
+	// end of their list
+	lastPositionQuery string = "(SELECT COALESCE(MAX(position), 0) + ? FROM tasks WHERE owner_id = ? AND tenant_id = ?)"
+	// rebalancePositionsQuery spread again the positions of the tasks of an owner and a tenant keeping their order,
+	// only needed when there is no room left between two positions. The moved tasks get a new version
+	// so their ETag changes with their position
+	rebalancePositionsQuery string = `
+		UPDATE tasks SET position = ordered.row_number * $1, version = version + 1
+		FROM (
+			SELECT id, ROW_NUMBER() OVER (ORDER BY position, id) AS row_number
+			FROM tasks WHERE owner_id = $2 AND tenant_id = $3
+		) AS ordered
+		WHERE tasks.id = ordered.id`
+)
+
 type taskRepository struct {
 	db storage.DB
 }
@@ -37,6 +162,13 @@ func NewTaskRepository(db storage.DB) TaskRepository {
 }
 
 func (tk *taskRepository) GetTask(ctx context.Context, id uuid.UUID) (*model.Task, error) {
+	ctx = storage.WithMethod(ctx, "taskRepository.GetTask")
+
+	scope, err := getScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	query, args, err := psql.
 		Select(`
 			id,
@@ -45,13 +177,18 @@ func (tk *taskRepository) GetTask(ctx context.Context, id uuid.UUID) (*model.Tas
 			due_date,
 			created_at,
 			updated_at,
-			deleted_at
+			deleted_at,
+			version,
+			parent_id,
+			priority,
+			position,
+			recurrence
 		`).
 		From("tasks").
-		Where(sq.Eq{
+		Where(scope.tasks(sq.Eq{
 			"deleted_at": nil,
 			"id":         id,
-		}).
+		})).
 		Limit(limitOne).
 		ToSql()
 	if err != nil {
@@ -68,6 +205,11 @@ func (tk *taskRepository) GetTask(ctx context.Context, id uuid.UUID) (*model.Tas
 		&task.CreatedAt,
 		&task.UpdatedAt,
 		&task.DeletedAt,
+		&task.Version,
+		&task.ParentId,
+		&task.Priority,
+		&task.Position,
+		&task.Recurrence,
 	); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, ErrTaskNotFound
@@ -78,8 +220,15 @@ func (tk *taskRepository) GetTask(ctx context.Context, id uuid.UUID) (*model.Tas
 	return &task, nil
 }
 
-func (tk *taskRepository) GetTasks(ctx context.Context, page int32) ([]*model.Task, error) {
-	query, args, err := psql.
+func (tk *taskRepository) GetTasks(ctx context.Context, filter model.TaskFilter) ([]*model.Task, error) {
+	ctx = storage.WithMethod(ctx, "taskRepository.GetTasks")
+
+	scope, err := getScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := psql.
 		Select(`
 			id,
 			value,
@@ -87,15 +236,31 @@ func (tk *taskRepository) GetTasks(ctx context.Context, page int32) ([]*model.Ta
 			due_date,
 			created_at,
 			updated_at,
-			deleted_at
+			deleted_at,
+			version,
+			parent_id,
+			priority,
+			position,
+			recurrence
 		`).
 		From("tasks").
-		Where(sq.Eq{
-			"deleted_at": nil,
-		}).
-		Limit(limitPage).
-		Offset(GetOffset(page, limitPage)).
-		ToSql()
+		Where(GetTaskFilterConditions(filter)).
+		Where(scope.tasks(sq.Eq{})).
+		OrderBy(GetTaskOrderBy(filter)...)
+
+	if filter.Limit > 0 {
+		// Keyset pagination on (created_at, id)
+		if filter.After != nil {
+			builder = builder.Where(GetTaskCursorCondition(filter))
+		}
+		builder = builder.Limit(filter.Limit)
+	} else {
+		builder = builder.
+			Limit(DefaultPageSize).
+			Offset(GetOffset(filter.Page, DefaultPageSize))
+	}
+
+	query, args, err := builder.ToSql()
 	if err != nil {
 		return nil, err
 	}
@@ -120,6 +285,11 @@ func (tk *taskRepository) GetTasks(ctx context.Context, page int32) ([]*model.Ta
 			&task.CreatedAt,
 			&task.UpdatedAt,
 			&task.DeletedAt,
+			&task.Version,
+			&task.ParentId,
+			&task.Priority,
+			&task.Position,
+			&task.Recurrence,
 		); err != nil {
 			return nil, err
 		}
@@ -130,115 +300,1266 @@ func (tk *taskRepository) GetTasks(ctx context.Context, page int32) ([]*model.Ta
 	return tasks, nil
 }
 
-func (tk *taskRepository) CreateTask(ctx context.Context, newTask model.Task) (*model.Task, error) {
-	var (
-		err error
-		tx  *sql.Tx
-	)
+func (tk *taskRepository) CountTasks(ctx context.Context, filter model.TaskFilter) (int64, error) {
+	ctx = storage.WithMethod(ctx, "taskRepository.CountTasks")
+
+	scope, err := getScope(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	query, args, err := psql.
+		Select("COUNT(*)").
+		From("tasks").
+		Where(GetTaskFilterConditions(filter)).
+		Where(scope.tasks(sq.Eq{})).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var total int64
+
+	if err := tk.db.QueryRowContext(ctx, query, args...).Scan(&total); err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+func (tk *taskRepository) SearchTasks(ctx context.Context, search model.TaskSearch) ([]*model.TaskSearchResult, error) {
+	ctx = storage.WithMethod(ctx, "taskRepository.SearchTasks")
 
-	tx, err = tk.db.BeginTx(ctx, nil)
+	scope, err := getScope(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	defer func() {
-		if err != nil {
-			if err := tx.Rollback(); err != nil {
-				zap.S().Errorf("cannot do a rollback, error: %v", err)
-			}
-		} else {
-			if err := tx.Commit(); err != nil {
-				zap.S().Errorf("cannot do a commit, error: %v", err)
-			}
-		}
-	}()
+	pageSize := search.PageSize
+	if pageSize == 0 {
+		pageSize = DefaultPageSize
+	}
 
 	query, args, err := psql.
-		Insert("tasks").
-		Columns("value", "due_date").
-		Values(newTask.Value, newTask.DueDate).
-		Suffix("RETURNING \"id\", \"value\", \"completed\", \"due_date\", \"created_at\", \"updated_at\", \"deleted_at\"").
+		Select(`
+			tasks.id,
+			tasks.value,
+			tasks.completed,
+			tasks.due_date,
+			tasks.created_at,
+			tasks.updated_at,
+			tasks.deleted_at,
+			tasks.version,
+			tasks.parent_id,
+			tasks.priority,
+			tasks.position,
+			tasks.recurrence,
+			ranked.rank,
+			matches.source,
+			matches.snippet
+		`).
+		Prefix(searchTasksPrefix, search.Query, scope.ownerId, scope.tenantId, pageSize, GetOffset(search.Page, pageSize)).
+		From("ranked").
+		Join("tasks ON tasks.id = ranked.task_id").
+		Join("matches ON matches.task_id = ranked.task_id").
+		OrderBy("ranked.rank DESC", "tasks.id", "matches.rank DESC").
 		ToSql()
 	if err != nil {
 		return nil, err
 	}
 
-	row := tx.QueryRowContext(
-		ctx,
-		query,
-		args...,
-	)
-	if err := row.Err(); err != nil {
+	rows, err := tk.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var results []*model.TaskSearchResult = []*model.TaskSearchResult{}
+
+	// One row per match, rows of the same task come together
+	for rows.Next() {
+		var (
+			result model.TaskSearchResult
+			match  model.SearchMatch
+		)
+
+		if err := rows.Scan(
+			&result.Task.Id,
+			&result.Task.Value,
+			&result.Task.Completed,
+			&result.Task.DueDate,
+			&result.Task.CreatedAt,
+			&result.Task.UpdatedAt,
+			&result.Task.DeletedAt,
+			&result.Task.Version,
+			&result.Task.ParentId,
+			&result.Task.Priority,
+			&result.Task.Position,
+			&result.Task.Recurrence,
+			&result.Rank,
+			&match.Source,
+			&match.Snippet,
+		); err != nil {
+			return nil, err
+		}
+
+		if last := len(results) - 1; last >= 0 && results[last].Task.Id == result.Task.Id {
+			results[last].Matches = append(results[last].Matches, &match)
+			continue
+		}
+
+		result.Matches = []*model.SearchMatch{&match}
+		results = append(results, &result)
+	}
+
+	return results, nil
+}
+
+func (tk *taskRepository) CreateTask(ctx context.Context, newTask model.Task) (*model.Task, error) {
+	ctx = storage.WithMethod(ctx, "taskRepository.CreateTask")
+
+	scope, err := getScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query, args, err := psql.
+		Insert("tasks").
+		Columns("value", "due_date", "parent_id", "priority", "position", "recurrence", "owner_id", "tenant_id").
+		Values(newTask.Value, newTask.DueDate, newTask.ParentId, newTask.Priority, sq.Expr(lastPositionQuery, positionStep, scope.ownerId, scope.tenantId), newTask.Recurrence, scope.ownerId, scope.tenantId).
+		Suffix("RETURNING \"id\", \"value\", \"completed\", \"due_date\", \"created_at\", \"updated_at\", \"deleted_at\", \"version\", \"parent_id\", \"priority\", \"position\", \"recurrence\"").
+		ToSql()
+	if err != nil {
 		return nil, err
 	}
 
 	var task model.Task
-	if err := row.Scan(
-		&task.Id,
-		&task.Value,
-		&task.Completed,
-		&task.DueDate,
-		&task.CreatedAt,
-		&task.UpdatedAt,
-		&task.DeletedAt,
-	); err != nil {
+
+	err = withTx(ctx, tk.db, func(tx storage.Tx) error {
+		if err := tx.QueryRowContext(ctx, query, args...).Scan(
+			&task.Id,
+			&task.Value,
+			&task.Completed,
+			&task.DueDate,
+			&task.CreatedAt,
+			&task.UpdatedAt,
+			&task.DeletedAt,
+			&task.Version,
+			&task.ParentId,
+			&task.Priority,
+			&task.Position,
+			&task.Recurrence,
+		); err != nil {
+			return err
+		}
+
+		return insertEvent(ctx, tx, model.NewTaskEvent(model.EventTaskCreated, &task))
+	})
+	if err != nil {
 		return nil, err
 	}
 
 	return &task, nil
 }
 
-func (tk *taskRepository) UpdateTask(ctx context.Context, task *model.Task) error {
-	query, args, err := psql.
-		Update("tasks").
-		Set("value", task.Value).
-		Set("completed", task.Completed).
-		Set("due_date", task.DueDate).
+// UpdateTask updates only the given fields of the task, all of them when fields is empty.
+// The update only happens if the task still has the version that was read, a deleted task is not found.
+// When the task gets completed its subtasks are checked or completed in the same transaction, as asked by the update,
+// and so is its next occurrence created. The events of the update are written in the transaction too.
+func (tk *taskRepository) UpdateTask(ctx context.Context, task *model.Task, update model.TaskUpdate) error {
+	ctx = storage.WithMethod(ctx, "taskRepository.UpdateTask")
+
+	scope, err := getScope(ctx)
+	if err != nil {
+		return err
+	}
+
+	fields := update.Fields
+	if len(fields) == 0 {
+		fields = model.TaskUpdatableFields
+	}
+
+	values := map[string]interface{}{
+		model.TaskFieldValue:      task.Value,
+		model.TaskFieldCompleted:  task.Completed,
+		model.TaskFieldDueDate:    task.DueDate,
+		model.TaskFieldParentId:   task.ParentId,
+		model.TaskFieldPriority:   task.Priority,
+		model.TaskFieldRecurrence: task.Recurrence,
+	}
+
+	builder := psql.Update("tasks")
+
+	for _, field := range fields {
+		value, ok := values[field]
+		if !ok {
+			return ErrTaskInvalidField
+		}
+		builder = builder.Set(field, value)
+	}
+
+	query, args, err := builder.
 		Set("updated_at", time.Now()).
-		Where(sq.Eq{
+		Set("version", sq.Expr("version + 1")).
+		Where(scope.tasks(sq.Eq{
 			"deleted_at": nil,
 			"id":         task.Id,
-		}).
+			"version":    task.Version,
+		})).
+		Suffix("RETURNING \"version\", \"updated_at\"").
 		ToSql()
 	if err != nil {
 		return err
 	}
 
-	if err := tk.db.QueryRowContext(ctx, query, args...).Err(); err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return ErrTaskNotFound
+	updated := *task
+
+	err = withTx(ctx, tk.db, func(tx storage.Tx) error {
+		if err := tx.QueryRowContext(ctx, query, args...).Scan(&updated.Version, &updated.UpdatedAt); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return versionConflict(ctx, tx, scope, task.Id)
+			}
+			return err
+		}
+
+		if err := updateSubtasks(ctx, tx, scope, &updated, update.SubtaskCompletion); err != nil {
+			return err
+		}
+
+		if err := insertEvent(ctx, tx, model.NewTaskEvent(model.EventTaskUpdated, &updated)); err != nil {
+			return err
+		}
+
+		if updated.Completed && !update.WasCompleted {
+			if err := insertEvent(ctx, tx, model.NewTaskEvent(model.EventTaskCompleted, &updated)); err != nil {
+				return err
+			}
 		}
+
+		if update.NextOccurrence != nil {
+			return createNextOccurrence(ctx, tx, scope, task.Id, update.NextOccurrence)
+		}
+
+		return nil
+	})
+	if err != nil {
 		return err
 	}
 
+	*task = updated
+
 	return nil
 }
 
-func (tk *taskRepository) DeleteTask(ctx context.Context, id uuid.UUID) error {
-	query, args, err := psql.
-		Update("tasks").
-		Set("deleted_at", time.Now()).
-		Where(sq.Eq{
-			"deleted_at": nil,
-			"id":         id,
-		}).ToSql()
+// updateSubtasks checks or completes the subtasks of a task that was just completed, as asked by completion. A change
+// of a subtask also changes the version of its parent, so the subtasks are checked after the task is locked by its
+// update and none of them can change until the commit.
+func updateSubtasks(ctx context.Context, tx storage.Tx, scope scope, task *model.Task, completion string) error {
+	if !task.Completed {
+		return nil
+	}
+
+	switch completion {
+	case model.SubtaskCompletionRequire:
+		incomplete, err := countIncompleteSubtasks(ctx, tx, scope, task.Id)
+		if err != nil {
+			return err
+		}
+		if incomplete > 0 {
+			return ErrIncompleteSubtasks
+		}
+	case model.SubtaskCompletionCascade:
+		completed, err := completeSubtasks(ctx, tx, scope, task.Id)
+		if err != nil {
+			return err
+		}
+		if completed > 0 {
+			// The completed subtasks gave the task a new version
+			return tx.QueryRowContext(ctx, "SELECT version FROM tasks WHERE id = $1", task.Id).Scan(&task.Version)
+		}
+	}
+
+	return nil
+}
+
+// DeleteTask soft delete the task with its subtasks, comments and labels in the same transaction,
+// when version is valid only if the task still has that version
+func (tk *taskRepository) DeleteTask(ctx context.Context, id uuid.UUID, version sql.NullInt64) error {
+	ctx = storage.WithMethod(ctx, "taskRepository.DeleteTask")
+
+	scope, err := getScope(ctx)
 	if err != nil {
 		return err
 	}
 
-	result, err := tk.db.ExecContext(ctx, query, args...)
+	conditions := scope.tasks(sq.Eq{
+		"deleted_at": nil,
+		"id":         id,
+	})
+
+	if version.Valid {
+		conditions["version"] = version.Int64
+	}
+
+	// The subtasks, comments and labels share the deletion time of the task, so they are restored together
+	deletedAt := time.Now()
+
+	return withTx(ctx, tk.db, func(tx storage.Tx) error {
+		query, args, err := psql.
+			Update("tasks").
+			Set("deleted_at", deletedAt).
+			Set("version", sq.Expr("version + 1")).
+			Where(conditions).ToSql()
+		if err != nil {
+			return err
+		}
+
+		result, err := tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			return err
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+
+		if affected == 0 {
+			if version.Valid {
+				return versionConflict(ctx, tx, scope, id)
+			}
+			return ErrTaskNotFound
+		}
+
+		// The task is deleted first, so the deleted subtasks don't give it a new version
+		subtaskIds, err := deleteSubtasks(ctx, tx, scope, id, deletedAt)
+		if err != nil {
+			return err
+		}
+
+		ids := append([]uuid.UUID{id}, subtaskIds...)
+
+		for _, table := range []string{"comments", "labels"} {
+			query, args, err := psql.
+				Update(table).
+				Set("deleted_at", deletedAt).
+				Where(sq.Eq{
+					"deleted_at": nil,
+					"task_id":    ids,
+				}).ToSql()
+			if err != nil {
+				return err
+			}
+
+			if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+				return err
+			}
+		}
+
+		for _, deletedId := range ids {
+			if err := insertEvent(ctx, tx, model.NewEvent(model.EventTaskDeleted, deletedId, model.DeletedData{Id: deletedId})); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// GetDeletedTasks get the tasks in the trash, most recently deleted first
+func (tk *taskRepository) GetDeletedTasks(ctx context.Context, page int32, pageSize uint64) ([]*model.Task, error) {
+	ctx = storage.WithMethod(ctx, "taskRepository.GetDeletedTasks")
+
+	scope, err := getScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if pageSize == 0 {
+		pageSize = DefaultPageSize
+	}
+
+	query, args, err := psql.
+		Select(`
+			id,
+			value,
+			completed,
+			due_date,
+			created_at,
+			updated_at,
+			deleted_at,
+			version,
+			parent_id,
+			priority,
+			position,
+			recurrence
+		`).
+		From("tasks").
+		Where(sq.NotEq{"deleted_at": nil}).
+		Where(scope.tasks(sq.Eq{})).
+		OrderBy("deleted_at DESC", "id DESC").
+		Limit(pageSize).
+		Offset(GetOffset(page, pageSize)).
+		ToSql()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	affected, err := result.RowsAffected()
+	rows, err := tk.db.QueryContext(ctx, query, args...)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var tasks []*model.Task = []*model.Task{}
+
+	for rows.Next() {
+		var task model.Task
+
+		if err := rows.Scan(
+			&task.Id,
+			&task.Value,
+			&task.Completed,
+			&task.DueDate,
+			&task.CreatedAt,
+			&task.UpdatedAt,
+			&task.DeletedAt,
+			&task.Version,
+			&task.ParentId,
+			&task.Priority,
+			&task.Position,
+			&task.Recurrence,
+		); err != nil {
+			return nil, err
+		}
+
+		tasks = append(tasks, &task)
 	}
 
-	if affected == 0 {
-		return ErrTaskNotFound
+	return tasks, nil
+}
+
+// RestoreTask take the task out of the trash with the subtasks, comments and labels deleted
+// at the same time or after the task, the ones deleted before stay deleted. A subtask cannot
+// be restored while its parent is in the trash.
+func (tk *taskRepository) RestoreTask(ctx context.Context, id uuid.UUID) (*model.Task, error) {
+	ctx = storage.WithMethod(ctx, "taskRepository.RestoreTask")
+
+	scope, err := getScope(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	var task model.Task
+
+	err = withTx(ctx, tk.db, func(tx storage.Tx) error {
+		deletedAt, err := lockDeletedTask(ctx, tx, scope, id)
+		if err != nil {
+			return err
+		}
+
+		if err := lockParentTask(ctx, tx, id); err != nil {
+			return err
+		}
+
+		// The subtasks are restored first, so they don't give the restored task a new version
+		subtasks, err := restoreSubtasks(ctx, tx, scope, id, deletedAt)
+		if err != nil {
+			return err
+		}
+
+		query, args, err := psql.
+			Update("tasks").
+			Set("deleted_at", nil).
+			Set("updated_at", time.Now()).
+			Set("version", sq.Expr("version + 1")).
+			Where(sq.Eq{"id": id}).
+			Suffix("RETURNING \"id\", \"value\", \"completed\", \"due_date\", \"created_at\", \"updated_at\", \"deleted_at\", \"version\", \"parent_id\", \"priority\", \"position\", \"recurrence\"").
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		if err := tx.QueryRowContext(ctx, query, args...).Scan(
+			&task.Id,
+			&task.Value,
+			&task.Completed,
+			&task.DueDate,
+			&task.CreatedAt,
+			&task.UpdatedAt,
+			&task.DeletedAt,
+			&task.Version,
+			&task.ParentId,
+			&task.Priority,
+			&task.Position,
+			&task.Recurrence,
+		); err != nil {
+			return err
+		}
+
+		restored := append([]*model.Task{&task}, subtasks...)
+
+		ids := make([]uuid.UUID, 0, len(restored))
+		for _, restoredTask := range restored {
+			ids = append(ids, restoredTask.Id)
+		}
+
+		for _, table := range []string{"comments", "labels"} {
+			query, args, err := psql.
+				Update(table).
+				Set("deleted_at", nil).
+				Where(sq.Eq{"task_id": ids}).
+				Where(sq.GtOrEq{"deleted_at": deletedAt}).
+				ToSql()
+			if err != nil {
+				return err
+			}
+
+			if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+				return err
+			}
+		}
+
+		for _, restoredTask := range restored {
+			if err := insertEvent(ctx, tx, model.NewTaskEvent(model.EventTaskRestored, restoredTask)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &task, nil
+}
+
+// PurgeTask permanently delete a task in the trash with the subtasks deleted with it, and their comments and labels
+func (tk *taskRepository) PurgeTask(ctx context.Context, id uuid.UUID) error {
+	ctx = storage.WithMethod(ctx, "taskRepository.PurgeTask")
+
+	scope, err := getScope(ctx)
+	if err != nil {
+		return err
+	}
+
+	return withTx(ctx, tk.db, func(tx storage.Tx) error {
+		deletedAt, err := lockDeletedTask(ctx, tx, scope, id)
+		if err != nil {
+			return err
+		}
+
+		query, args, err := psql.
+			Select("id").
+			Prefix(subtreePrefix, id, scope.ownerId, scope.tenantId, deletedAt).
+			From("subtree").
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		rows, err := tx.QueryContext(ctx, query, args...)
+		if err != nil {
+			return err
+		}
+
+		var ids []uuid.UUID
+
+		for rows.Next() {
+			var purgedId uuid.UUID
+
+			if err := rows.Scan(&purgedId); err != nil {
+				rows.Close()
+				return err
+			}
+
+			ids = append(ids, purgedId)
+		}
+		rows.Close()
+
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		for _, table := range []string{"comments", "labels"} {
+			query, args, err := psql.
+				Delete(table).
+				Where(sq.Eq{"task_id": ids}).
+				ToSql()
+			if err != nil {
+				return err
+			}
+
+			if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+				return err
+			}
+		}
+
+		query, args, err = psql.
+			Delete("tasks").
+			Where(sq.Eq{"id": ids}).
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, query, args...)
+		return err
+	})
+}
+
+// PurgeDeletedTasks permanently delete the tasks, comments and labels deleted before the given time,
+// the comments and labels of the purged tasks are deleted too. It returns the number of deleted rows.
+// It is not scoped, the retention applies to the tasks of every owner and tenant.
+func (tk *taskRepository) PurgeDeletedTasks(ctx context.Context, before time.Time) (int64, error) {
+	ctx = storage.WithMethod(ctx, "taskRepository.PurgeDeletedTasks")
+
+	var total int64
+
+	err := withTx(ctx, tk.db, func(tx storage.Tx) error {
+		deleteBuilders := []sq.DeleteBuilder{
+			psql.Delete("comments").Where(sq.Or{
+				sq.Lt{"deleted_at": before},
+				sq.Expr("task_id IN (SELECT id FROM tasks WHERE deleted_at < ?)", before),
+			}),
+			psql.Delete("labels").Where(sq.Or{
+				sq.Lt{"deleted_at": before},
+				sq.Expr("task_id IN (SELECT id FROM tasks WHERE deleted_at < ?)", before),
+			}),
+			psql.Delete("tasks").Where(sq.Lt{"deleted_at": before}),
+		}
+
+		for _, builder := range deleteBuilders {
+			query, args, err := builder.ToSql()
+			if err != nil {
+				return err
+			}
+
+			result, err := tx.ExecContext(ctx, query, args...)
+			if err != nil {
+				return err
+			}
+
+			affected, err := result.RowsAffected()
+			if err != nil {
+				return err
+			}
+
+			total += affected
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+// GetSubtasks get the direct subtasks of a task
+func (tk *taskRepository) GetSubtasks(ctx context.Context, parentId uuid.UUID) ([]*model.Task, error) {
+	ctx = storage.WithMethod(ctx, "taskRepository.GetSubtasks")
+
+	scope, err := getScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query, args, err := psql.
+		Select(`
+			id,
+			value,
+			completed,
+			due_date,
+			created_at,
+			updated_at,
+			deleted_at,
+			version,
+			parent_id,
+			priority,
+			position,
+			recurrence
+		`).
+		From("tasks").
+		Where(scope.tasks(sq.Eq{
+			"deleted_at": nil,
+			"parent_id":  parentId,
+		})).
+		OrderBy("created_at", "id").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tk.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var tasks []*model.Task = []*model.Task{}
+
+	for rows.Next() {
+		var task model.Task
+
+		if err := rows.Scan(
+			&task.Id,
+			&task.Value,
+			&task.Completed,
+			&task.DueDate,
+			&task.CreatedAt,
+			&task.UpdatedAt,
+			&task.DeletedAt,
+			&task.Version,
+			&task.ParentId,
+			&task.Priority,
+			&task.Position,
+			&task.Recurrence,
+		); err != nil {
+			return nil, err
+		}
+
+		tasks = append(tasks, &task)
+	}
+
+	return tasks, nil
+}
+
+// GetTaskAncestors get the ids from the task up to the root of its hierarchy, the task first.
+// The number of ids is the depth of the task.
+func (tk *taskRepository) GetTaskAncestors(ctx context.Context, id uuid.UUID) ([]uuid.UUID, error) {
+	ctx = storage.WithMethod(ctx, "taskRepository.GetTaskAncestors")
+
+	scope, err := getScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query, args, err := psql.
+		Select("id").
+		Prefix(taskAncestorsPrefix, id, scope.ownerId, scope.tenantId).
+		From("ancestors").
+		OrderBy("depth").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tk.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var ids []uuid.UUID
+
+	for rows.Next() {
+		var ancestorId uuid.UUID
+
+		if err := rows.Scan(&ancestorId); err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, ancestorId)
+	}
+
+	if len(ids) == 0 {
+		return nil, ErrTaskNotFound
+	}
+
+	return ids, nil
+}
+
+// GetSubtaskHeight get the number of levels of the hierarchy under a task, including the task
+func (tk *taskRepository) GetSubtaskHeight(ctx context.Context, id uuid.UUID) (int, error) {
+	ctx = storage.WithMethod(ctx, "taskRepository.GetSubtaskHeight")
+
+	scope, err := getScope(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	query, args, err := psql.
+		Select("COALESCE(MAX(depth), 0)").
+		Prefix(subtasksPrefix, id, scope.ownerId, scope.tenantId).
+		From("subtasks").
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var height int
+	if err := tk.db.QueryRowContext(ctx, query, args...).Scan(&height); err != nil {
+		return 0, err
+	}
+
+	if height == 0 {
+		return 0, ErrTaskNotFound
+	}
+
+	return height, nil
+}
+
+// countIncompleteSubtasks count the subtasks at any level under a task that are not completed
+func countIncompleteSubtasks(ctx context.Context, tx storage.Tx, scope scope, id uuid.UUID) (int64, error) {
+	query, args, err := psql.
+		Select("COUNT(*)").
+		Prefix(subtasksPrefix, id, scope.ownerId, scope.tenantId).
+		From("subtasks").
+		Where("depth > 1 AND NOT completed").
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var total int64
+	if err := tx.QueryRowContext(ctx, query, args...).Scan(&total); err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+// completeSubtasks mark as completed the subtasks at any level under a task and get how many were completed
+func completeSubtasks(ctx context.Context, tx storage.Tx, scope scope, id uuid.UUID) (int64, error) {
+	query, args, err := psql.
+		Update("tasks").
+		Prefix(subtasksPrefix, id, scope.ownerId, scope.tenantId).
+		Set("completed", true).
+		Set("updated_at", time.Now()).
+		Set("version", sq.Expr("version + 1")).
+		Where("id IN (SELECT id FROM subtasks WHERE depth > 1)").
+		Where(sq.Eq{"completed": false}).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+// deleteSubtasks soft delete the non deleted subtasks at any level under a task and get their ids
+func deleteSubtasks(ctx context.Context, tx storage.Tx, scope scope, id uuid.UUID, deletedAt time.Time) ([]uuid.UUID, error) {
+	query, args, err := psql.
+		Update("tasks").
+		Prefix(subtreePrefix, id, scope.ownerId, scope.tenantId, nil).
+		Set("deleted_at", deletedAt).
+		Set("version", sq.Expr("version + 1")).
+		Where("id IN (SELECT id FROM subtree WHERE depth > 1)").
+		Suffix("RETURNING \"id\"").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var ids []uuid.UUID
+
+	for rows.Next() {
+		var subtaskId uuid.UUID
+
+		if err := rows.Scan(&subtaskId); err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, subtaskId)
+	}
+
+	return ids, rows.Err()
+}
+
+// restoreSubtasks take out of the trash the subtasks at any level under a task that were deleted with it
+func restoreSubtasks(ctx context.Context, tx storage.Tx, scope scope, id uuid.UUID, deletedAt time.Time) ([]*model.Task, error) {
+	query, args, err := psql.
+		Update("tasks").
+		Prefix(subtreePrefix, id, scope.ownerId, scope.tenantId, deletedAt).
+		Set("deleted_at", nil).
+		Set("updated_at", time.Now()).
+		Set("version", sq.Expr("version + 1")).
+		Where("id IN (SELECT id FROM subtree WHERE depth > 1)").
+		Suffix("RETURNING \"id\", \"value\", \"completed\", \"due_date\", \"created_at\", \"updated_at\", \"deleted_at\", \"version\", \"parent_id\", \"priority\", \"position\", \"recurrence\"").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var tasks []*model.Task
+
+	for rows.Next() {
+		var task model.Task
+
+		if err := rows.Scan(
+			&task.Id,
+			&task.Value,
+			&task.Completed,
+			&task.DueDate,
+			&task.CreatedAt,
+			&task.UpdatedAt,
+			&task.DeletedAt,
+			&task.Version,
+			&task.ParentId,
+			&task.Priority,
+			&task.Position,
+			&task.Recurrence,
+		); err != nil {
+			return nil, err
+		}
+
+		tasks = append(tasks, &task)
+	}
+
+	return tasks, rows.Err()
+}
+
+// createNextOccurrence create the next occurrence of a recurring task with the labels of the previous one, and its
+// task.created event. next gets the values of the created task.
+func createNextOccurrence(ctx context.Context, tx storage.Tx, scope scope, previousId uuid.UUID, next *model.Task) error {
+	query, args, err := psql.
+		Insert("tasks").
+		Columns("value", "due_date", "parent_id", "priority", "position", "recurrence", "owner_id", "tenant_id").
+		Values(next.Value, next.DueDate, next.ParentId, next.Priority, sq.Expr(lastPositionQuery, positionStep, scope.ownerId, scope.tenantId), next.Recurrence, scope.ownerId, scope.tenantId).
+		Suffix("RETURNING \"id\", \"value\", \"completed\", \"due_date\", \"created_at\", \"updated_at\", \"deleted_at\", \"version\", \"parent_id\", \"priority\", \"position\", \"recurrence\"").
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	if err := tx.QueryRowContext(ctx, query, args...).Scan(
+		&next.Id,
+		&next.Value,
+		&next.Completed,
+		&next.DueDate,
+		&next.CreatedAt,
+		&next.UpdatedAt,
+		&next.DeletedAt,
+		&next.Version,
+		&next.ParentId,
+		&next.Priority,
+		&next.Position,
+		&next.Recurrence,
+	); err != nil {
+		return err
+	}
+
+	labels := psql.
+		Select().
+		Column(sq.Expr("?", next.Id)).
+		Column("value").
+		From("labels").
+		Where(sq.Eq{
+			"deleted_at": nil,
+			"task_id":    previousId,
+		}).
+		Where(scope.taskIds())
+
+	query, args, err = psql.
+		Insert("labels").
+		Columns("task_id", "value").
+		Select(labels).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return err
+	}
+
+	return insertEvent(ctx, tx, model.NewTaskEvent(model.EventTaskCreated, next))
+}
+
+// MoveTask place a task right before or after the target task, only the moved task gets a new position:
+// the middle between the target and its neighbour, or one step away when the target is the first or last one.
+func (tk *taskRepository) MoveTask(ctx context.Context, id uuid.UUID, targetId uuid.UUID, after bool) (*model.Task, error) {
+	ctx = storage.WithMethod(ctx, "taskRepository.MoveTask")
+
+	scope, err := getScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var task model.Task
+
+	err = withTx(ctx, tk.db, func(tx storage.Tx) error {
+		position, err := getMovePosition(ctx, tx, scope, id, targetId, after)
+		if err == errNoRoomBetweenPositions {
+			if _, err := tx.ExecContext(ctx, rebalancePositionsQuery, positionStep, scope.ownerId, scope.tenantId); err != nil {
+				return err
+			}
+			position, err = getMovePosition(ctx, tx, scope, id, targetId, after)
+		}
+		if err != nil {
+			return err
+		}
+
+		query, args, err := psql.
+			Update("tasks").
+			Set("position", position).
+			Set("updated_at", time.Now()).
+			Set("version", sq.Expr("version + 1")).
+			Where(scope.tasks(sq.Eq{
+				"deleted_at": nil,
+				"id":         id,
+			})).
+			Suffix("RETURNING \"id\", \"value\", \"completed\", \"due_date\", \"created_at\", \"updated_at\", \"deleted_at\", \"version\", \"parent_id\", \"priority\", \"position\", \"recurrence\"").
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		if err := tx.QueryRowContext(ctx, query, args...).Scan(
+			&task.Id,
+			&task.Value,
+			&task.Completed,
+			&task.DueDate,
+			&task.CreatedAt,
+			&task.UpdatedAt,
+			&task.DeletedAt,
+			&task.Version,
+			&task.ParentId,
+			&task.Priority,
+			&task.Position,
+			&task.Recurrence,
+		); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrTaskNotFound
+			}
+			return err
+		}
+
+		return insertEvent(ctx, tx, model.NewTaskEvent(model.EventTaskUpdated, &task))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &task, nil
+}
+
+// errNoRoomBetweenPositions the positions around the target are too close to fit a task between them
+var errNoRoomBetweenPositions = errors.New("no room between positions")
+
+// getMovePosition find the position right before or after the target task, ignoring the task that is moved.
+// Only the tasks of the scope are neighbours, the positions of the others do not matter to it.
+func getMovePosition(ctx context.Context, tx storage.Tx, scope scope, id uuid.UUID, targetId uuid.UUID, after bool) (float64, error) {
+	query, args, err := psql.
+		Select("position").
+		From("tasks").
+		Where(scope.tasks(sq.Eq{
+			"deleted_at": nil,
+			"id":         targetId,
+		})).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var target float64
+	if err := tx.QueryRowContext(ctx, query, args...).Scan(&target); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, ErrMoveTargetNotFound
+		}
+		return 0, err
+	}
+
+	builder := psql.
+		Select("position").
+		From("tasks").
+		Where(scope.tasks(sq.Eq{"deleted_at": nil})).
+		Where(sq.NotEq{"id": id}).
+		Limit(limitOne)
+
+	step := positionStep
+	if after {
+		builder = builder.Where(sq.Gt{"position": target}).OrderBy("position ASC")
+	} else {
+		builder = builder.Where(sq.Lt{"position": target}).OrderBy("position DESC")
+		step = -positionStep
+	}
+
+	query, args, err = builder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var neighbour float64
+	if err := tx.QueryRowContext(ctx, query, args...).Scan(&neighbour); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return target + step, nil
+		}
+		return 0, err
+	}
+
+	position := target + (neighbour-target)/2
+	if position == target || position == neighbour {
+		return 0, errNoRoomBetweenPositions
+	}
+
+	return position, nil
+}
+
+// versionConflict tells why a conditional write of a task changed nothing, the task was deleted or is not found
+// when it has no non deleted row in the scope, otherwise it has another version
+func versionConflict(ctx context.Context, tx storage.Tx, scope scope, id uuid.UUID) error {
+	query, args, err := scope.task(id).ToSql()
+	if err != nil {
+		return err
+	}
+
+	var found uuid.UUID
+
+	if err := tx.QueryRowContext(ctx, query, args...).Scan(&found); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrTaskNotFound
+		}
+		return err
+	}
+
+	return ErrTaskVersionMismatch
+}
+
+// lockDeletedTask lock the row of a task in the trash of the scope and get when it was deleted
+func lockDeletedTask(ctx context.Context, tx storage.Tx, scope scope, id uuid.UUID) (time.Time, error) {
+	query, args, err := psql.
+		Select("deleted_at").
+		From("tasks").
+		Where(scope.tasks(sq.Eq{"id": id})).
+		Where(sq.NotEq{"deleted_at": nil}).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var deletedAt time.Time
+
+	if err := tx.QueryRowContext(ctx, query, args...).Scan(&deletedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return time.Time{}, ErrTaskNotFound
+		}
+		return time.Time{}, err
+	}
+
+	return deletedAt, nil
+}
+
+// lockParentTask lock the row of the parent of a task until the commit, so it cannot be deleted meanwhile, and fail
+// when the parent is in the trash. A task without parent has nothing to lock.
+func lockParentTask(ctx context.Context, tx storage.Tx, id uuid.UUID) error {
+	query, args, err := psql.
+		Select("deleted_at").
+		From("tasks").
+		Where("id = (SELECT parent_id FROM tasks WHERE id = ?)", id).
+		Suffix("FOR SHARE").
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	var deletedAt sql.NullTime
+
+	if err := tx.QueryRowContext(ctx, query, args...).Scan(&deletedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+
+	if deletedAt.Valid {
+		return ErrParentTaskDeleted
+	}
+
+	return nil
+}
+
+// GetTaskFilterConditions build the where clause for the non deleted tasks that match the filter
+func GetTaskFilterConditions(filter model.TaskFilter) sq.And {
+	conditions := sq.And{
+		sq.Eq{"deleted_at": nil},
+	}
+
+	if filter.Completed.Valid {
+		conditions = append(conditions, sq.Eq{"completed": filter.Completed.Bool})
+	}
+
+	if filter.DueBefore.Valid {
+		conditions = append(conditions, sq.Lt{"due_date": filter.DueBefore.Time})
+	}
+
+	if filter.DueAfter.Valid {
+		conditions = append(conditions, sq.Gt{"due_date": filter.DueAfter.Time})
+	}
+
+	if filter.CreatedAfter.Valid {
+		conditions = append(conditions, sq.GtOrEq{"created_at": filter.CreatedAfter.Time})
+	}
+
+	if filter.CreatedBefore.Valid {
+		conditions = append(conditions, sq.Lt{"created_at": filter.CreatedBefore.Time})
+	}
+
+	if filter.Label != "" {
+		conditions = append(conditions, sq.Expr(
+			"EXISTS (SELECT 1 FROM labels WHERE labels.task_id = tasks.id AND labels.deleted_at IS NULL AND labels.value = ?)",
+			filter.Label,
+		))
+	}
+
+	return conditions
+}
+
+// GetTaskCursorCondition build the where clause to get the tasks after the cursor in the filter direction
+func GetTaskCursorCondition(filter model.TaskFilter) sq.Sqlizer {
+	operator := ">"
+	if filter.SortDirection == model.SortDirectionDesc {
+		operator = "<"
+	}
+
+	return sq.Expr(
+		fmt.Sprintf("(created_at, id) %s (?, ?)", operator),
+		filter.After.CreatedAt,
+		filter.After.Id,
+	)
+}
+
+// GetTaskOrderBy build the order by clause from the filter, id is used as tie-breaker
+func GetTaskOrderBy(filter model.TaskFilter) []string {
+	column, ok := taskSortColumns[filter.SortBy]
+	if !ok {
+		column = taskSortColumns[model.TaskSortByCreatedAt]
+	}
+
+	direction := model.SortDirectionAsc
+	if filter.SortDirection == model.SortDirectionDesc {
+		direction = model.SortDirectionDesc
+	}
+
+	return []string{
+		fmt.Sprintf("%s %s NULLS LAST", column, direction),
+		fmt.Sprintf("id %s", direction),
+	}
 }
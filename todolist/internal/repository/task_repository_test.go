@@ -1,7 +1,6 @@
 package repository
 
 import (
-	"context"
 	"database/sql"
 	"errors"
 	"regexp"
@@ -15,6 +14,21 @@ import (
 	uuid "github.com/satori/go.uuid"
 )
 
+var taskColumns []string = []string{
+	"id",
+	"value",
+	"completed",
+	"due_date",
+	"created_at",
+	"updated_at",
+	"deleted_at",
+	"version",
+	"parent_id",
+	"priority",
+	"position",
+	"recurrence",
+}
+
 func TestGetTask(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -45,20 +59,25 @@ func TestGetTask(t *testing.T) {
 						due_date,
 						created_at,
 						updated_at,
-						deleted_at
+						deleted_at,
+						version,
+						parent_id,
+						priority,
+						position,
+						recurrence
 					`).
 					From("tasks").
-					Where(sq.Eq{
+					Where(callerScope.tasks(sq.Eq{
 						"deleted_at": nil,
 						"id":         task.Id,
-					}).
+					})).
 					Limit(limitOne).
 					ToSql()
 				if err != nil {
 					t.Fatalf("an error '%s' was not expected when creating a new query", err)
 				}
 
-				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(args[0]).WillReturnRows(sqlmock.NewRows(
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(driverArgs(args)...).WillReturnRows(sqlmock.NewRows(
 					[]string{
 						"id",
 						"value",
@@ -67,6 +86,11 @@ func TestGetTask(t *testing.T) {
 						"created_at",
 						"updated_at",
 						"deleted_at",
+						"version",
+						"parent_id",
+						"priority",
+						"position",
+						"recurrence",
 					},
 				).AddRow(
 					task.Id,
@@ -76,13 +100,18 @@ func TestGetTask(t *testing.T) {
 					task.CreatedAt,
 					task.UpdatedAt,
 					task.DeletedAt,
+					task.Version,
+					task.ParentId,
+					task.Priority,
+					task.Position,
+					task.Recurrence,
 				))
 
 				mock.ExpectCommit()
 
 				svc := NewTaskRepository(db)
 
-				return svc.GetTask(context.Background(), task.Id)
+				return svc.GetTask(callerContext(), task.Id)
 			},
 			expect: nil,
 		},
@@ -110,20 +139,25 @@ func TestGetTask(t *testing.T) {
 						due_date,
 						created_at,
 						updated_at,
-						deleted_at
+						deleted_at,
+						version,
+						parent_id,
+						priority,
+						position,
+						recurrence
 					`).
 					From("tasks").
-					Where(sq.Eq{
+					Where(callerScope.tasks(sq.Eq{
 						"deleted_at": nil,
 						"id":         task.Id,
-					}).
+					})).
 					Limit(limitOne).
 					ToSql()
 				if err != nil {
 					t.Fatalf("an error '%s' was not expected when creating a new query", err)
 				}
 
-				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(args[0]).WillReturnRows(sqlmock.NewRows(
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(driverArgs(args)...).WillReturnRows(sqlmock.NewRows(
 					[]string{
 						"id",
 						"value",
@@ -132,6 +166,11 @@ func TestGetTask(t *testing.T) {
 						"created_at",
 						"updated_at",
 						"deleted_at",
+						"version",
+						"parent_id",
+						"priority",
+						"position",
+						"recurrence",
 					},
 				).AddRow(
 					task.Id,
@@ -141,11 +180,16 @@ func TestGetTask(t *testing.T) {
 					task.CreatedAt,
 					task.UpdatedAt,
 					task.DeletedAt,
+					task.Version,
+					task.ParentId,
+					task.Priority,
+					task.Position,
+					task.Recurrence,
 				))
 
 				svc := NewTaskRepository(db)
 
-				return svc.GetTask(context.Background(), task.Id)
+				return svc.GetTask(callerContext(), task.Id)
 			},
 			expect: nil,
 		},
@@ -173,23 +217,28 @@ func TestGetTask(t *testing.T) {
 						due_date,
 						created_at,
 						updated_at,
-						deleted_at
+						deleted_at,
+						version,
+						parent_id,
+						priority,
+						position,
+						recurrence
 					`).
 					From("tasks").
-					Where(sq.Eq{
+					Where(callerScope.tasks(sq.Eq{
 						"deleted_at": nil,
 						"id":         task.Id,
-					}).
+					})).
 					Limit(limitOne).
 					ToSql()
 				if err != nil {
 					t.Fatalf("an error '%s' was not expected when creating a new query", err)
 				}
 
-				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(args[0]).WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(driverArgs(args)...).WillReturnError(sql.ErrNoRows)
 
 				svc := NewTaskRepository(db)
-				return svc.GetTask(context.Background(), task.Id)
+				return svc.GetTask(callerContext(), task.Id)
 			},
 			expect: ErrTaskNotFound,
 		},
@@ -235,14 +284,21 @@ func TestGetTasks(t *testing.T) {
 						due_date,
 						created_at,
 						updated_at,
-						deleted_at
+						deleted_at,
+						version,
+						parent_id,
+						priority,
+						position,
+						recurrence
 					`).
 					From("tasks").
-					Where(sq.Eq{
-						"deleted_at": nil,
+					Where(sq.And{
+						sq.Eq{"deleted_at": nil},
 					}).
-					Limit(limitPage).
-					Offset(GetOffset(1, limitPage)).
+					Where(callerScope.tasks(sq.Eq{})).
+					OrderBy("created_at ASC NULLS LAST", "id ASC").
+					Limit(DefaultPageSize).
+					Offset(GetOffset(1, DefaultPageSize)).
 					ToSql()
 				if err != nil {
 					t.Fatalf("an error '%s' was not expected when creating a new query", err)
@@ -257,6 +313,11 @@ func TestGetTasks(t *testing.T) {
 						"created_at",
 						"updated_at",
 						"deleted_at",
+						"version",
+						"parent_id",
+						"priority",
+						"position",
+						"recurrence",
 					},
 				).AddRow(
 					task.Id,
@@ -266,13 +327,18 @@ func TestGetTasks(t *testing.T) {
 					task.CreatedAt,
 					task.UpdatedAt,
 					task.DeletedAt,
+					task.Version,
+					task.ParentId,
+					task.Priority,
+					task.Position,
+					task.Recurrence,
 				))
 
 				mock.ExpectCommit()
 
 				svc := NewTaskRepository(db)
 
-				return svc.GetTasks(context.Background(), 1)
+				return svc.GetTasks(callerContext(), model.TaskFilter{Page: 1})
 			},
 			expect: nil,
 		},
@@ -300,14 +366,21 @@ func TestGetTasks(t *testing.T) {
 						due_date,
 						created_at,
 						updated_at,
-						deleted_at
+						deleted_at,
+						version,
+						parent_id,
+						priority,
+						position,
+						recurrence
 					`).
 					From("tasks").
-					Where(sq.Eq{
-						"deleted_at": nil,
+					Where(sq.And{
+						sq.Eq{"deleted_at": nil},
 					}).
-					Limit(limitPage).
-					Offset(GetOffset(1, limitPage)).
+					Where(callerScope.tasks(sq.Eq{})).
+					OrderBy("created_at ASC NULLS LAST", "id ASC").
+					Limit(DefaultPageSize).
+					Offset(GetOffset(1, DefaultPageSize)).
 					ToSql()
 				if err != nil {
 					t.Fatalf("an error '%s' was not expected when creating a new query", err)
@@ -322,6 +395,11 @@ func TestGetTasks(t *testing.T) {
 						"created_at",
 						"updated_at",
 						"deleted_at",
+						"version",
+						"parent_id",
+						"priority",
+						"position",
+						"recurrence",
 					},
 				).AddRow(
 					task.Id,
@@ -331,11 +409,159 @@ func TestGetTasks(t *testing.T) {
 					task.CreatedAt,
 					task.UpdatedAt,
 					task.DeletedAt,
+					task.Version,
+					task.ParentId,
+					task.Priority,
+					task.Position,
+					task.Recurrence,
+				))
+
+				svc := NewTaskRepository(db)
+
+				return svc.GetTasks(callerContext(), model.TaskFilter{Page: 1})
+			},
+			expect: nil,
+		},
+		{
+			name: "GetTasks_SuccessWithFilter",
+			input: func() ([]*model.Task, error) {
+				db, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+				}
+				defer db.Close()
+
+				filter := model.TaskFilter{
+					Page:          2,
+					Completed:     sql.NullBool{Bool: true, Valid: true},
+					DueAfter:      sql.NullTime{Time: time.Now(), Valid: true},
+					Label:         "work",
+					SortBy:        model.TaskSortByDueDate,
+					SortDirection: model.SortDirectionDesc,
+				}
+
+				query, args, err := psql.
+					Select(`
+						id,
+						value,
+						completed,
+						due_date,
+						created_at,
+						updated_at,
+						deleted_at,
+						version,
+						parent_id,
+						priority,
+						position,
+						recurrence
+					`).
+					From("tasks").
+					Where(sq.And{
+						sq.Eq{"deleted_at": nil},
+						sq.Eq{"completed": filter.Completed.Bool},
+						sq.Gt{"due_date": filter.DueAfter.Time},
+						sq.Expr(
+							"EXISTS (SELECT 1 FROM labels WHERE labels.task_id = tasks.id AND labels.deleted_at IS NULL AND labels.value = ?)",
+							filter.Label,
+						),
+					}).
+					Where(callerScope.tasks(sq.Eq{})).
+					OrderBy("due_date DESC NULLS LAST", "id DESC").
+					Limit(DefaultPageSize).
+					Offset(GetOffset(filter.Page, DefaultPageSize)).
+					ToSql()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when creating a new query", err)
+				}
+
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(driverArgs(args)...).WillReturnRows(sqlmock.NewRows(
+					[]string{
+						"id",
+						"value",
+						"completed",
+						"due_date",
+						"created_at",
+						"updated_at",
+						"deleted_at",
+						"version",
+						"parent_id",
+						"priority",
+						"position",
+						"recurrence",
+					},
+				))
+
+				svc := NewTaskRepository(db)
+
+				return svc.GetTasks(callerContext(), filter)
+			},
+			expect: nil,
+		},
+		{
+			name: "GetTasks_SuccessWithCursor",
+			input: func() ([]*model.Task, error) {
+				db, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+				}
+				defer db.Close()
+
+				filter := model.TaskFilter{
+					Limit: 11,
+					After: &model.TaskCursor{
+						CreatedAt: time.Now(),
+						Id:        uuid.NewV4(),
+					},
+				}
+
+				query, args, err := psql.
+					Select(`
+						id,
+						value,
+						completed,
+						due_date,
+						created_at,
+						updated_at,
+						deleted_at,
+						version,
+						parent_id,
+						priority,
+						position,
+						recurrence
+					`).
+					From("tasks").
+					Where(sq.And{
+						sq.Eq{"deleted_at": nil},
+					}).
+					Where(callerScope.tasks(sq.Eq{})).
+					OrderBy("created_at ASC NULLS LAST", "id ASC").
+					Where(sq.Expr("(created_at, id) > (?, ?)", filter.After.CreatedAt, filter.After.Id)).
+					Limit(filter.Limit).
+					ToSql()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when creating a new query", err)
+				}
+
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(driverArgs(args)...).WillReturnRows(sqlmock.NewRows(
+					[]string{
+						"id",
+						"value",
+						"completed",
+						"due_date",
+						"created_at",
+						"updated_at",
+						"deleted_at",
+						"version",
+						"parent_id",
+						"priority",
+						"position",
+						"recurrence",
+					},
 				))
 
 				svc := NewTaskRepository(db)
 
-				return svc.GetTasks(context.Background(), 1)
+				return svc.GetTasks(callerContext(), filter)
 			},
 			expect: nil,
 		},
@@ -356,14 +582,21 @@ func TestGetTasks(t *testing.T) {
 						due_date,
 						created_at,
 						updated_at,
-						deleted_at
+						deleted_at,
+						version,
+						parent_id,
+						priority,
+						position,
+						recurrence
 					`).
 					From("tasks").
-					Where(sq.Eq{
-						"deleted_at": nil,
+					Where(sq.And{
+						sq.Eq{"deleted_at": nil},
 					}).
-					Limit(limitPage).
-					Offset(GetOffset(1, limitPage)).
+					Where(callerScope.tasks(sq.Eq{})).
+					OrderBy("created_at ASC NULLS LAST", "id ASC").
+					Limit(DefaultPageSize).
+					Offset(GetOffset(1, DefaultPageSize)).
 					ToSql()
 				if err != nil {
 					t.Fatalf("an error '%s' was not expected when creating a new query", err)
@@ -372,7 +605,7 @@ func TestGetTasks(t *testing.T) {
 				mock.ExpectQuery(regexp.QuoteMeta(query)).WillReturnError(sql.ErrNoRows)
 
 				svc := NewTaskRepository(db)
-				return svc.GetTasks(context.Background(), 1)
+				return svc.GetTasks(callerContext(), model.TaskFilter{Page: 1})
 			},
 			expect: sql.ErrNoRows,
 		},
@@ -388,9 +621,192 @@ func TestGetTasks(t *testing.T) {
 	}
 }
 
+func TestCountTasks(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  func() (int64, error)
+		expect error
+	}{
+		{
+			name: "CountTasks_Success",
+			input: func() (int64, error) {
+				db, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+				}
+				defer db.Close()
+
+				filter := model.TaskFilter{
+					Completed: sql.NullBool{Bool: false, Valid: true},
+				}
+
+				query, args, err := psql.
+					Select("COUNT(*)").
+					From("tasks").
+					Where(sq.And{
+						sq.Eq{"deleted_at": nil},
+						sq.Eq{"completed": filter.Completed.Bool},
+					}).
+					Where(callerScope.tasks(sq.Eq{})).
+					ToSql()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when creating a new query", err)
+				}
+
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(driverArgs(args)...).WillReturnRows(
+					sqlmock.NewRows([]string{"count"}).AddRow(42),
+				)
+
+				svc := NewTaskRepository(db)
+
+				return svc.CountTasks(callerContext(), filter)
+			},
+			expect: nil,
+		},
+		{
+			name: "CountTasks_ErrConnDone",
+			input: func() (int64, error) {
+				db, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+				}
+				defer db.Close()
+
+				query, _, err := psql.
+					Select("COUNT(*)").
+					From("tasks").
+					Where(sq.And{
+						sq.Eq{"deleted_at": nil},
+					}).
+					Where(callerScope.tasks(sq.Eq{})).
+					ToSql()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when creating a new query", err)
+				}
+
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WillReturnError(sql.ErrConnDone)
+
+				svc := NewTaskRepository(db)
+
+				return svc.CountTasks(callerContext(), model.TaskFilter{})
+			},
+			expect: sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.input()
+			if err != tt.expect {
+				t.Errorf("expect values are equals, but got diferent, output: %v, expect: %v", err, tt.expect)
+			}
+		})
+	}
+}
+
+func TestSearchTasks(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  func() ([]*model.TaskSearchResult, error)
+		expect error
+		count  int
+	}{
+		{
+			name: "SearchTasks_Success",
+			input: func() ([]*model.TaskSearchResult, error) {
+				db, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+				}
+				defer db.Close()
+
+				search := model.TaskSearch{
+					Query: "report",
+					Page:  1,
+				}
+
+				task := model.Task{
+					Id:        uuid.NewV4(),
+					Value:     "monthly report",
+					CreatedAt: time.Now(),
+				}
+
+				// The snippets are highlighted in the escaped text, the markup of the tasks is never returned as is
+				mock.ExpectQuery("(?s)"+regexp.QuoteMeta("WITH search AS")+".*"+regexp.QuoteMeta(`ts_headline('english', replace(replace(replace(replace(tasks.value, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;')`)).
+					WithArgs(search.Query, caller.Subject, caller.TenantId, DefaultPageSize, GetOffset(search.Page, DefaultPageSize)).
+					WillReturnRows(sqlmock.NewRows(
+						[]string{
+							"id",
+							"value",
+							"completed",
+							"due_date",
+							"created_at",
+							"updated_at",
+							"deleted_at",
+							"version",
+							"parent_id",
+							"priority",
+							"position",
+							"recurrence",
+							"rank",
+							"source",
+							"snippet",
+						},
+					).AddRow(
+						task.Id, task.Value, task.Completed, task.DueDate, task.CreatedAt, task.UpdatedAt, task.DeletedAt, task.Version, task.ParentId, task.Priority, task.Position, task.Recurrence,
+						0.6, model.SearchSourceTask, "monthly <mark>report</mark>",
+					).AddRow(
+						task.Id, task.Value, task.Completed, task.DueDate, task.CreatedAt, task.UpdatedAt, task.DeletedAt, task.Version, task.ParentId, task.Priority, task.Position, task.Recurrence,
+						0.6, model.SearchSourceComment, "<mark>report</mark> sent",
+					).AddRow(
+						uuid.NewV4(), task.Value, task.Completed, task.DueDate, task.CreatedAt, task.UpdatedAt, task.DeletedAt, task.Version, task.ParentId, task.Priority, task.Position, task.Recurrence,
+						0.1, model.SearchSourceLabel, "<mark>report</mark>",
+					))
+
+				svc := NewTaskRepository(db)
+
+				return svc.SearchTasks(callerContext(), search)
+			},
+			expect: nil,
+			count:  2,
+		},
+		{
+			name: "SearchTasks_ErrConnDone",
+			input: func() ([]*model.TaskSearchResult, error) {
+				db, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+				}
+				defer db.Close()
+
+				mock.ExpectQuery(regexp.QuoteMeta("WITH search AS")).WillReturnError(sql.ErrConnDone)
+
+				svc := NewTaskRepository(db)
+
+				return svc.SearchTasks(callerContext(), model.TaskSearch{Query: "report"})
+			},
+			expect: sql.ErrConnDone,
+			count:  0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := tt.input()
+			if err != tt.expect {
+				t.Errorf("expect values are equals, but got diferent, output: %v, expect: %v", err, tt.expect)
+			}
+			if len(results) != tt.count {
+				t.Errorf("expect %d results, but got %d", tt.count, len(results))
+			}
+		})
+	}
+}
+
 func TestCreateTask(t *testing.T) {
 	var (
 		errCannotCreateTransaction error = errors.New("cannot create transaction")
+		errCannotInsertTask        error = errors.New("cannot insert task")
 	)
 
 	tests := []struct {
@@ -416,9 +832,9 @@ func TestCreateTask(t *testing.T) {
 
 				query, args, err := psql.
 					Insert("tasks").
-					Columns("value", "due_date").
-					Values(task.Value, task.DueDate).
-					Suffix("RETURNING \"id\", \"value\", \"completed\", \"due_date\", \"created_at\", \"updated_at\", \"deleted_at\"").
+					Columns("value", "due_date", "parent_id", "priority", "position", "recurrence", "owner_id", "tenant_id").
+					Values(task.Value, task.DueDate, task.ParentId, task.Priority, sq.Expr(lastPositionQuery, positionStep, callerScope.ownerId, callerScope.tenantId), task.Recurrence, callerScope.ownerId, callerScope.tenantId).
+					Suffix("RETURNING \"id\", \"value\", \"completed\", \"due_date\", \"created_at\", \"updated_at\", \"deleted_at\", \"version\", \"parent_id\", \"priority\", \"position\", \"recurrence\"").
 					ToSql()
 				if err != nil {
 					t.Fatalf("an error '%s' was not expected when creating a new query", err)
@@ -426,7 +842,7 @@ func TestCreateTask(t *testing.T) {
 
 				mock.ExpectBegin()
 
-				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(args[0], args[1]).WillReturnRows(sqlmock.NewRows(
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(driverArgs(args)...).WillReturnRows(sqlmock.NewRows(
 					[]string{
 						"id",
 						"value",
@@ -435,6 +851,11 @@ func TestCreateTask(t *testing.T) {
 						"created_at",
 						"updated_at",
 						"deleted_at",
+						"version",
+						"parent_id",
+						"priority",
+						"position",
+						"recurrence",
 					},
 				).AddRow(
 					task.Id,
@@ -444,13 +865,20 @@ func TestCreateTask(t *testing.T) {
 					task.CreatedAt,
 					task.UpdatedAt,
 					task.DeletedAt,
+					task.Version,
+					task.ParentId,
+					task.Priority,
+					task.Position,
+					task.Recurrence,
 				))
 
+				expectInsertEvent(mock, model.EventTaskCreated, task.Id)
+
 				mock.ExpectCommit()
 
 				svc := NewTaskRepository(db)
 
-				return svc.CreateTask(context.Background(), task)
+				return svc.CreateTask(callerContext(), task)
 			},
 			expect: nil,
 		},
@@ -474,10 +902,40 @@ func TestCreateTask(t *testing.T) {
 
 				svc := NewTaskRepository(db)
 
-				return svc.CreateTask(context.Background(), task)
+				return svc.CreateTask(callerContext(), task)
 			},
 			expect: errCannotCreateTransaction,
 		},
+		{
+			name: "CreateTask_ErrInsert_Rollback",
+			input: func() (*model.Task, error) {
+				db, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+				}
+				defer db.Close()
+
+				task := model.Task{
+					Value: uuid.NewV4().String(),
+				}
+
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO tasks")).WillReturnError(errCannotInsertTask)
+				mock.ExpectRollback()
+
+				svc := NewTaskRepository(db)
+
+				created, err := svc.CreateTask(callerContext(), task)
+
+				// the task is not committed when it cannot be read back
+				if err := mock.ExpectationsWereMet(); err != nil {
+					t.Errorf("there were unfulfilled expectations: %s", err)
+				}
+
+				return created, err
+			},
+			expect: errCannotInsertTask,
+		},
 	}
 
 	for _, tt := range tests {
@@ -526,27 +984,26 @@ func TestUpdateTask(t *testing.T) {
 					Set("completed", task.Completed).
 					Set("due_date", task.DueDate).
 					Set("updated_at", task.UpdatedAt).
-					Where(sq.Eq{
+					Set("version", sq.Expr("version + 1")).
+					Where(callerScope.tasks(sq.Eq{
 						"deleted_at": nil,
 						"id":         task.Id,
-					}).
+						"version":    task.Version,
+					})).
+					Suffix("RETURNING \"version\", \"updated_at\"").
 					ToSql()
 				if err != nil {
 					t.Fatalf("an error '%s' was not expected when creating a new query", err)
 				}
 
+				mock.ExpectBegin()
 				mock.ExpectQuery(regexp.QuoteMeta(query)).
-					WithArgs(
-						args[0],
-						args[1],
-						args[2],
-						args[3],
-						args[4],
-					).
+					WithArgs(driverArgs(args)...).
 					WillReturnError(sql.ErrConnDone)
+				mock.ExpectRollback()
 
 				svc := NewTaskRepository(db)
-				return svc.UpdateTask(context.Background(), &task)
+				return svc.UpdateTask(callerContext(), &task, model.TaskUpdate{})
 			},
 			expect: sql.ErrConnDone,
 		},
@@ -580,32 +1037,32 @@ func TestUpdateTask(t *testing.T) {
 					Set("completed", task.Completed).
 					Set("due_date", task.DueDate).
 					Set("updated_at", task.DeletedAt.Time).
-					Where(sq.Eq{
+					Set("version", sq.Expr("version + 1")).
+					Where(callerScope.tasks(sq.Eq{
 						"deleted_at": nil,
 						"id":         task.Id,
-					}).
+						"version":    task.Version,
+					})).
+					Suffix("RETURNING \"version\", \"updated_at\"").
 					ToSql()
 				if err != nil {
 					t.Fatalf("an error '%s' was not expected when creating a new query", err)
 				}
 
+				mock.ExpectBegin()
 				mock.ExpectQuery(regexp.QuoteMeta(query)).
-					WithArgs(
-						args[0],
-						args[1],
-						args[2],
-						args[3],
-						args[4],
-					).
-					WillReturnRows()
+					WithArgs(driverArgs(args)...).
+					WillReturnRows(sqlmock.NewRows([]string{"version", "updated_at"}).AddRow(task.Version+1, time.Now()))
+				expectInsertEvent(mock, model.EventTaskUpdated, task.Id)
+				mock.ExpectCommit()
 
 				svc := NewTaskRepository(db)
-				return svc.UpdateTask(context.Background(), &task)
+				return svc.UpdateTask(callerContext(), &task, model.TaskUpdate{})
 			},
 			expect: nil,
 		},
 		{
-			name: "UpdateTask_ErrTaskNotFound",
+			name: "UpdateTask_ErrTaskVersionMismatch",
 			input: func() error {
 				db, mock, err := sqlmock.New()
 				if err != nil {
@@ -634,50 +1091,34 @@ func TestUpdateTask(t *testing.T) {
 					Set("completed", task.Completed).
 					Set("due_date", task.DueDate).
 					Set("updated_at", task.DeletedAt.Time).
-					Where(sq.Eq{
+					Set("version", sq.Expr("version + 1")).
+					Where(callerScope.tasks(sq.Eq{
 						"deleted_at": nil,
 						"id":         task.Id,
-					}).
+						"version":    task.Version,
+					})).
+					Suffix("RETURNING \"version\", \"updated_at\"").
 					ToSql()
 				if err != nil {
 					t.Fatalf("an error '%s' was not expected when creating a new query", err)
 				}
 
+				mock.ExpectBegin()
 				mock.ExpectQuery(regexp.QuoteMeta(query)).
-					WithArgs(
-						args[0],
-						args[1],
-						args[2],
-						args[3],
-						args[4],
-					).
+					WithArgs(driverArgs(args)...).
 					WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT id FROM tasks WHERE deleted_at IS NULL AND id = $1 AND owner_id = $2 AND tenant_id = $3")).
+					WithArgs(task.Id, callerScope.ownerId, callerScope.tenantId).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(task.Id))
+				mock.ExpectRollback()
 
 				svc := NewTaskRepository(db)
-				return svc.UpdateTask(context.Background(), &task)
+				return svc.UpdateTask(callerContext(), &task, model.TaskUpdate{})
 			},
-			expect: ErrTaskNotFound,
+			expect: ErrTaskVersionMismatch,
 		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			err := tt.input()
-			if err != tt.expect {
-				t.Errorf("expect values are equals, but got diferent, output: %v, expect: %v", err, tt.expect)
-			}
-		})
-	}
-}
-
-func TestDeleteTask(t *testing.T) {
-	tests := []struct {
-		name   string
-		input  func() error
-		expect error
-	}{
 		{
-			name: "DeleteTask_ErrNoRows",
+			name: "UpdateTask_ErrTaskNotFound",
 			input: func() error {
 				db, mock, err := sqlmock.New()
 				if err != nil {
@@ -685,9 +1126,222 @@ func TestDeleteTask(t *testing.T) {
 				}
 				defer db.Close()
 
+				timeNow := time.Now()
+
+				monkey.Patch(time.Now, func() time.Time { return timeNow })
+
 				task := model.Task{
-					Id:        uuid.NewV4(),
-					Value:     uuid.NewV4().String(),
+					Id:      uuid.NewV4(),
+					Value:   uuid.NewV4().String(),
+					Version: 2,
+				}
+
+				query, args, err := psql.
+					Update("tasks").
+					Set("value", task.Value).
+					Set("updated_at", timeNow).
+					Set("version", sq.Expr("version + 1")).
+					Where(callerScope.tasks(sq.Eq{
+						"deleted_at": nil,
+						"id":         task.Id,
+						"version":    task.Version,
+					})).
+					Suffix("RETURNING \"version\", \"updated_at\"").
+					ToSql()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when creating a new query", err)
+				}
+
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs(driverArgs(args)...).
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT id FROM tasks WHERE deleted_at IS NULL AND id = $1 AND owner_id = $2 AND tenant_id = $3")).
+					WithArgs(task.Id, callerScope.ownerId, callerScope.tenantId).
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+
+				svc := NewTaskRepository(db)
+				return svc.UpdateTask(callerContext(), &task, model.TaskUpdate{Fields: []string{model.TaskFieldValue}})
+			},
+			expect: ErrTaskNotFound,
+		},
+		{
+			name: "UpdateTask_SuccessWithFields",
+			input: func() error {
+				db, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+				}
+				defer db.Close()
+
+				timeNow := time.Now()
+
+				monkey.Patch(time.Now, func() time.Time { return timeNow })
+
+				task := model.Task{
+					Id:    uuid.NewV4(),
+					Value: uuid.NewV4().String(),
+				}
+
+				query, args, err := psql.
+					Update("tasks").
+					Set("value", task.Value).
+					Set("updated_at", timeNow).
+					Set("version", sq.Expr("version + 1")).
+					Where(callerScope.tasks(sq.Eq{
+						"deleted_at": nil,
+						"id":         task.Id,
+						"version":    task.Version,
+					})).
+					Suffix("RETURNING \"version\", \"updated_at\"").
+					ToSql()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when creating a new query", err)
+				}
+
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs(driverArgs(args)...).
+					WillReturnRows(sqlmock.NewRows([]string{"version", "updated_at"}).AddRow(task.Version+1, time.Now()))
+				expectInsertEvent(mock, model.EventTaskUpdated, task.Id)
+				mock.ExpectCommit()
+
+				svc := NewTaskRepository(db)
+				return svc.UpdateTask(callerContext(), &task, model.TaskUpdate{Fields: []string{model.TaskFieldValue}})
+			},
+			expect: nil,
+		},
+		{
+			name: "UpdateTask_ErrIncompleteSubtasks",
+			input: func() error {
+				db, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+				}
+				defer db.Close()
+
+				task := model.Task{
+					Id:        uuid.NewV4(),
+					Completed: true,
+					Version:   1,
+				}
+
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("UPDATE tasks SET completed = $1, updated_at = $2, version = version + 1 WHERE")).
+					WithArgs(true, sqlmock.AnyArg(), task.Id, caller.Subject, caller.TenantId, task.Version).
+					WillReturnRows(sqlmock.NewRows([]string{"version", "updated_at"}).AddRow(2, time.Now()))
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM subtasks WHERE depth > 1 AND NOT completed")).
+					WithArgs(task.Id, caller.Subject, caller.TenantId).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+				mock.ExpectRollback()
+
+				svc := NewTaskRepository(db)
+				err = svc.UpdateTask(callerContext(), &task, model.TaskUpdate{
+					Fields:            []string{model.TaskFieldCompleted},
+					SubtaskCompletion: model.SubtaskCompletionRequire,
+				})
+				if err := mock.ExpectationsWereMet(); err != nil {
+					t.Errorf("there were unfulfilled expectations: %s", err)
+				}
+				if task.Version != 1 {
+					t.Errorf("expect the version to be kept, but got %d", task.Version)
+				}
+				return err
+			},
+			expect: ErrIncompleteSubtasks,
+		},
+		{
+			name: "UpdateTask_SuccessCascadeSubtasks",
+			input: func() error {
+				db, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+				}
+				defer db.Close()
+
+				task := model.Task{
+					Id:        uuid.NewV4(),
+					Completed: true,
+					Version:   1,
+				}
+
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("UPDATE tasks SET completed = $1, updated_at = $2, version = version + 1 WHERE")).
+					WithArgs(true, sqlmock.AnyArg(), task.Id, caller.Subject, caller.TenantId, task.Version).
+					WillReturnRows(sqlmock.NewRows([]string{"version", "updated_at"}).AddRow(2, time.Now()))
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE tasks SET completed = $4, updated_at = $5, version = version + 1 WHERE id IN (SELECT id FROM subtasks WHERE depth > 1) AND completed = $6")).
+					WithArgs(task.Id, caller.Subject, caller.TenantId, true, sqlmock.AnyArg(), false).
+					WillReturnResult(sqlmock.NewResult(0, 3))
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT version FROM tasks WHERE id = $1")).
+					WithArgs(task.Id).
+					WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(5))
+				expectInsertEvent(mock, model.EventTaskUpdated, task.Id)
+				expectInsertEvent(mock, model.EventTaskCompleted, task.Id)
+				mock.ExpectCommit()
+
+				svc := NewTaskRepository(db)
+				if err := svc.UpdateTask(callerContext(), &task, model.TaskUpdate{
+					Fields:            []string{model.TaskFieldCompleted},
+					SubtaskCompletion: model.SubtaskCompletionCascade,
+				}); err != nil {
+					return err
+				}
+				if task.Version != 5 {
+					t.Errorf("expect the version after the subtasks were completed, but got %d", task.Version)
+				}
+				return mock.ExpectationsWereMet()
+			},
+			expect: nil,
+		},
+		{
+			name: "UpdateTask_ErrTaskInvalidField",
+			input: func() error {
+				db, _, err := sqlmock.New()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+				}
+				defer db.Close()
+
+				task := model.Task{
+					Id: uuid.NewV4(),
+				}
+
+				svc := NewTaskRepository(db)
+				return svc.UpdateTask(callerContext(), &task, model.TaskUpdate{Fields: []string{"created_at"}})
+			},
+			expect: ErrTaskInvalidField,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.input()
+			if err != tt.expect {
+				t.Errorf("expect values are equals, but got diferent, output: %v, expect: %v", err, tt.expect)
+			}
+		})
+	}
+}
+
+func TestDeleteTask(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  func() error
+		expect error
+	}{
+		{
+			name: "DeleteTask_ErrNoRows",
+			input: func() error {
+				db, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+				}
+				defer db.Close()
+
+				task := model.Task{
+					Id:        uuid.NewV4(),
+					Value:     uuid.NewV4().String(),
 					CreatedAt: time.Now(),
 					DeletedAt: sql.NullTime{
 						Time:  time.Now(),
@@ -700,17 +1354,20 @@ func TestDeleteTask(t *testing.T) {
 				query, args, err := psql.
 					Update("tasks").
 					Set("deleted_at", time.Now()).
-					Where(sq.Eq{
+					Set("version", sq.Expr("version + 1")).
+					Where(callerScope.tasks(sq.Eq{
 						"deleted_at": nil,
 						"id":         task.Id,
-					}).ToSql()
+					})).ToSql()
 				if err != nil {
 					t.Fatalf("an error '%s' was not expected when creating a new query", err)
 				}
-				mock.ExpectExec(regexp.QuoteMeta(query)).WithArgs(args[0], args[1]).WillReturnError(sql.ErrNoRows)
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(query)).WithArgs(driverArgs(args)...).WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
 
 				svc := NewTaskRepository(db)
-				return svc.DeleteTask(context.Background(), task.Id)
+				return svc.DeleteTask(callerContext(), task.Id, sql.NullInt64{})
 			},
 			expect: sql.ErrNoRows,
 		},
@@ -738,18 +1395,77 @@ func TestDeleteTask(t *testing.T) {
 				query, args, err := psql.
 					Update("tasks").
 					Set("deleted_at", time.Now()).
-					Where(sq.Eq{
+					Set("version", sq.Expr("version + 1")).
+					Where(callerScope.tasks(sq.Eq{
 						"deleted_at": nil,
 						"id":         task.Id,
-					}).ToSql()
+					})).ToSql()
 				if err != nil {
 					t.Fatalf("an error '%s' was not expected when creating a new query", err)
 				}
 
-				mock.ExpectExec(regexp.QuoteMeta(query)).WithArgs(args[0], args[1]).WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(query)).WithArgs(driverArgs(args)...).WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectQuery(regexp.QuoteMeta("UPDATE tasks SET deleted_at = $5, version = version + 1 WHERE id IN (SELECT id FROM subtree WHERE depth > 1) RETURNING \"id\"")).
+					WithArgs(task.Id, caller.Subject, caller.TenantId, nil, args[0]).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE comments SET deleted_at = $1 WHERE deleted_at IS NULL AND task_id IN ($2)")).
+					WithArgs(args[0], task.Id).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE labels SET deleted_at = $1 WHERE deleted_at IS NULL AND task_id IN ($2)")).
+					WithArgs(args[0], task.Id).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				expectInsertEvent(mock, model.EventTaskDeleted, task.Id)
+				mock.ExpectCommit()
+
+				svc := NewTaskRepository(db)
+				if err := svc.DeleteTask(callerContext(), task.Id, sql.NullInt64{}); err != nil {
+					return err
+				}
+
+				return mock.ExpectationsWereMet()
+			},
+			expect: nil,
+		},
+		{
+			name: "DeleteTask_Subtasks",
+			input: func() error {
+				db, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+				}
+				defer db.Close()
+
+				timeNow := time.Now()
+
+				monkey.Patch(time.Now, func() time.Time { return timeNow })
+
+				id, subtaskId, nestedId := uuid.NewV4(), uuid.NewV4(), uuid.NewV4()
+
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE tasks SET deleted_at = $1, version = version + 1 WHERE deleted_at IS NULL AND id = $2 AND owner_id = $3 AND tenant_id = $4")).
+					WithArgs(timeNow, id, caller.Subject, caller.TenantId).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(regexp.QuoteMeta("WITH RECURSIVE subtree AS")).
+					WithArgs(id, caller.Subject, caller.TenantId, nil, timeNow).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(subtaskId).AddRow(nestedId))
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE comments SET deleted_at = $1 WHERE deleted_at IS NULL AND task_id IN ($2,$3,$4)")).
+					WithArgs(timeNow, id, subtaskId, nestedId).
+					WillReturnResult(sqlmock.NewResult(0, 3))
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE labels SET deleted_at = $1 WHERE deleted_at IS NULL AND task_id IN ($2,$3,$4)")).
+					WithArgs(timeNow, id, subtaskId, nestedId).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				expectInsertEvent(mock, model.EventTaskDeleted, id)
+				expectInsertEvent(mock, model.EventTaskDeleted, subtaskId)
+				expectInsertEvent(mock, model.EventTaskDeleted, nestedId)
+				mock.ExpectCommit()
 
 				svc := NewTaskRepository(db)
-				return svc.DeleteTask(context.Background(), task.Id)
+				if err := svc.DeleteTask(callerContext(), id, sql.NullInt64{}); err != nil {
+					return err
+				}
+
+				return mock.ExpectationsWereMet()
 			},
 			expect: nil,
 		},
@@ -777,22 +1493,1084 @@ func TestDeleteTask(t *testing.T) {
 				query, args, err := psql.
 					Update("tasks").
 					Set("deleted_at", time.Now()).
-					Where(sq.Eq{
+					Set("version", sq.Expr("version + 1")).
+					Where(callerScope.tasks(sq.Eq{
 						"deleted_at": nil,
 						"id":         task.Id,
-					}).ToSql()
+					})).ToSql()
 				if err != nil {
 					t.Fatalf("an error '%s' was not expected when creating a new query", err)
 				}
 				row := sqlmock.NewResult(0, 0)
 
-				mock.ExpectExec(regexp.QuoteMeta(query)).WithArgs(args[0], args[1]).WillReturnResult(row)
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(query)).WithArgs(driverArgs(args)...).WillReturnResult(row)
+				mock.ExpectRollback()
 
 				svc := NewTaskRepository(db)
-				return svc.DeleteTask(context.Background(), task.Id)
+				return svc.DeleteTask(callerContext(), task.Id, sql.NullInt64{})
 			},
 			expect: ErrTaskNotFound,
 		},
+		{
+			name: "DeleteTask_ErrTaskVersionMismatch",
+			input: func() error {
+				db, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+				}
+				defer db.Close()
+
+				timeNow := time.Now()
+
+				monkey.Patch(time.Now, func() time.Time { return timeNow })
+
+				task := model.Task{
+					Id:      uuid.NewV4(),
+					Version: 3,
+				}
+
+				query, args, err := psql.
+					Update("tasks").
+					Set("deleted_at", timeNow).
+					Set("version", sq.Expr("version + 1")).
+					Where(callerScope.tasks(sq.Eq{
+						"deleted_at": nil,
+						"id":         task.Id,
+						"version":    task.Version,
+					})).ToSql()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when creating a new query", err)
+				}
+
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(query)).WithArgs(driverArgs(args)...).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT id FROM tasks WHERE deleted_at IS NULL AND id = $1 AND owner_id = $2 AND tenant_id = $3")).
+					WithArgs(task.Id, callerScope.ownerId, callerScope.tenantId).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(task.Id))
+				mock.ExpectRollback()
+
+				svc := NewTaskRepository(db)
+				return svc.DeleteTask(callerContext(), task.Id, sql.NullInt64{Int64: task.Version, Valid: true})
+			},
+			expect: ErrTaskVersionMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.input()
+			if err != tt.expect {
+				t.Errorf("expect values are equals, but got diferent, output: %v, expect: %v", err, tt.expect)
+			}
+		})
+	}
+}
+
+func TestGetDeletedTasks(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  func() ([]*model.Task, error)
+		expect error
+	}{
+		{
+			name: "GetDeletedTasks_Success",
+			input: func() ([]*model.Task, error) {
+				db, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+				}
+				defer db.Close()
+
+				task := model.Task{
+					Id:        uuid.NewV4(),
+					Value:     uuid.NewV4().String(),
+					CreatedAt: time.Now(),
+					DeletedAt: sql.NullTime{
+						Time:  time.Now(),
+						Valid: true,
+					},
+					Version: 2,
+				}
+
+				mock.ExpectQuery(regexp.QuoteMeta("FROM tasks WHERE deleted_at IS NOT NULL AND owner_id = $1 AND tenant_id = $2 ORDER BY deleted_at DESC, id DESC LIMIT 20 OFFSET 20")).
+					WillReturnRows(sqlmock.NewRows(
+						[]string{
+							"id",
+							"value",
+							"completed",
+							"due_date",
+							"created_at",
+							"updated_at",
+							"deleted_at",
+							"version",
+							"parent_id",
+							"priority",
+							"position",
+							"recurrence",
+						},
+					).AddRow(
+						task.Id,
+						task.Value,
+						task.Completed,
+						task.DueDate,
+						task.CreatedAt,
+						task.UpdatedAt,
+						task.DeletedAt,
+						task.Version,
+						task.ParentId,
+						task.Priority,
+						task.Position,
+						task.Recurrence,
+					))
+
+				svc := NewTaskRepository(db)
+				return svc.GetDeletedTasks(callerContext(), 2, 0)
+			},
+			expect: nil,
+		},
+		{
+			name: "GetDeletedTasks_ErrNoRows",
+			input: func() ([]*model.Task, error) {
+				db, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+				}
+				defer db.Close()
+
+				mock.ExpectQuery(regexp.QuoteMeta("FROM tasks WHERE deleted_at IS NOT NULL")).WillReturnError(sql.ErrNoRows)
+
+				svc := NewTaskRepository(db)
+				return svc.GetDeletedTasks(callerContext(), 1, 5)
+			},
+			expect: sql.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.input()
+			if err != tt.expect {
+				t.Errorf("expect values are equals, but got diferent, output: %v, expect: %v", err, tt.expect)
+			}
+		})
+	}
+}
+
+func TestRestoreTask(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  func() (*model.Task, error)
+		expect error
+	}{
+		{
+			name: "RestoreTask_Success",
+			input: func() (*model.Task, error) {
+				db, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+				}
+				defer db.Close()
+
+				task := model.Task{
+					Id:        uuid.NewV4(),
+					Value:     uuid.NewV4().String(),
+					CreatedAt: time.Now(),
+					Version:   3,
+				}
+				deletedAt := time.Now()
+
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT deleted_at FROM tasks WHERE id = $1 AND owner_id = $2 AND tenant_id = $3 AND deleted_at IS NOT NULL FOR UPDATE")).
+					WithArgs(task.Id, caller.Subject, caller.TenantId).
+					WillReturnRows(sqlmock.NewRows([]string{"deleted_at"}).AddRow(deletedAt))
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT deleted_at FROM tasks WHERE id = (SELECT parent_id FROM tasks WHERE id = $1) FOR SHARE")).
+					WithArgs(task.Id).
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery(regexp.QuoteMeta("UPDATE tasks SET deleted_at = $5, updated_at = $6, version = version + 1 WHERE id IN (SELECT id FROM subtree WHERE depth > 1) RETURNING")).
+					WithArgs(task.Id, caller.Subject, caller.TenantId, deletedAt, nil, sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows(taskColumns))
+				mock.ExpectQuery(regexp.QuoteMeta("UPDATE tasks SET deleted_at = $1, updated_at = $2, version = version + 1 WHERE id = $3 RETURNING")).
+					WithArgs(nil, sqlmock.AnyArg(), task.Id).
+					WillReturnRows(sqlmock.NewRows(
+						[]string{
+							"id",
+							"value",
+							"completed",
+							"due_date",
+							"created_at",
+							"updated_at",
+							"deleted_at",
+							"version",
+							"parent_id",
+							"priority",
+							"position",
+							"recurrence",
+						},
+					).AddRow(
+						task.Id,
+						task.Value,
+						task.Completed,
+						task.DueDate,
+						task.CreatedAt,
+						task.UpdatedAt,
+						task.DeletedAt,
+						task.Version,
+						task.ParentId,
+						task.Priority,
+						task.Position,
+						task.Recurrence,
+					))
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE comments SET deleted_at = $1 WHERE task_id IN ($2) AND deleted_at >= $3")).
+					WithArgs(nil, task.Id, deletedAt).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE labels SET deleted_at = $1 WHERE task_id IN ($2) AND deleted_at >= $3")).
+					WithArgs(nil, task.Id, deletedAt).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				expectInsertEvent(mock, model.EventTaskRestored, task.Id)
+				mock.ExpectCommit()
+
+				svc := NewTaskRepository(db)
+				restored, err := svc.RestoreTask(callerContext(), task.Id)
+				if err != nil {
+					return nil, err
+				}
+
+				if err := mock.ExpectationsWereMet(); err != nil {
+					t.Errorf("there were unfulfilled expectations: %s", err)
+				}
+
+				return restored, nil
+			},
+			expect: nil,
+		},
+		{
+			name: "RestoreTask_Subtasks",
+			input: func() (*model.Task, error) {
+				db, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+				}
+				defer db.Close()
+
+				deletedAt := time.Now()
+				task := model.Task{Id: uuid.NewV4(), Version: 3}
+				subtask := model.Task{Id: uuid.NewV4(), Version: 2, ParentId: uuid.NullUUID{UUID: task.Id, Valid: true}}
+
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT deleted_at FROM tasks WHERE id = $1 AND owner_id = $2 AND tenant_id = $3 AND deleted_at IS NOT NULL FOR UPDATE")).
+					WithArgs(task.Id, caller.Subject, caller.TenantId).
+					WillReturnRows(sqlmock.NewRows([]string{"deleted_at"}).AddRow(deletedAt))
+				mock.ExpectQuery(regexp.QuoteMeta("FOR SHARE")).
+					WithArgs(task.Id).
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery(regexp.QuoteMeta("WITH RECURSIVE subtree AS")).
+					WithArgs(task.Id, caller.Subject, caller.TenantId, deletedAt, nil, sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows(taskColumns).AddRow(
+						subtask.Id,
+						subtask.Value,
+						subtask.Completed,
+						subtask.DueDate,
+						subtask.CreatedAt,
+						subtask.UpdatedAt,
+						subtask.DeletedAt,
+						subtask.Version,
+						subtask.ParentId,
+						subtask.Priority,
+						subtask.Position,
+						subtask.Recurrence,
+					))
+				mock.ExpectQuery(regexp.QuoteMeta("UPDATE tasks SET deleted_at = $1, updated_at = $2, version = version + 1 WHERE id = $3 RETURNING")).
+					WithArgs(nil, sqlmock.AnyArg(), task.Id).
+					WillReturnRows(sqlmock.NewRows(taskColumns).AddRow(
+						task.Id,
+						task.Value,
+						task.Completed,
+						task.DueDate,
+						task.CreatedAt,
+						task.UpdatedAt,
+						task.DeletedAt,
+						task.Version,
+						task.ParentId,
+						task.Priority,
+						task.Position,
+						task.Recurrence,
+					))
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE comments SET deleted_at = $1 WHERE task_id IN ($2,$3) AND deleted_at >= $4")).
+					WithArgs(nil, task.Id, subtask.Id, deletedAt).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE labels SET deleted_at = $1 WHERE task_id IN ($2,$3) AND deleted_at >= $4")).
+					WithArgs(nil, task.Id, subtask.Id, deletedAt).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				expectInsertEvent(mock, model.EventTaskRestored, task.Id)
+				expectInsertEvent(mock, model.EventTaskRestored, subtask.Id)
+				mock.ExpectCommit()
+
+				svc := NewTaskRepository(db)
+				restored, err := svc.RestoreTask(callerContext(), task.Id)
+				if err != nil {
+					return nil, err
+				}
+
+				if err := mock.ExpectationsWereMet(); err != nil {
+					t.Errorf("there were unfulfilled expectations: %s", err)
+				}
+
+				return restored, nil
+			},
+			expect: nil,
+		},
+		{
+			name: "RestoreTask_ErrParentTaskDeleted",
+			input: func() (*model.Task, error) {
+				db, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+				}
+				defer db.Close()
+
+				id := uuid.NewV4()
+
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT deleted_at FROM tasks WHERE id = $1 AND owner_id = $2 AND tenant_id = $3 AND deleted_at IS NOT NULL FOR UPDATE")).
+					WithArgs(id, caller.Subject, caller.TenantId).
+					WillReturnRows(sqlmock.NewRows([]string{"deleted_at"}).AddRow(time.Now()))
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT deleted_at FROM tasks WHERE id = (SELECT parent_id FROM tasks WHERE id = $1) FOR SHARE")).
+					WithArgs(id).
+					WillReturnRows(sqlmock.NewRows([]string{"deleted_at"}).AddRow(time.Now()))
+				mock.ExpectRollback()
+
+				svc := NewTaskRepository(db)
+				return svc.RestoreTask(callerContext(), id)
+			},
+			expect: ErrParentTaskDeleted,
+		},
+		{
+			name: "RestoreTask_ErrTaskNotFound",
+			input: func() (*model.Task, error) {
+				db, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+				}
+				defer db.Close()
+
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT deleted_at FROM tasks")).WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+
+				svc := NewTaskRepository(db)
+				return svc.RestoreTask(callerContext(), uuid.NewV4())
+			},
+			expect: ErrTaskNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.input()
+			if err != tt.expect {
+				t.Errorf("expect values are equals, but got diferent, output: %v, expect: %v", err, tt.expect)
+			}
+		})
+	}
+}
+
+func TestPurgeTask(t *testing.T) {
+	var (
+		errCannotCreateTransaction error = errors.New("cannot create transaction")
+	)
+
+	tests := []struct {
+		name   string
+		input  func() error
+		expect error
+	}{
+		{
+			name: "PurgeTask_Success",
+			input: func() error {
+				db, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+				}
+				defer db.Close()
+
+				id := uuid.NewV4()
+				deletedAt := time.Now()
+
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT deleted_at FROM tasks WHERE id = $1 AND owner_id = $2 AND tenant_id = $3 AND deleted_at IS NOT NULL FOR UPDATE")).
+					WithArgs(id, caller.Subject, caller.TenantId).
+					WillReturnRows(sqlmock.NewRows([]string{"deleted_at"}).AddRow(deletedAt))
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT id FROM subtree")).
+					WithArgs(id, caller.Subject, caller.TenantId, deletedAt).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(id))
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM comments WHERE task_id IN ($1)")).WithArgs(id).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM labels WHERE task_id IN ($1)")).WithArgs(id).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM tasks WHERE id IN ($1)")).WithArgs(id).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+
+				svc := NewTaskRepository(db)
+				if err := svc.PurgeTask(callerContext(), id); err != nil {
+					return err
+				}
+
+				return mock.ExpectationsWereMet()
+			},
+			expect: nil,
+		},
+		{
+			name: "PurgeTask_Subtasks",
+			input: func() error {
+				db, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+				}
+				defer db.Close()
+
+				id, subtaskId := uuid.NewV4(), uuid.NewV4()
+				deletedAt := time.Now()
+
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT deleted_at FROM tasks WHERE id = $1 AND owner_id = $2 AND tenant_id = $3 AND deleted_at IS NOT NULL FOR UPDATE")).
+					WithArgs(id, caller.Subject, caller.TenantId).
+					WillReturnRows(sqlmock.NewRows([]string{"deleted_at"}).AddRow(deletedAt))
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT id FROM subtree")).
+					WithArgs(id, caller.Subject, caller.TenantId, deletedAt).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(id).AddRow(subtaskId))
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM comments WHERE task_id IN ($1,$2)")).WithArgs(id, subtaskId).WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM labels WHERE task_id IN ($1,$2)")).WithArgs(id, subtaskId).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM tasks WHERE id IN ($1,$2)")).WithArgs(id, subtaskId).WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectCommit()
+
+				svc := NewTaskRepository(db)
+				if err := svc.PurgeTask(callerContext(), id); err != nil {
+					return err
+				}
+
+				return mock.ExpectationsWereMet()
+			},
+			expect: nil,
+		},
+		{
+			name: "PurgeTask_ErrTaskNotFound",
+			input: func() error {
+				db, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+				}
+				defer db.Close()
+
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT deleted_at FROM tasks")).WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+
+				svc := NewTaskRepository(db)
+				return svc.PurgeTask(callerContext(), uuid.NewV4())
+			},
+			expect: ErrTaskNotFound,
+		},
+		{
+			name: "PurgeTask_ErrBeginTx",
+			input: func() error {
+				db, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+				}
+				defer db.Close()
+
+				mock.ExpectBegin().WillReturnError(errCannotCreateTransaction)
+
+				svc := NewTaskRepository(db)
+				return svc.PurgeTask(callerContext(), uuid.NewV4())
+			},
+			expect: errCannotCreateTransaction,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.input()
+			if err != tt.expect {
+				t.Errorf("expect values are equals, but got diferent, output: %v, expect: %v", err, tt.expect)
+			}
+		})
+	}
+}
+
+func TestPurgeDeletedTasks(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  func() (int64, error)
+		expect int64
+		err    error
+	}{
+		{
+			name: "PurgeDeletedTasks_Success",
+			input: func() (int64, error) {
+				db, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+				}
+				defer db.Close()
+
+				before := time.Now()
+
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM comments WHERE (deleted_at < $1 OR task_id IN (SELECT id FROM tasks WHERE deleted_at < $2))")).
+					WithArgs(before, before).
+					WillReturnResult(sqlmock.NewResult(0, 3))
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM labels WHERE (deleted_at < $1 OR task_id IN (SELECT id FROM tasks WHERE deleted_at < $2))")).
+					WithArgs(before, before).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM tasks WHERE deleted_at < $1")).
+					WithArgs(before).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+
+				svc := NewTaskRepository(db)
+				return svc.PurgeDeletedTasks(callerContext(), before)
+			},
+			expect: 6,
+			err:    nil,
+		},
+		{
+			name: "PurgeDeletedTasks_ErrRollback",
+			input: func() (int64, error) {
+				db, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+				}
+				defer db.Close()
+
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM comments")).WillReturnResult(sqlmock.NewResult(0, 3))
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM labels")).WillReturnError(sql.ErrConnDone)
+				mock.ExpectRollback()
+
+				svc := NewTaskRepository(db)
+				return svc.PurgeDeletedTasks(callerContext(), time.Now())
+			},
+			expect: 0,
+			err:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			purged, err := tt.input()
+			if err != tt.err {
+				t.Errorf("expect values are equals, but got diferent, output: %v, expect: %v", err, tt.err)
+			}
+			if purged != tt.expect {
+				t.Errorf("expect %d purged rows, but got %d", tt.expect, purged)
+			}
+		})
+	}
+}
+
+func TestGetSubtasks(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  func() ([]*model.Task, error)
+		expect error
+	}{
+		{
+			name: "GetSubtasks_Success",
+			input: func() ([]*model.Task, error) {
+				db, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+				}
+				defer db.Close()
+
+				task := model.Task{
+					Id:        uuid.NewV4(),
+					Value:     uuid.NewV4().String(),
+					CreatedAt: time.Now(),
+					ParentId:  uuid.NullUUID{UUID: uuid.NewV4(), Valid: true},
+				}
+
+				mock.ExpectQuery(regexp.QuoteMeta("FROM tasks WHERE deleted_at IS NULL AND owner_id = $1 AND parent_id = $2 AND tenant_id = $3 ORDER BY created_at, id")).
+					WithArgs(caller.Subject, task.ParentId.UUID, caller.TenantId).
+					WillReturnRows(sqlmock.NewRows(
+						[]string{
+							"id",
+							"value",
+							"completed",
+							"due_date",
+							"created_at",
+							"updated_at",
+							"deleted_at",
+							"version",
+							"parent_id",
+							"priority",
+							"position",
+							"recurrence",
+						},
+					).AddRow(
+						task.Id,
+						task.Value,
+						task.Completed,
+						task.DueDate,
+						task.CreatedAt,
+						task.UpdatedAt,
+						task.DeletedAt,
+						task.Version,
+						task.ParentId,
+						task.Priority,
+						task.Position,
+						task.Recurrence,
+					))
+
+				svc := NewTaskRepository(db)
+				return svc.GetSubtasks(callerContext(), task.ParentId.UUID)
+			},
+			expect: nil,
+		},
+		{
+			name: "GetSubtasks_ErrNoRows",
+			input: func() ([]*model.Task, error) {
+				db, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+				}
+				defer db.Close()
+
+				mock.ExpectQuery(regexp.QuoteMeta("FROM tasks WHERE deleted_at IS NULL AND owner_id = $1 AND parent_id = $2")).WillReturnError(sql.ErrNoRows)
+
+				svc := NewTaskRepository(db)
+				return svc.GetSubtasks(callerContext(), uuid.NewV4())
+			},
+			expect: sql.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.input()
+			if err != tt.expect {
+				t.Errorf("expect values are equals, but got diferent, output: %v, expect: %v", err, tt.expect)
+			}
+		})
+	}
+}
+
+func TestGetTaskAncestors(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  func() ([]uuid.UUID, error)
+		expect error
+	}{
+		{
+			name: "GetTaskAncestors_Success",
+			input: func() ([]uuid.UUID, error) {
+				db, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+				}
+				defer db.Close()
+
+				id, parentId := uuid.NewV4(), uuid.NewV4()
+
+				mock.ExpectQuery(regexp.QuoteMeta("WITH RECURSIVE ancestors AS")).
+					WithArgs(id, caller.Subject, caller.TenantId).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(id).AddRow(parentId))
+
+				svc := NewTaskRepository(db)
+				ancestors, err := svc.GetTaskAncestors(callerContext(), id)
+				if err != nil {
+					return nil, err
+				}
+
+				if len(ancestors) != 2 || ancestors[0] != id || ancestors[1] != parentId {
+					t.Errorf("expect the task and its parent, but got %v", ancestors)
+				}
+
+				return ancestors, nil
+			},
+			expect: nil,
+		},
+		{
+			name: "GetTaskAncestors_ErrTaskNotFound",
+			input: func() ([]uuid.UUID, error) {
+				db, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+				}
+				defer db.Close()
+
+				mock.ExpectQuery(regexp.QuoteMeta("WITH RECURSIVE ancestors AS")).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+				svc := NewTaskRepository(db)
+				return svc.GetTaskAncestors(callerContext(), uuid.NewV4())
+			},
+			expect: ErrTaskNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.input()
+			if err != tt.expect {
+				t.Errorf("expect values are equals, but got diferent, output: %v, expect: %v", err, tt.expect)
+			}
+		})
+	}
+}
+
+func TestGetSubtaskHeight(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  func() (int, error)
+		expect int
+		err    error
+	}{
+		{
+			name: "GetSubtaskHeight_Success",
+			input: func() (int, error) {
+				db, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+				}
+				defer db.Close()
+
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT COALESCE(MAX(depth), 0) FROM subtasks")).
+					WillReturnRows(sqlmock.NewRows([]string{"height"}).AddRow(3))
+
+				svc := NewTaskRepository(db)
+				return svc.GetSubtaskHeight(callerContext(), uuid.NewV4())
+			},
+			expect: 3,
+			err:    nil,
+		},
+		{
+			name: "GetSubtaskHeight_ErrTaskNotFound",
+			input: func() (int, error) {
+				db, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+				}
+				defer db.Close()
+
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT COALESCE(MAX(depth), 0) FROM subtasks")).
+					WillReturnRows(sqlmock.NewRows([]string{"height"}).AddRow(0))
+
+				svc := NewTaskRepository(db)
+				return svc.GetSubtaskHeight(callerContext(), uuid.NewV4())
+			},
+			expect: 0,
+			err:    ErrTaskNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			height, err := tt.input()
+			if err != tt.err {
+				t.Errorf("expect values are equals, but got diferent, output: %v, expect: %v", err, tt.err)
+			}
+			if height != tt.expect {
+				t.Errorf("expect height %d, but got %d", tt.expect, height)
+			}
+		})
+	}
+}
+
+func TestMoveTask(t *testing.T) {
+	var (
+		id       uuid.UUID = uuid.NewV4()
+		targetId uuid.UUID = uuid.NewV4()
+	)
+
+	expectMovedTask := func(mock sqlmock.Sqlmock, position float64) {
+		mock.ExpectQuery(regexp.QuoteMeta("UPDATE tasks SET position = $1, updated_at = $2, version = version + 1 WHERE deleted_at IS NULL AND id = $3 AND owner_id = $4 AND tenant_id = $5 RETURNING")).
+			WithArgs(position, sqlmock.AnyArg(), id, caller.Subject, caller.TenantId).
+			WillReturnRows(sqlmock.NewRows(
+				[]string{
+					"id",
+					"value",
+					"completed",
+					"due_date",
+					"created_at",
+					"updated_at",
+					"deleted_at",
+					"version",
+					"parent_id",
+					"priority",
+					"position",
+					"recurrence",
+				},
+			).AddRow(id, "task", false, nil, time.Now(), time.Now(), nil, 2, nil, model.TaskPriorityHigh, position, ""))
+		expectInsertEvent(mock, model.EventTaskUpdated, id)
+	}
+
+	tests := []struct {
+		name     string
+		input    func(db *sql.DB, mock sqlmock.Sqlmock) (*model.Task, error)
+		position float64
+		expect   error
+	}{
+		{
+			name: "MoveTask_SuccessBetweenTasks",
+			input: func(db *sql.DB, mock sqlmock.Sqlmock) (*model.Task, error) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT position FROM tasks WHERE deleted_at IS NULL AND id = $1 AND owner_id = $2 AND tenant_id = $3")).
+					WithArgs(targetId, caller.Subject, caller.TenantId).
+					WillReturnRows(sqlmock.NewRows([]string{"position"}).AddRow(1024.0))
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT position FROM tasks WHERE deleted_at IS NULL AND owner_id = $1 AND tenant_id = $2 AND id <> $3 AND position > $4 ORDER BY position ASC LIMIT 1")).
+					WithArgs(caller.Subject, caller.TenantId, id, 1024.0).
+					WillReturnRows(sqlmock.NewRows([]string{"position"}).AddRow(2048.0))
+				expectMovedTask(mock, 1536)
+				mock.ExpectCommit()
+
+				return NewTaskRepository(db).MoveTask(callerContext(), id, targetId, true)
+			},
+			position: 1536,
+			expect:   nil,
+		},
+		{
+			name: "MoveTask_SuccessFirstTask",
+			input: func(db *sql.DB, mock sqlmock.Sqlmock) (*model.Task, error) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT position FROM tasks WHERE deleted_at IS NULL AND id = $1 AND owner_id = $2 AND tenant_id = $3")).
+					WithArgs(targetId, caller.Subject, caller.TenantId).
+					WillReturnRows(sqlmock.NewRows([]string{"position"}).AddRow(1024.0))
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT position FROM tasks WHERE deleted_at IS NULL AND owner_id = $1 AND tenant_id = $2 AND id <> $3 AND position < $4 ORDER BY position DESC LIMIT 1")).
+					WithArgs(caller.Subject, caller.TenantId, id, 1024.0).
+					WillReturnError(sql.ErrNoRows)
+				expectMovedTask(mock, 0)
+				mock.ExpectCommit()
+
+				return NewTaskRepository(db).MoveTask(callerContext(), id, targetId, false)
+			},
+			position: 0,
+			expect:   nil,
+		},
+		{
+			name: "MoveTask_SuccessWithRebalance",
+			input: func(db *sql.DB, mock sqlmock.Sqlmock) (*model.Task, error) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT position FROM tasks WHERE deleted_at IS NULL AND id = $1 AND owner_id = $2 AND tenant_id = $3")).
+					WithArgs(targetId, caller.Subject, caller.TenantId).
+					WillReturnRows(sqlmock.NewRows([]string{"position"}).AddRow(1.0))
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT position FROM tasks WHERE deleted_at IS NULL AND owner_id = $1 AND tenant_id = $2 AND id <> $3 AND position > $4")).
+					WithArgs(caller.Subject, caller.TenantId, id, 1.0).
+					WillReturnRows(sqlmock.NewRows([]string{"position"}).AddRow(1.0000000000000002))
+				mock.ExpectExec(regexp.QuoteMeta("FROM tasks WHERE owner_id = $2 AND tenant_id = $3")).
+					WithArgs(positionStep, caller.Subject, caller.TenantId).
+					WillReturnResult(sqlmock.NewResult(0, 3))
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT position FROM tasks WHERE deleted_at IS NULL AND id = $1 AND owner_id = $2 AND tenant_id = $3")).
+					WithArgs(targetId, caller.Subject, caller.TenantId).
+					WillReturnRows(sqlmock.NewRows([]string{"position"}).AddRow(1024.0))
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT position FROM tasks WHERE deleted_at IS NULL AND owner_id = $1 AND tenant_id = $2 AND id <> $3 AND position > $4")).
+					WithArgs(caller.Subject, caller.TenantId, id, 1024.0).
+					WillReturnRows(sqlmock.NewRows([]string{"position"}).AddRow(2048.0))
+				expectMovedTask(mock, 1536)
+				mock.ExpectCommit()
+
+				return NewTaskRepository(db).MoveTask(callerContext(), id, targetId, true)
+			},
+			position: 1536,
+			expect:   nil,
+		},
+		{
+			name: "MoveTask_ErrMoveTargetNotFound",
+			input: func(db *sql.DB, mock sqlmock.Sqlmock) (*model.Task, error) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT position FROM tasks WHERE deleted_at IS NULL AND id = $1 AND owner_id = $2 AND tenant_id = $3")).
+					WithArgs(targetId, caller.Subject, caller.TenantId).
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+
+				return NewTaskRepository(db).MoveTask(callerContext(), id, targetId, true)
+			},
+			expect: ErrMoveTargetNotFound,
+		},
+		{
+			name: "MoveTask_ErrTaskNotFound",
+			input: func(db *sql.DB, mock sqlmock.Sqlmock) (*model.Task, error) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT position FROM tasks WHERE deleted_at IS NULL AND id = $1 AND owner_id = $2 AND tenant_id = $3")).
+					WithArgs(targetId, caller.Subject, caller.TenantId).
+					WillReturnRows(sqlmock.NewRows([]string{"position"}).AddRow(1024.0))
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT position FROM tasks WHERE deleted_at IS NULL AND owner_id = $1 AND tenant_id = $2 AND id <> $3 AND position > $4")).
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery(regexp.QuoteMeta("UPDATE tasks SET position = $1")).
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+
+				return NewTaskRepository(db).MoveTask(callerContext(), id, targetId, true)
+			},
+			expect: ErrTaskNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer db.Close()
+
+			task, err := tt.input(db, mock)
+			if err != tt.expect {
+				t.Errorf("expect values are equals, but got diferent, output: %v, expect: %v", err, tt.expect)
+			}
+			if err == nil && task.Position != tt.position {
+				t.Errorf("expect position %v, but got %v", tt.position, task.Position)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestUpdateTaskNextOccurrence(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  func() error
+		expect error
+	}{
+		{
+			name: "UpdateTask_SuccessCreatesNextOccurrence",
+			input: func() error {
+				db, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+				}
+				defer db.Close()
+
+				task := model.Task{
+					Id:        uuid.NewV4(),
+					Completed: true,
+					Version:   1,
+				}
+				next := model.Task{
+					Id:         uuid.NewV4(),
+					Value:      "weekly report",
+					DueDate:    sql.NullTime{Time: time.Now(), Valid: true},
+					Priority:   model.TaskPriorityMedium,
+					Recurrence: "DTSTART:20220404T093000Z\nRRULE:FREQ=WEEKLY",
+				}
+				created := next
+				created.Id = uuid.NewV4()
+				created.Version = 1
+
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("UPDATE tasks SET completed = $1, recurrence = $2, updated_at = $3, version = version + 1 WHERE")).
+					WithArgs(true, "", sqlmock.AnyArg(), task.Id, caller.Subject, caller.TenantId, task.Version).
+					WillReturnRows(sqlmock.NewRows([]string{"version", "updated_at"}).AddRow(task.Version+1, time.Now()))
+				expectInsertEvent(mock, model.EventTaskUpdated, task.Id)
+				expectInsertEvent(mock, model.EventTaskCompleted, task.Id)
+				mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO tasks (value,due_date,parent_id,priority,position,recurrence,owner_id,tenant_id) VALUES ($1,$2,$3,$4,(SELECT COALESCE(MAX(position), 0) + $5 FROM tasks WHERE owner_id = $6 AND tenant_id = $7),$8,$9,$10) RETURNING")).
+					WithArgs(next.Value, next.DueDate, next.ParentId, next.Priority, positionStep, caller.Subject, caller.TenantId, next.Recurrence, caller.Subject, caller.TenantId).
+					WillReturnRows(sqlmock.NewRows(
+						[]string{
+							"id",
+							"value",
+							"completed",
+							"due_date",
+							"created_at",
+							"updated_at",
+							"deleted_at",
+							"version",
+							"parent_id",
+							"priority",
+							"position",
+							"recurrence",
+						},
+					).AddRow(
+						created.Id,
+						created.Value,
+						created.Completed,
+						created.DueDate,
+						created.CreatedAt,
+						created.UpdatedAt,
+						created.DeletedAt,
+						created.Version,
+						created.ParentId,
+						created.Priority,
+						created.Position,
+						created.Recurrence,
+					))
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO labels (task_id,value) SELECT $1, value FROM labels WHERE deleted_at IS NULL AND task_id = $2 AND task_id IN (SELECT id FROM tasks WHERE owner_id = $3 AND tenant_id = $4)")).
+					WithArgs(created.Id, task.Id, caller.Subject, caller.TenantId).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				expectInsertEvent(mock, model.EventTaskCreated, created.Id)
+				mock.ExpectCommit()
+
+				svc := NewTaskRepository(db)
+				if err := svc.UpdateTask(callerContext(), &task, model.TaskUpdate{
+					Fields:         []string{model.TaskFieldCompleted, model.TaskFieldRecurrence},
+					NextOccurrence: &next,
+				}); err != nil {
+					return err
+				}
+
+				if next.Id != created.Id {
+					t.Errorf("expect the next occurrence to get the created task, but got %v", next.Id)
+				}
+
+				return mock.ExpectationsWereMet()
+			},
+			expect: nil,
+		},
+		{
+			name: "UpdateTask_ErrCopyLabelsKeepsSeries",
+			input: func() error {
+				db, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+				}
+				defer db.Close()
+
+				task := model.Task{
+					Id:        uuid.NewV4(),
+					Completed: true,
+					Version:   1,
+				}
+
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("UPDATE tasks SET completed = $1, recurrence = $2, updated_at = $3, version = version + 1 WHERE")).
+					WithArgs(true, "", sqlmock.AnyArg(), task.Id, caller.Subject, caller.TenantId, task.Version).
+					WillReturnRows(sqlmock.NewRows([]string{"version", "updated_at"}).AddRow(task.Version+1, time.Now()))
+				expectInsertEvent(mock, model.EventTaskUpdated, task.Id)
+				expectInsertEvent(mock, model.EventTaskCompleted, task.Id)
+				mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO tasks")).
+					WillReturnRows(sqlmock.NewRows(
+						[]string{
+							"id",
+							"value",
+							"completed",
+							"due_date",
+							"created_at",
+							"updated_at",
+							"deleted_at",
+							"version",
+							"parent_id",
+							"priority",
+							"position",
+							"recurrence",
+						},
+					).AddRow(uuid.NewV4(), "task", false, nil, time.Now(), time.Now(), nil, 1, nil, 0, 1024.0, ""))
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO labels")).WillReturnError(sql.ErrConnDone)
+				mock.ExpectRollback()
+
+				svc := NewTaskRepository(db)
+				err = svc.UpdateTask(callerContext(), &task, model.TaskUpdate{
+					Fields:         []string{model.TaskFieldCompleted, model.TaskFieldRecurrence},
+					NextOccurrence: &model.Task{Value: "task"},
+				})
+				if task.Version != 1 {
+					t.Errorf("expect the version to be kept, but got %d", task.Version)
+				}
+				return err
+			},
+			expect: sql.ErrConnDone,
+		},
 	}
 
 	for _, tt := range tests {
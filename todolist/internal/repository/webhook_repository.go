@@ -0,0 +1,553 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+	storage "github.com/overridesh/sgg-todolist-service/pkg/storage/sql"
+)
+
+var (
+	ErrWebhookNotFound         = errors.New("webhook not found")
+	ErrWebhookDeliveryNotFound = errors.New("webhook delivery not found")
+)
+
+// deliveryClaimLease is how long the deliveries claimed by a replica are skipped by the others. The claim of a delivery
+// is renewed right before it is posted, so the lease only has to outlive the post of one delivery and not the ones of a
+// whole batch.
+const deliveryClaimLease time.Duration = 5 * time.Minute
+
+// DeliverFunc posts a delivery to its webhook, returning the status code of the response when there is one
+type DeliverFunc func(ctx context.Context, webhook *model.Webhook, delivery *model.WebhookDelivery) (int, error)
+
+// RetryFunc tells how long to wait before the next attempt of a failed delivery, false means there are no attempts left
+type RetryFunc func(attempts int32) (time.Duration, bool)
+
+type WebhookRepository interface {
+	CreateWebhook(context.Context, model.Webhook) (*model.Webhook, error)
+	GetWebhook(context.Context, uuid.UUID) (*model.Webhook, error)
+	GetWebhooks(context.Context) ([]*model.Webhook, error)
+	DeleteWebhook(context.Context, uuid.UUID) error
+	EnqueueDeliveries(context.Context, model.Event) (int64, error)
+	GetDeliveries(ctx context.Context, webhookId uuid.UUID, status string, limit uint64) ([]*model.WebhookDelivery, error)
+	RedeliverDelivery(ctx context.Context, webhookId uuid.UUID, deliveryId uuid.UUID) error
+	ProcessDueDeliveries(ctx context.Context, now time.Time, limit uint64, deliver DeliverFunc, retry RetryFunc) (int, error)
+}
+
+type webhookRepository struct {
+	db storage.DB
+}
+
+func NewWebhookRepository(db storage.DB) WebhookRepository {
+	return &webhookRepository{
+		db: db,
+	}
+}
+
+// CreateWebhook adds a webhook to the scope, it only gets the events of the tasks of the scope
+func (wr *webhookRepository) CreateWebhook(ctx context.Context, newWebhook model.Webhook) (*model.Webhook, error) {
+	ctx = storage.WithMethod(ctx, "webhookRepository.CreateWebhook")
+
+	scope, err := getScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query, args, err := psql.
+		Insert("webhooks").
+		Columns("url", "secret", "events", "owner_id", "tenant_id").
+		Values(newWebhook.Url, newWebhook.Secret, pq.Array(newWebhook.Events), scope.ownerId, scope.tenantId).
+		Suffix("RETURNING \"id\", \"url\", \"secret\", \"events\", \"created_at\", \"deleted_at\"").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var webhook model.Webhook
+
+	if err := wr.db.QueryRowContext(ctx, query, args...).Scan(
+		&webhook.Id,
+		&webhook.Url,
+		&webhook.Secret,
+		pq.Array(&webhook.Events),
+		&webhook.CreatedAt,
+		&webhook.DeletedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return &webhook, nil
+}
+
+// GetWebhook returns a webhook of the scope, ErrWebhookNotFound when it is not found
+func (wr *webhookRepository) GetWebhook(ctx context.Context, id uuid.UUID) (*model.Webhook, error) {
+	ctx = storage.WithMethod(ctx, "webhookRepository.GetWebhook")
+
+	scope, err := getScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query, args, err := psql.
+		Select(`
+			id,
+			url,
+			secret,
+			events,
+			created_at,
+			deleted_at
+		`).
+		From("webhooks").
+		Where(scope.webhooks(sq.Eq{
+			"deleted_at": nil,
+			"id":         id,
+		})).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var webhook model.Webhook
+
+	if err := wr.db.QueryRowContext(ctx, query, args...).Scan(
+		&webhook.Id,
+		&webhook.Url,
+		&webhook.Secret,
+		pq.Array(&webhook.Events),
+		&webhook.CreatedAt,
+		&webhook.DeletedAt,
+	); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrWebhookNotFound
+		}
+		return nil, err
+	}
+
+	return &webhook, nil
+}
+
+// GetWebhooks returns the webhooks of the scope
+func (wr *webhookRepository) GetWebhooks(ctx context.Context) ([]*model.Webhook, error) {
+	ctx = storage.WithMethod(ctx, "webhookRepository.GetWebhooks")
+
+	scope, err := getScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query, args, err := psql.
+		Select(`
+			id,
+			url,
+			secret,
+			events,
+			created_at,
+			deleted_at
+		`).
+		From("webhooks").
+		Where(scope.webhooks(sq.Eq{"deleted_at": nil})).
+		OrderBy("created_at", "id").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := wr.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var webhooks []*model.Webhook = []*model.Webhook{}
+
+	for rows.Next() {
+		var webhook model.Webhook
+
+		if err := rows.Scan(
+			&webhook.Id,
+			&webhook.Url,
+			&webhook.Secret,
+			pq.Array(&webhook.Events),
+			&webhook.CreatedAt,
+			&webhook.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		webhooks = append(webhooks, &webhook)
+	}
+
+	return webhooks, rows.Err()
+}
+
+// DeleteWebhook soft deletes a webhook of the scope, its pending deliveries are not attempted anymore
+func (wr *webhookRepository) DeleteWebhook(ctx context.Context, id uuid.UUID) error {
+	ctx = storage.WithMethod(ctx, "webhookRepository.DeleteWebhook")
+
+	scope, err := getScope(ctx)
+	if err != nil {
+		return err
+	}
+
+	query, args, err := psql.
+		Update("webhooks").
+		Set("deleted_at", time.Now()).
+		Where(scope.webhooks(sq.Eq{
+			"deleted_at": nil,
+			"id":         id,
+		})).ToSql()
+	if err != nil {
+		return err
+	}
+
+	result, err := wr.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return ErrWebhookNotFound
+	}
+
+	return nil
+}
+
+// EnqueueDeliveries adds a pending delivery of the event for every webhook subscribed to it, returning how many were
+// added. Only the webhooks of the owner and the tenant of the event get it.
+func (wr *webhookRepository) EnqueueDeliveries(ctx context.Context, event model.Event) (int64, error) {
+	ctx = storage.WithMethod(ctx, "webhookRepository.EnqueueDeliveries")
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return 0, err
+	}
+
+	query, args, err := psql.
+		Insert("webhook_deliveries").
+		Columns("webhook_id", "event", "payload", "owner_id", "tenant_id").
+		Select(
+			psql.
+				Select("id").
+				Column(sq.Expr("?", event.Type)).
+				Column(sq.Expr("?", payload)).
+				Columns("owner_id", "tenant_id").
+				From("webhooks").
+				Where(sq.Eq{
+					"deleted_at": nil,
+					"owner_id":   event.OwnerId,
+					"tenant_id":  event.TenantId,
+				}).
+				Where("(cardinality(events) = 0 OR ? = ANY(events))", event.Type),
+		).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := wr.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+// GetDeliveries returns the latest deliveries of a webhook of the scope first, only the ones in status when it is not
+// empty
+func (wr *webhookRepository) GetDeliveries(ctx context.Context, webhookId uuid.UUID, status string, limit uint64) ([]*model.WebhookDelivery, error) {
+	ctx = storage.WithMethod(ctx, "webhookRepository.GetDeliveries")
+
+	scope, err := getScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conditions := scope.webhooks(sq.Eq{"webhook_id": webhookId})
+	if status != "" {
+		conditions["status"] = status
+	}
+
+	query, args, err := psql.
+		Select(`
+			id,
+			webhook_id,
+			event,
+			payload,
+			status,
+			attempts,
+			next_attempt_at,
+			response_code,
+			last_error,
+			delivered_at,
+			created_at
+		`).
+		From("webhook_deliveries").
+		Where(conditions).
+		OrderBy("created_at DESC", "id DESC").
+		Limit(limit).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := wr.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var deliveries []*model.WebhookDelivery = []*model.WebhookDelivery{}
+
+	for rows.Next() {
+		var delivery model.WebhookDelivery
+
+		if err := rows.Scan(
+			&delivery.Id,
+			&delivery.WebhookId,
+			&delivery.Event,
+			&delivery.Payload,
+			&delivery.Status,
+			&delivery.Attempts,
+			&delivery.NextAttemptAt,
+			&delivery.ResponseCode,
+			&delivery.LastError,
+			&delivery.DeliveredAt,
+			&delivery.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		deliveries = append(deliveries, &delivery)
+	}
+
+	return deliveries, rows.Err()
+}
+
+// RedeliverDelivery puts a delivery of the scope back to pending with all of its attempts, it is how a dead delivery
+// is retried
+func (wr *webhookRepository) RedeliverDelivery(ctx context.Context, webhookId uuid.UUID, deliveryId uuid.UUID) error {
+	ctx = storage.WithMethod(ctx, "webhookRepository.RedeliverDelivery")
+
+	scope, err := getScope(ctx)
+	if err != nil {
+		return err
+	}
+
+	query, args, err := psql.
+		Update("webhook_deliveries").
+		Set("status", model.WebhookDeliveryPending).
+		Set("attempts", 0).
+		Set("next_attempt_at", time.Now()).
+		Where(scope.webhooks(sq.Eq{
+			"webhook_id": webhookId,
+			"id":         deliveryId,
+		})).ToSql()
+	if err != nil {
+		return err
+	}
+
+	result, err := wr.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return ErrWebhookDeliveryNotFound
+	}
+
+	return nil
+}
+
+// ProcessDueDeliveries posts up to limit pending deliveries due at now, returning how many were delivered.
+// A failed delivery is attempted again after the delay given by retry, or is dead when retry gives up on it.
+// The deliveries are claimed in a short transaction and posted outside of it, like the reminders, and the ones
+// claimed by another replica are skipped until deliveryClaimLease. A delivery whose claim was taken over by another
+// replica is left to it.
+func (wr *webhookRepository) ProcessDueDeliveries(ctx context.Context, now time.Time, limit uint64, deliver DeliverFunc, retry RetryFunc) (int, error) {
+	ctx = storage.WithMethod(ctx, "webhookRepository.ProcessDueDeliveries")
+
+	// postgres keeps microseconds, the claim is told apart by its exact time
+	now = now.Truncate(time.Microsecond)
+	started := time.Now()
+
+	due, err := wr.claimDueDeliveries(ctx, now, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	var delivered int
+
+	for _, item := range due {
+		renewed, err := renewClaim(ctx, wr.db, "webhook_deliveries", "id", item.delivery.Id, now, now.Add(time.Since(started)).Truncate(time.Microsecond))
+		if err != nil {
+			return delivered, err
+		}
+
+		if !renewed {
+			continue
+		}
+
+		attempts := item.delivery.Attempts + 1
+
+		builder := psql.
+			Update("webhook_deliveries").
+			Set("attempts", attempts).
+			Set("claimed_at", nil).
+			Where(sq.Eq{"id": item.delivery.Id})
+
+		code, err := deliver(ctx, &item.webhook, &item.delivery)
+		if code > 0 {
+			builder = builder.Set("response_code", code)
+		}
+
+		if err != nil {
+			builder = builder.Set("last_error", err.Error())
+
+			if delay, ok := retry(attempts); ok {
+				builder = builder.Set("next_attempt_at", now.Add(delay))
+			} else {
+				builder = builder.Set("status", model.WebhookDeliveryDead)
+			}
+		} else {
+			builder = builder.
+				Set("status", model.WebhookDeliveryDelivered).
+				Set("delivered_at", now).
+				Set("last_error", nil)
+			delivered++
+		}
+
+		query, args, err := builder.ToSql()
+		if err != nil {
+			return delivered, err
+		}
+
+		if _, err := wr.db.ExecContext(ctx, query, args...); err != nil {
+			return delivered, err
+		}
+	}
+
+	return delivered, nil
+}
+
+// dueDelivery is a claimed delivery with the webhook it is posted to
+type dueDelivery struct {
+	delivery model.WebhookDelivery
+	webhook  model.Webhook
+}
+
+// claimDueDeliveries locks up to limit pending deliveries due at now that are not claimed by another replica, and
+// claims them
+func (wr *webhookRepository) claimDueDeliveries(ctx context.Context, now time.Time, limit uint64) ([]*dueDelivery, error) {
+	var due []*dueDelivery
+
+	err := withTx(ctx, wr.db, func(tx storage.Tx) error {
+		query, args, err := psql.
+			Select(`
+				webhook_deliveries.id,
+				webhook_deliveries.webhook_id,
+				webhook_deliveries.event,
+				webhook_deliveries.payload,
+				webhook_deliveries.status,
+				webhook_deliveries.attempts,
+				webhook_deliveries.next_attempt_at,
+				webhook_deliveries.created_at,
+				webhooks.url,
+				webhooks.secret
+			`).
+			From("webhook_deliveries").
+			Join("webhooks ON webhooks.id = webhook_deliveries.webhook_id").
+			Where(sq.Eq{
+				"webhook_deliveries.status": model.WebhookDeliveryPending,
+				"webhooks.deleted_at":       nil,
+			}).
+			Where(sq.LtOrEq{"webhook_deliveries.next_attempt_at": now}).
+			Where(sq.Or{
+				sq.Eq{"webhook_deliveries.claimed_at": nil},
+				sq.Lt{"webhook_deliveries.claimed_at": now.Add(-deliveryClaimLease)},
+			}).
+			OrderBy("webhook_deliveries.next_attempt_at").
+			Limit(limit).
+			Suffix("FOR UPDATE OF webhook_deliveries SKIP LOCKED").
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		rows, err := tx.QueryContext(ctx, query, args...)
+		if err != nil {
+			return err
+		}
+
+		var ids []uuid.UUID
+
+		for rows.Next() {
+			var item dueDelivery
+
+			if err := rows.Scan(
+				&item.delivery.Id,
+				&item.delivery.WebhookId,
+				&item.delivery.Event,
+				&item.delivery.Payload,
+				&item.delivery.Status,
+				&item.delivery.Attempts,
+				&item.delivery.NextAttemptAt,
+				&item.delivery.CreatedAt,
+				&item.webhook.Url,
+				&item.webhook.Secret,
+			); err != nil {
+				rows.Close()
+				return err
+			}
+
+			item.webhook.Id = item.delivery.WebhookId
+			due = append(due, &item)
+			ids = append(ids, item.delivery.Id)
+		}
+		rows.Close()
+
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		if len(ids) == 0 {
+			return nil
+		}
+
+		query, args, err = psql.
+			Update("webhook_deliveries").
+			Set("claimed_at", now).
+			Where(sq.Eq{"id": ids}).
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, query, args...)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return due, nil
+}
@@ -0,0 +1,361 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+)
+
+var webhookColumns []string = []string{
+	"id",
+	"url",
+	"secret",
+	"events",
+	"created_at",
+	"deleted_at",
+}
+
+func TestCreateWebhook(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	webhook := model.Webhook{
+		Id:        uuid.NewV4(),
+		Url:       "https://example.com/hooks",
+		Secret:    "s3cr3t",
+		Events:    []string{model.EventTaskCreated, model.EventTaskCompleted},
+		CreatedAt: time.Now(),
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO webhooks (url,secret,events,owner_id,tenant_id) VALUES ($1,$2,$3,$4,$5) RETURNING")).
+		WithArgs(webhook.Url, webhook.Secret, pq.Array(webhook.Events), caller.Subject, caller.TenantId).
+		WillReturnRows(sqlmock.NewRows(webhookColumns).AddRow(
+			webhook.Id,
+			webhook.Url,
+			webhook.Secret,
+			"{task.created,task.completed}",
+			webhook.CreatedAt,
+			nil,
+		))
+
+	svc := NewWebhookRepository(db)
+	created, err := svc.CreateWebhook(callerContext(), model.Webhook{
+		Url:    webhook.Url,
+		Secret: webhook.Secret,
+		Events: webhook.Events,
+	})
+	if err != nil {
+		t.Fatalf("expect no error, but got %v", err)
+	}
+
+	if created.Id != webhook.Id || len(created.Events) != 2 || created.Events[1] != model.EventTaskCompleted {
+		t.Errorf("expect the created webhook, but got %+v", created)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestGetWebhook(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	id := uuid.NewV4()
+
+	mock.ExpectQuery(regexp.QuoteMeta("FROM webhooks WHERE deleted_at IS NULL AND id = $1 AND owner_id = $2 AND tenant_id = $3")).
+		WithArgs(id, caller.Subject, caller.TenantId).
+		WillReturnRows(sqlmock.NewRows(webhookColumns))
+
+	svc := NewWebhookRepository(db)
+	if _, err := svc.GetWebhook(callerContext(), id); err != ErrWebhookNotFound {
+		t.Errorf("expect %v, but got %v", ErrWebhookNotFound, err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestGetWebhooks(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("FROM webhooks WHERE deleted_at IS NULL AND owner_id = $1 AND tenant_id = $2 ORDER BY created_at, id")).
+		WithArgs(caller.Subject, caller.TenantId).
+		WillReturnRows(sqlmock.NewRows(webhookColumns).AddRow(uuid.NewV4(), "https://example.com/hooks", "s3cr3t", "{}", time.Now(), nil))
+
+	svc := NewWebhookRepository(db)
+	webhooks, err := svc.GetWebhooks(callerContext())
+	if err != nil {
+		t.Fatalf("expect no error, but got %v", err)
+	}
+
+	if len(webhooks) != 1 || len(webhooks[0].Events) != 0 {
+		t.Errorf("expect the webhook of the caller, but got %v", webhooks)
+	}
+
+	if _, err := svc.GetWebhooks(context.Background()); err != ErrIdentityRequired {
+		t.Errorf("expect %v, but got %v", ErrIdentityRequired, err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestDeleteWebhook(t *testing.T) {
+	tests := []struct {
+		name     string
+		affected int64
+		expect   error
+	}{
+		{
+			name:     "DeleteWebhook_Success",
+			affected: 1,
+			expect:   nil,
+		},
+		{
+			name:     "DeleteWebhook_ErrWebhookNotFound",
+			affected: 0,
+			expect:   ErrWebhookNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer db.Close()
+
+			id := uuid.NewV4()
+
+			mock.ExpectExec(regexp.QuoteMeta("UPDATE webhooks SET deleted_at = $1 WHERE deleted_at IS NULL AND id = $2 AND owner_id = $3 AND tenant_id = $4")).
+				WithArgs(sqlmock.AnyArg(), id, caller.Subject, caller.TenantId).
+				WillReturnResult(sqlmock.NewResult(0, tt.affected))
+
+			svc := NewWebhookRepository(db)
+			if err := svc.DeleteWebhook(callerContext(), id); err != tt.expect {
+				t.Errorf("expect %v, but got %v", tt.expect, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestEnqueueDeliveries(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	event := model.Event{
+		Id:         uuid.NewV4(),
+		Type:       model.EventLabelCreated,
+		TaskId:     uuid.NewV4(),
+		OccurredAt: time.Now().UTC(),
+		Data:       json.RawMessage(`{"id":"1","name":"home"}`),
+		OwnerId:    caller.Subject,
+		TenantId:   caller.TenantId,
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Only the webhooks of the owner and the tenant of the event get it
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO webhook_deliveries (webhook_id,event,payload,owner_id,tenant_id) SELECT id, $1, $2, owner_id, tenant_id FROM webhooks WHERE deleted_at IS NULL AND owner_id = $3 AND tenant_id = $4 AND (cardinality(events) = 0 OR $5 = ANY(events))")).
+		WithArgs(event.Type, payload, caller.Subject, caller.TenantId, event.Type).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	svc := NewWebhookRepository(db)
+	enqueued, err := svc.EnqueueDeliveries(context.Background(), event)
+	if err != nil {
+		t.Fatalf("expect no error, but got %v", err)
+	}
+
+	if enqueued != 2 {
+		t.Errorf("expect 2 deliveries, but got %d", enqueued)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestGetDeliveries(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	var (
+		now       time.Time = time.Now()
+		webhookId uuid.UUID = uuid.NewV4()
+	)
+
+	mock.ExpectQuery(regexp.QuoteMeta("FROM webhook_deliveries WHERE owner_id = $1 AND status = $2 AND tenant_id = $3 AND webhook_id = $4 ORDER BY created_at DESC, id DESC LIMIT 20")).
+		WithArgs(caller.Subject, model.WebhookDeliveryDead, caller.TenantId, webhookId).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "webhook_id", "event", "payload", "status", "attempts", "next_attempt_at",
+			"response_code", "last_error", "delivered_at", "created_at",
+		}).AddRow(uuid.NewV4(), webhookId, model.EventTaskDeleted, []byte(`{}`), model.WebhookDeliveryDead, 8, now, 500, "webhook responded with status 500", nil, now))
+
+	svc := NewWebhookRepository(db)
+	deliveries, err := svc.GetDeliveries(callerContext(), webhookId, model.WebhookDeliveryDead, 20)
+	if err != nil {
+		t.Fatalf("expect no error, but got %v", err)
+	}
+
+	if len(deliveries) != 1 || deliveries[0].ResponseCode.Int32 != 500 || deliveries[0].Attempts != 8 {
+		t.Errorf("expect the dead delivery, but got %v", deliveries)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestRedeliverDelivery(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	var (
+		webhookId  uuid.UUID = uuid.NewV4()
+		deliveryId uuid.UUID = uuid.NewV4()
+	)
+
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE webhook_deliveries SET status = $1, attempts = $2, next_attempt_at = $3 WHERE id = $4 AND owner_id = $5 AND tenant_id = $6 AND webhook_id = $7")).
+		WithArgs(model.WebhookDeliveryPending, 0, sqlmock.AnyArg(), deliveryId, caller.Subject, caller.TenantId, webhookId).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	svc := NewWebhookRepository(db)
+	if err := svc.RedeliverDelivery(callerContext(), webhookId, deliveryId); err != ErrWebhookDeliveryNotFound {
+		t.Errorf("expect %v, but got %v", ErrWebhookDeliveryNotFound, err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestProcessDueDeliveries(t *testing.T) {
+	var (
+		errDeliver error = errors.New("webhook responded with status 503")
+	)
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	var (
+		now         time.Time = time.Now().Truncate(time.Microsecond)
+		webhookId   uuid.UUID = uuid.NewV4()
+		deliveredId uuid.UUID = uuid.NewV4()
+		retriedId   uuid.UUID = uuid.NewV4()
+		deadId      uuid.UUID = uuid.NewV4()
+		lostId      uuid.UUID = uuid.NewV4()
+	)
+
+	// expectRenew expects the claim of the delivery to be renewed, renewed tells if it was still claimed
+	expectRenew := func(id uuid.UUID, renewed bool) {
+		var affected int64
+		if renewed {
+			affected = 1
+		}
+
+		mock.ExpectExec(regexp.QuoteMeta("UPDATE webhook_deliveries SET claimed_at = $1 WHERE claimed_at = $2 AND id = $3")).
+			WithArgs(sqlmock.AnyArg(), now, id).
+			WillReturnResult(sqlmock.NewResult(0, affected))
+	}
+
+	columns := []string{"id", "webhook_id", "event", "payload", "status", "attempts", "next_attempt_at", "created_at", "url", "secret"}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("FROM webhook_deliveries JOIN webhooks ON webhooks.id = webhook_deliveries.webhook_id WHERE webhook_deliveries.status = $1 AND webhooks.deleted_at IS NULL AND webhook_deliveries.next_attempt_at <= $2 AND (webhook_deliveries.claimed_at IS NULL OR webhook_deliveries.claimed_at < $3) ORDER BY webhook_deliveries.next_attempt_at LIMIT 10 FOR UPDATE OF webhook_deliveries SKIP LOCKED")).
+		WithArgs(model.WebhookDeliveryPending, now, now.Add(-deliveryClaimLease)).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(deliveredId, webhookId, model.EventTaskCreated, []byte(`{}`), model.WebhookDeliveryPending, 0, now, now, "https://example.com", "s3cr3t").
+			AddRow(retriedId, webhookId, model.EventTaskCreated, []byte(`{}`), model.WebhookDeliveryPending, 1, now, now, "https://example.com", "s3cr3t").
+			AddRow(deadId, webhookId, model.EventTaskCreated, []byte(`{}`), model.WebhookDeliveryPending, 2, now, now, "https://example.com", "s3cr3t").
+			AddRow(lostId, webhookId, model.EventTaskCreated, []byte(`{}`), model.WebhookDeliveryPending, 0, now, now, "https://example.com", "s3cr3t"))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE webhook_deliveries SET claimed_at = $1 WHERE id IN ($2,$3,$4,$5)")).
+		WithArgs(now, deliveredId, retriedId, deadId, lostId).
+		WillReturnResult(sqlmock.NewResult(0, 4))
+	mock.ExpectCommit()
+	expectRenew(deliveredId, true)
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE webhook_deliveries SET attempts = $1, claimed_at = $2, response_code = $3, status = $4, delivered_at = $5, last_error = $6 WHERE id = $7")).
+		WithArgs(int32(1), nil, 204, model.WebhookDeliveryDelivered, now, nil, deliveredId).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectRenew(retriedId, true)
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE webhook_deliveries SET attempts = $1, claimed_at = $2, response_code = $3, last_error = $4, next_attempt_at = $5 WHERE id = $6")).
+		WithArgs(int32(2), nil, 503, errDeliver.Error(), now.Add(time.Minute), retriedId).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectRenew(deadId, true)
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE webhook_deliveries SET attempts = $1, claimed_at = $2, response_code = $3, last_error = $4, status = $5 WHERE id = $6")).
+		WithArgs(int32(3), nil, 503, errDeliver.Error(), model.WebhookDeliveryDead, deadId).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	// The lease of the last delivery ended while the others were posted and another replica claimed it
+	expectRenew(lostId, false)
+
+	svc := NewWebhookRepository(db)
+	delivered, err := svc.ProcessDueDeliveries(context.Background(), now, 10,
+		func(ctx context.Context, webhook *model.Webhook, delivery *model.WebhookDelivery) (int, error) {
+			if webhook.Id != webhookId || webhook.Secret != "s3cr3t" {
+				t.Errorf("expect the webhook of the delivery, but got %v", webhook)
+			}
+			if delivery.Id == lostId {
+				t.Error("expect the delivery claimed by another replica not to be posted")
+			}
+			if delivery.Id == deliveredId {
+				return 204, nil
+			}
+			return 503, errDeliver
+		},
+		func(attempts int32) (time.Duration, bool) {
+			return time.Minute, attempts < 3
+		},
+	)
+	if err != nil {
+		t.Fatalf("expect no error, but got %v", err)
+	}
+
+	if delivered != 1 {
+		t.Errorf("expect 1 delivery, but got %d", delivered)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
@@ -0,0 +1,203 @@
+package sse
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/overridesh/sgg-todolist-service/internal/auth"
+	"github.com/overridesh/sgg-todolist-service/internal/logging"
+	pbTodoList "github.com/overridesh/sgg-todolist-service/proto"
+	"github.com/overridesh/sgg-todolist-service/tools"
+)
+
+const (
+	// LastEventIDHeader is sent by the browsers when they reconnect, it is the sequence of the last event received
+	LastEventIDHeader string = "Last-Event-ID"
+	// lastEventIDParam resumes the first connection, when the header cannot be set
+	lastEventIDParam string = "last_event_id"
+	// accessTokenParam authenticates the stream as described in RFC 6750, when the header cannot be set
+	accessTokenParam    string = "access_token"
+	authorizationHeader string = "Authorization"
+
+	// retryInterval is how long the browsers wait before they reconnect
+	retryInterval time.Duration = 3 * time.Second
+)
+
+var marshaler protojson.MarshalOptions = protojson.MarshalOptions{
+	UseProtoNames: true,
+}
+
+// Handler streams the events of WatchTasks as Server-Sent Events. Every event has the sequence as id, so a browser
+// that reconnects resumes the stream where it was left.
+type Handler struct {
+	client    pbTodoList.TodoListServiceClient
+	heartbeat time.Duration
+}
+
+// NewHandler initializes a handler that sends a comment every heartbeat, so the proxies keep idle streams open
+func NewHandler(client pbTodoList.TodoListServiceClient, heartbeat time.Duration) *Handler {
+	return &Handler{
+		client:    client,
+		heartbeat: heartbeat,
+	}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		writeError(w, http.StatusMethodNotAllowed, status.New(codes.Unimplemented, http.StatusText(http.StatusMethodNotAllowed)))
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, http.StatusInternalServerError, status.New(codes.Internal, "streaming is not supported"))
+		return
+	}
+
+	in := pbTodoList.WatchTasksRequest{
+		TaskId: r.URL.Query().Get("task_id"),
+		Label:  r.URL.Query().Get("label"),
+	}
+
+	lastEventID := r.Header.Get(LastEventIDHeader)
+	if len(lastEventID) == 0 {
+		lastEventID = r.URL.Query().Get(lastEventIDParam)
+	}
+
+	if len(lastEventID) > 0 {
+		sequence, err := strconv.ParseInt(lastEventID, 10, 64)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, status.New(codes.InvalidArgument, "invalid Last-Event-ID"))
+			return
+		}
+		in.AfterSequence = sequence
+	}
+
+	ctx := r.Context()
+
+	// The browsers cannot set the Authorization header of an EventSource, the token can be sent as access_token
+	authorization := r.Header.Get(authorizationHeader)
+	if token := r.URL.Query().Get(accessTokenParam); len(authorization) == 0 && len(token) > 0 {
+		authorization = "Bearer " + token
+	}
+	if len(authorization) > 0 {
+		ctx = metadata.AppendToOutgoingContext(ctx, auth.AuthorizationHeader, authorization)
+	}
+	if apiKey := r.Header.Get(auth.ApiKeyHeader); len(apiKey) > 0 {
+		ctx = metadata.AppendToOutgoingContext(ctx, auth.ApiKeyHeader, apiKey)
+	}
+	// The stream is logged by the server with the id of the request
+	if requestID := logging.RequestID(ctx); len(requestID) > 0 {
+		ctx = metadata.AppendToOutgoingContext(ctx, logging.RequestIDHeader, requestID)
+	}
+
+	stream, err := h.client.WatchTasks(ctx, &in)
+	if err != nil {
+		writeStatusError(w, err)
+		return
+	}
+
+	// The server sends the headers once the watch is accepted, a refused watch ends with its error instead
+	if header, err := stream.Header(); err != nil || !tools.IsWatchAccepted(header) {
+		if err == nil {
+			_, err = stream.Recv()
+		}
+		writeStatusError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	fmt.Fprintf(w, "retry: %d\n\n", retryInterval.Milliseconds())
+	flusher.Flush()
+
+	// Recv blocks, so the events are received apart from the heartbeats
+	events := make(chan *pbTodoList.TaskEvent)
+	errs := make(chan error, 1)
+
+	go func() {
+		for {
+			event, err := stream.Recv()
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	heartbeat := time.NewTicker(h.heartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case err := <-errs:
+			// The browser reconnects from the last event, also when the watch fell behind
+			if status.Code(err) != codes.Canceled {
+				logging.FromContext(ctx).Warn("watch ended", zap.Error(err))
+			}
+			return
+		case <-heartbeat.C:
+			fmt.Fprint(w, ": heartbeat\n\n")
+			flusher.Flush()
+		case event := <-events:
+			data, err := marshaler.Marshal(event)
+			if err != nil {
+				logging.FromContext(ctx).Error("cannot marshal event", zap.Int64("sequence", event.GetSequence()), zap.Error(err))
+				continue
+			}
+
+			fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.GetSequence(), event.GetEvent(), compact(data))
+			flusher.Flush()
+		}
+	}
+}
+
+// compact removes the new lines of the JSON, a data line of an event cannot have them
+func compact(data []byte) []byte {
+	var buffer bytes.Buffer
+	if err := json.Compact(&buffer, data); err != nil {
+		return data
+	}
+	return buffer.Bytes()
+}
+
+// writeStatusError writes the error of the watch with the http status of its grpc code
+func writeStatusError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	writeError(w, runtime.HTTPStatusFromCode(st.Code()), st)
+}
+
+// writeError writes an error in the same shape as the errors of the gateway
+func writeError(w http.ResponseWriter, httpStatus int, st *status.Status) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"code":    st.Code(),
+		"message": st.Message(),
+		"details": []interface{}{},
+	})
+}
@@ -0,0 +1,260 @@
+package sse_test
+
+import (
+	"bufio"
+	"context"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/overridesh/sgg-todolist-service/internal/auth"
+	"github.com/overridesh/sgg-todolist-service/internal/sse"
+	pbTodoList "github.com/overridesh/sgg-todolist-service/proto"
+	"github.com/overridesh/sgg-todolist-service/tools"
+)
+
+// watchServer sends its events to every watch and keeps it open until the client leaves
+type watchServer struct {
+	pbTodoList.UnimplementedTodoListServiceServer
+
+	events   []*pbTodoList.TaskEvent
+	err      error
+	requests chan *pbTodoList.WatchTasksRequest
+	// authorization and api key of the last watch, they are set before the request is sent
+	authorization []string
+	apiKey        []string
+}
+
+func (s *watchServer) WatchTasks(in *pbTodoList.WatchTasksRequest, stream pbTodoList.TodoListService_WatchTasksServer) error {
+	md, _ := metadata.FromIncomingContext(stream.Context())
+	s.authorization = md.Get(auth.AuthorizationHeader)
+	s.apiKey = md.Get(auth.ApiKeyHeader)
+	s.requests <- in
+
+	if s.err != nil {
+		return s.err
+	}
+
+	if err := tools.SetWatchAccepted(stream); err != nil {
+		return err
+	}
+
+	for _, event := range s.events {
+		if err := stream.Send(event); err != nil {
+			return err
+		}
+	}
+
+	<-stream.Context().Done()
+	return nil
+}
+
+func newServer(t *testing.T, watch *watchServer, heartbeat time.Duration) *httptest.Server {
+	listener := bufconn.Listen(1024 * 1024)
+
+	server := grpc.NewServer()
+	pbTodoList.RegisterTodoListServiceServer(server, watch)
+
+	go func() {
+		if err := server.Serve(listener); err != nil {
+			log.Fatal(err)
+		}
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+		return listener.Dial()
+	}))
+	if err != nil {
+		log.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	httpServer := httptest.NewServer(sse.NewHandler(pbTodoList.NewTodoListServiceClient(conn), heartbeat))
+	t.Cleanup(httpServer.Close)
+
+	return httpServer
+}
+
+// readLines reads the stream until it has count lines that are not empty
+func readLines(t *testing.T, response *http.Response, count int) []string {
+	lines := make(chan []string, 1)
+
+	go func() {
+		var read []string
+		scanner := bufio.NewScanner(response.Body)
+		for len(read) < count && scanner.Scan() {
+			if len(scanner.Text()) > 0 {
+				read = append(read, scanner.Text())
+			}
+		}
+		lines <- read
+	}()
+
+	select {
+	case read := <-lines:
+		return read
+	case <-time.After(time.Second):
+		t.Fatalf("expect %d lines", count)
+	}
+	return nil
+}
+
+func TestHandler(t *testing.T) {
+	t.Run("Handler_StreamEvents", func(t *testing.T) {
+		watch := &watchServer{
+			events: []*pbTodoList.TaskEvent{{
+				Sequence: 7,
+				Event:    "task.deleted",
+				TaskId:   "aa54dc02-b5c4-4629-889e-ee64d3921483",
+				Data:     &pbTodoList.TaskEvent_DeletedId{DeletedId: "aa54dc02-b5c4-4629-889e-ee64d3921483"},
+			}},
+			requests: make(chan *pbTodoList.WatchTasksRequest, 1),
+		}
+		server := newServer(t, watch, time.Hour)
+
+		request, _ := http.NewRequest(http.MethodGet, server.URL+"?label=home", nil)
+		request.Header.Set(sse.LastEventIDHeader, "6")
+
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			t.Fatalf("expect no error, but got %v", err)
+		}
+		defer response.Body.Close()
+
+		if in := <-watch.requests; in.GetLabel() != "home" || in.GetAfterSequence() != 6 {
+			t.Errorf("expect the watch of label home after 6, but got %v", in)
+		}
+
+		if contentType := response.Header.Get("Content-Type"); contentType != "text/event-stream" {
+			t.Errorf("expect text/event-stream, but got %s", contentType)
+		}
+
+		lines := readLines(t, response, 4)
+		if lines[1] != "id: 7" || lines[2] != "event: task.deleted" {
+			t.Errorf("expect the event 7, but got %v", lines)
+		}
+
+		if !strings.HasPrefix(lines[3], "data: {") || !strings.Contains(lines[3], `"deleted_id":"aa54dc02-b5c4-4629-889e-ee64d3921483"`) {
+			t.Errorf("expect the data of the event, but got %s", lines[3])
+		}
+	})
+
+	t.Run("Handler_Heartbeat", func(t *testing.T) {
+		watch := &watchServer{requests: make(chan *pbTodoList.WatchTasksRequest, 1)}
+		server := newServer(t, watch, 10*time.Millisecond)
+
+		response, err := http.Get(server.URL + "?last_event_id=3")
+		if err != nil {
+			t.Fatalf("expect no error, but got %v", err)
+		}
+		defer response.Body.Close()
+
+		if in := <-watch.requests; in.GetAfterSequence() != 3 {
+			t.Errorf("expect the watch after 3, but got %v", in)
+		}
+
+		if lines := readLines(t, response, 2); lines[1] != ": heartbeat" {
+			t.Errorf("expect a heartbeat, but got %v", lines)
+		}
+	})
+
+	t.Run("Handler_ForwardAccessToken", func(t *testing.T) {
+		watch := &watchServer{requests: make(chan *pbTodoList.WatchTasksRequest, 1)}
+		server := newServer(t, watch, time.Hour)
+
+		response, err := http.Get(server.URL + "?access_token=token")
+		if err != nil {
+			t.Fatalf("expect no error, but got %v", err)
+		}
+		defer response.Body.Close()
+
+		<-watch.requests
+
+		if len(watch.authorization) != 1 || watch.authorization[0] != "Bearer token" {
+			t.Errorf("expect the bearer token, but got %v", watch.authorization)
+		}
+	})
+
+	t.Run("Handler_ForwardApiKey", func(t *testing.T) {
+		watch := &watchServer{requests: make(chan *pbTodoList.WatchTasksRequest, 1)}
+		server := newServer(t, watch, time.Hour)
+
+		request, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		request.Header.Set("X-Api-Key", "tdl_key")
+
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			t.Fatalf("expect no error, but got %v", err)
+		}
+		defer response.Body.Close()
+
+		<-watch.requests
+
+		if len(watch.apiKey) != 1 || watch.apiKey[0] != "tdl_key" {
+			t.Errorf("expect the api key, but got %v", watch.apiKey)
+		}
+	})
+
+	t.Run("Handler_WatchRefused", func(t *testing.T) {
+		watch := &watchServer{
+			err:      status.Error(codes.NotFound, "task not found"),
+			requests: make(chan *pbTodoList.WatchTasksRequest, 1),
+		}
+		server := newServer(t, watch, time.Hour)
+
+		response, err := http.Get(server.URL + "?task_id=aa54dc02-b5c4-4629-889e-ee64d3921483")
+		if err != nil {
+			t.Fatalf("expect no error, but got %v", err)
+		}
+		defer response.Body.Close()
+
+		if response.StatusCode != http.StatusNotFound {
+			t.Errorf("expect %d, but got %d", http.StatusNotFound, response.StatusCode)
+		}
+	})
+
+	t.Run("Handler_InvalidLastEventID", func(t *testing.T) {
+		server := newServer(t, &watchServer{}, time.Hour)
+
+		request, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+		request.Header.Set(sse.LastEventIDHeader, "abc")
+
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			t.Fatalf("expect no error, but got %v", err)
+		}
+		defer response.Body.Close()
+
+		if response.StatusCode != http.StatusBadRequest {
+			t.Errorf("expect %d, but got %d", http.StatusBadRequest, response.StatusCode)
+		}
+	})
+
+	t.Run("Handler_MethodNotAllowed", func(t *testing.T) {
+		server := newServer(t, &watchServer{}, time.Hour)
+
+		response, err := http.Post(server.URL, "application/json", nil)
+		if err != nil {
+			t.Fatalf("expect no error, but got %v", err)
+		}
+		defer response.Body.Close()
+
+		if response.StatusCode != http.StatusMethodNotAllowed {
+			t.Errorf("expect %d, but got %d", http.StatusMethodNotAllowed, response.StatusCode)
+		}
+	})
+}
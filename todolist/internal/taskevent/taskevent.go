@@ -0,0 +1,119 @@
+// Package taskevent maps the events of the outbox into the messages the watches and the webhooks send
+package taskevent
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+	pbTodoList "github.com/overridesh/sgg-todolist-service/proto"
+	"github.com/overridesh/sgg-todolist-service/tools"
+)
+
+// marshaler gives the data of the webhook deliveries the same JSON the gateway responds with
+var marshaler protojson.MarshalOptions = protojson.MarshalOptions{
+	UseProtoNames:   true,
+	EmitUnpopulated: true,
+}
+
+// Response maps an event of the outbox into the protobuf event of the watches
+func Response(event *model.Event) (*pbTodoList.TaskEvent, error) {
+	response := pbTodoList.TaskEvent{
+		Id:         event.Id.String(),
+		Sequence:   event.Sequence,
+		Event:      event.Type,
+		TaskId:     event.TaskId.String(),
+		OccurredAt: tools.FormatDate(event.OccurredAt),
+	}
+
+	// The data is raw JSON when the event is read back from the outbox
+	raw, err := json.Marshal(event.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch event.Type {
+	case model.EventTaskCreated, model.EventTaskUpdated, model.EventTaskCompleted, model.EventTaskRestored:
+		var data model.TaskData
+		if err := json.Unmarshal(raw, &data); err != nil {
+			return nil, err
+		}
+
+		task := pbTodoList.Task{
+			Id:         data.Id.String(),
+			Value:      data.Value,
+			Completed:  data.Completed,
+			CreatedAt:  tools.FormatDate(data.CreatedAt),
+			UpdatedAt:  tools.FormatDate(data.UpdatedAt),
+			Etag:       tools.FormatEtag(data.Version),
+			Priority:   pbTodoList.TaskPriority(data.Priority),
+			Position:   data.Position,
+			Recurrence: data.Recurrence,
+		}
+
+		if data.DueDate != nil {
+			task.DueDate = tools.FormatDate(*data.DueDate)
+		}
+
+		if data.ParentId != nil {
+			task.ParentId = data.ParentId.String()
+		}
+
+		response.Data = &pbTodoList.TaskEvent_Task{Task: &task}
+	case model.EventCommentCreated:
+		var data model.CommentData
+		if err := json.Unmarshal(raw, &data); err != nil {
+			return nil, err
+		}
+
+		response.Data = &pbTodoList.TaskEvent_Comment{Comment: &pbTodoList.Comment{
+			Id:        data.Id.String(),
+			Message:   data.Message,
+			CreatedAt: tools.FormatDate(data.CreatedAt),
+		}}
+	case model.EventLabelCreated:
+		var data model.LabelData
+		if err := json.Unmarshal(raw, &data); err != nil {
+			return nil, err
+		}
+
+		response.Data = &pbTodoList.TaskEvent_Label{Label: &pbTodoList.Label{
+			Id:        data.Id.String(),
+			Name:      data.Name,
+			CreatedAt: tools.FormatDate(data.CreatedAt),
+		}}
+	default:
+		var data model.DeletedData
+		if err := json.Unmarshal(raw, &data); err != nil {
+			return nil, err
+		}
+
+		response.Data = &pbTodoList.TaskEvent_DeletedId{DeletedId: data.Id.String()}
+	}
+
+	return &response, nil
+}
+
+// Data returns the JSON of the resource of an event, as the gateway responds with it, or {"id": "<id>"} when the
+// resource was deleted
+func Data(event *model.Event) (json.RawMessage, error) {
+	response, err := Response(event)
+	if err != nil {
+		return nil, err
+	}
+
+	switch data := response.Data.(type) {
+	case *pbTodoList.TaskEvent_Task:
+		return marshaler.Marshal(data.Task)
+	case *pbTodoList.TaskEvent_Comment:
+		return marshaler.Marshal(data.Comment)
+	case *pbTodoList.TaskEvent_Label:
+		return marshaler.Marshal(data.Label)
+	case *pbTodoList.TaskEvent_DeletedId:
+		return json.Marshal(map[string]string{"id": data.DeletedId})
+	}
+
+	return nil, fmt.Errorf("unknown data of %s event", event.Type)
+}
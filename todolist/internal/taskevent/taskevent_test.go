@@ -0,0 +1,97 @@
+package taskevent
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+	pbTodoList "github.com/overridesh/sgg-todolist-service/proto"
+)
+
+func TestResponse(t *testing.T) {
+	task := model.Task{
+		Id:        uuid.NewV4(),
+		Value:     "write the report",
+		Completed: true,
+		Version:   3,
+		Priority:  model.TaskPriorityHigh,
+	}
+
+	event := model.NewTaskEvent(model.EventTaskCompleted, &task)
+	event.Sequence = 7
+
+	response, err := Response(&event)
+	if err != nil {
+		t.Fatalf("expect no error, but got %v", err)
+	}
+
+	data, ok := response.Data.(*pbTodoList.TaskEvent_Task)
+	if !ok {
+		t.Fatalf("expect the task as the data, but got %T", response.Data)
+	}
+
+	if response.Sequence != 7 || response.Event != model.EventTaskCompleted || response.TaskId != task.Id.String() ||
+		data.Task.Value != task.Value || !data.Task.Completed || data.Task.Priority != pbTodoList.TaskPriority_TASK_PRIORITY_HIGH {
+		t.Errorf("unexpected response %v", response)
+	}
+
+	if _, err := Response(&model.Event{Type: model.EventCommentCreated, Data: json.RawMessage(`[]`)}); err == nil {
+		t.Error("expect an error for malformed data")
+	}
+}
+
+func TestData(t *testing.T) {
+	var (
+		taskId    uuid.UUID = uuid.NewV4()
+		commentId uuid.UUID = uuid.NewV4()
+		createdAt time.Time = time.Date(2022, 4, 4, 9, 30, 0, 0, time.UTC)
+	)
+
+	tests := []struct {
+		name   string
+		event  model.Event
+		expect string
+	}{
+		{
+			name: "Data_Comment",
+			event: model.NewCommentEvent(model.EventCommentCreated, &model.Comment{
+				Id:        commentId,
+				TaskId:    taskId,
+				Value:     "done by friday",
+				CreatedAt: createdAt,
+			}),
+			expect: `{"id":"` + commentId.String() + `","message":"done by friday","created_at":"2022-04-04T09:30:00.000Z"}`,
+		},
+		{
+			name:   "Data_Deleted",
+			event:  model.NewEvent(model.EventCommentDeleted, taskId, model.DeletedData{Id: commentId}),
+			expect: `{"id":"` + commentId.String() + `"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := Data(&tt.event)
+			if err != nil {
+				t.Fatalf("expect no error, but got %v", err)
+			}
+
+			var output, expect interface{}
+			if err := json.Unmarshal(data, &output); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal([]byte(tt.expect), &expect); err != nil {
+				t.Fatal(err)
+			}
+
+			outputJSON, _ := json.Marshal(output)
+			expectJSON, _ := json.Marshal(expect)
+			if string(outputJSON) != string(expectJSON) {
+				t.Errorf("expect %s, but got %s", expectJSON, outputJSON)
+			}
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package tracing
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+
+	storage "github.com/overridesh/sgg-todolist-service/pkg/storage/sql"
+)
+
+// db starts a child span for every call to a storage.DB
+type db struct {
+	storage.DB
+	tracer trace.Tracer
+}
+
+// NewDB decorates database with a span for every query, named by its statement, like SELECT tasks. The statements of
+// its transactions have a span too, and so do their BEGIN and their COMMIT or ROLLBACK.
+func NewDB(database storage.DB, provider trace.TracerProvider) storage.DB {
+	if provider == nil {
+		provider = otel.GetTracerProvider()
+	}
+
+	return &db{
+		DB:     database,
+		tracer: provider.Tracer(instrumentationName),
+	}
+}
+
+func (d *db) BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error) {
+	ctx, span := d.startOperation(ctx, "BEGIN")
+	defer span.End()
+
+	tx, err := d.DB.BeginTx(ctx, opts)
+	end(span, err)
+	return tx, err
+}
+
+func (d *db) ExecContext(ctx context.Context, query string, arguments ...interface{}) (sql.Result, error) {
+	return exec(ctx, d, d.DB, query, arguments)
+}
+
+func (d *db) QueryContext(ctx context.Context, query string, optionsAndArgs ...interface{}) (*sql.Rows, error) {
+	return queryRows(ctx, d, d.DB, query, optionsAndArgs)
+}
+
+// QueryRowContext ends the span with the error of the query, sql.ErrNoRows is only returned by the scan and is not one
+// of them
+func (d *db) QueryRowContext(ctx context.Context, query string, optionsAndArgs ...interface{}) *sql.Row {
+	return queryRow(ctx, d, d.DB, query, optionsAndArgs)
+}
+
+// DecorateTx starts a span for every statement of a transaction, its COMMIT or ROLLBACK is a child of the context it
+// began with
+func (d *db) DecorateTx(ctx context.Context, tx storage.Tx) storage.Tx {
+	if decorator, ok := d.DB.(storage.TxDecorator); ok {
+		tx = decorator.DecorateTx(ctx, tx)
+	}
+
+	return &dbTx{Tx: tx, db: d, ctx: ctx}
+}
+
+// dbTx starts a child span for every statement of a transaction like its db
+type dbTx struct {
+	storage.Tx
+	db  *db
+	ctx context.Context
+}
+
+func (t *dbTx) ExecContext(ctx context.Context, query string, arguments ...interface{}) (sql.Result, error) {
+	return exec(ctx, t.db, t.Tx, query, arguments)
+}
+
+func (t *dbTx) QueryContext(ctx context.Context, query string, optionsAndArgs ...interface{}) (*sql.Rows, error) {
+	return queryRows(ctx, t.db, t.Tx, query, optionsAndArgs)
+}
+
+func (t *dbTx) QueryRowContext(ctx context.Context, query string, optionsAndArgs ...interface{}) *sql.Row {
+	return queryRow(ctx, t.db, t.Tx, query, optionsAndArgs)
+}
+
+func (t *dbTx) Commit() error {
+	_, span := t.db.startOperation(t.ctx, "COMMIT")
+	defer span.End()
+
+	err := t.Tx.Commit()
+	end(span, err)
+	return err
+}
+
+func (t *dbTx) Rollback() error {
+	_, span := t.db.startOperation(t.ctx, "ROLLBACK")
+	defer span.End()
+
+	err := t.Tx.Rollback()
+	end(span, err)
+	return err
+}
+
+// querier runs the statements of a database or of a transaction
+type querier interface {
+	ExecContext(ctx context.Context, query string, arguments ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, optionsAndArgs ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, optionsAndArgs ...interface{}) *sql.Row
+}
+
+func exec(ctx context.Context, d *db, q querier, query string, arguments []interface{}) (sql.Result, error) {
+	ctx, span := d.start(ctx, query)
+	defer span.End()
+
+	result, err := q.ExecContext(ctx, query, arguments...)
+	end(span, err)
+	return result, err
+}
+
+func queryRows(ctx context.Context, d *db, q querier, query string, optionsAndArgs []interface{}) (*sql.Rows, error) {
+	ctx, span := d.start(ctx, query)
+	defer span.End()
+
+	rows, err := q.QueryContext(ctx, query, optionsAndArgs...)
+	end(span, err)
+	return rows, err
+}
+
+func queryRow(ctx context.Context, d *db, q querier, query string, optionsAndArgs []interface{}) *sql.Row {
+	ctx, span := d.start(ctx, query)
+	defer span.End()
+
+	row := q.QueryRowContext(ctx, query, optionsAndArgs...)
+	end(span, row.Err())
+	return row
+}
+
+// startOperation starts the span of an operation without a statement, like BEGIN
+func (d *db) startOperation(ctx context.Context, operation string) (context.Context, trace.Span) {
+	return d.tracer.Start(ctx, operation, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+		semconv.DBSystemPostgreSQL,
+		semconv.DBOperationKey.String(operation),
+	))
+}
+
+// start starts the span of query, the arguments are left out of it
+func (d *db) start(ctx context.Context, query string) (context.Context, trace.Span) {
+	operation, table := Statement(query)
+
+	attributes := []attribute.KeyValue{
+		semconv.DBSystemPostgreSQL,
+		semconv.DBStatementKey.String(query),
+		semconv.DBOperationKey.String(operation),
+	}
+	name := operation
+	if len(table) > 0 {
+		attributes = append(attributes, semconv.DBSQLTableKey.String(table))
+		name = operation + " " + table
+	}
+
+	return d.tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attributes...))
+}
+
+func end(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
+
+// Statement returns the operation of query and the table it runs on, like SELECT and tasks, the table is empty when it
+// is not found
+func Statement(query string) (string, string) {
+	fields := strings.Fields(query)
+	if len(fields) == 0 {
+		return "", ""
+	}
+
+	var (
+		operation string = strings.ToUpper(fields[0])
+		keyword   string
+	)
+	switch operation {
+	case "SELECT", "DELETE", "WITH":
+		keyword = "FROM"
+	case "INSERT":
+		keyword = "INTO"
+	case "UPDATE":
+		if len(fields) > 1 {
+			return operation, tableName(fields[1])
+		}
+		return operation, ""
+	default:
+		return operation, ""
+	}
+
+	for i := 1; i < len(fields)-1; i++ {
+		if strings.EqualFold(fields[i], keyword) && !strings.HasPrefix(fields[i+1], "(") {
+			return operation, tableName(fields[i+1])
+		}
+	}
+
+	return operation, ""
+}
+
+// tableName trims the punctuation around a table of a statement
+func tableName(field string) string {
+	return strings.Trim(field, "(),;\"")
+}
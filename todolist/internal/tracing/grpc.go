@@ -0,0 +1,33 @@
+package tracing
+
+import (
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+)
+
+// UnaryServerInterceptor continues the trace of the caller of every unary request, the handlers get its span in their
+// context
+func UnaryServerInterceptor(provider trace.TracerProvider) grpc.UnaryServerInterceptor {
+	return otelgrpc.UnaryServerInterceptor(grpcOptions(provider)...)
+}
+
+// StreamServerInterceptor continues the trace of the caller of every stream
+func StreamServerInterceptor(provider trace.TracerProvider) grpc.StreamServerInterceptor {
+	return otelgrpc.StreamServerInterceptor(grpcOptions(provider)...)
+}
+
+// DialOptions propagate the trace of the context of every call made with the connection
+func DialOptions(provider trace.TracerProvider) []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor(grpcOptions(provider)...)),
+		grpc.WithStreamInterceptor(otelgrpc.StreamClientInterceptor(grpcOptions(provider)...)),
+	}
+}
+
+func grpcOptions(provider trace.TracerProvider) []otelgrpc.Option {
+	return []otelgrpc.Option{
+		otelgrpc.WithTracerProvider(provider),
+		otelgrpc.WithPropagators(Propagator),
+	}
+}
@@ -0,0 +1,38 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+)
+
+// NewHandler starts the span of every request served by next, the trace of the caller is continued when it sends one
+func NewHandler(next http.Handler, provider trace.TracerProvider) http.Handler {
+	return otelhttp.NewHandler(next, "HTTP",
+		otelhttp.WithTracerProvider(provider),
+		otelhttp.WithPropagators(Propagator),
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return r.Method
+		}),
+	)
+}
+
+// Route is a metadata annotator of the gateway mux, it names the span of the request by the path pattern of the
+// matched rpc, like GET /api/v1/task/{id}. It adds no metadata.
+func Route(ctx context.Context, r *http.Request) metadata.MD {
+	pattern, ok := runtime.HTTPPathPattern(ctx)
+	if !ok {
+		return nil
+	}
+
+	span := trace.SpanFromContext(ctx)
+	span.SetName(r.Method + " " + pattern)
+	span.SetAttributes(semconv.HTTPRouteKey.String(pattern))
+
+	return nil
+}
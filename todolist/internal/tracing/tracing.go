@@ -0,0 +1,79 @@
+// Package tracing traces the requests with OpenTelemetry, from the gateway through the gRPC server into the database.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	// ExporterNone does not record the spans
+	ExporterNone string = "none"
+	// ExporterOTLP sends the spans to an OTLP collector over gRPC
+	ExporterOTLP string = "otlp"
+	// ExporterStdout writes the spans to the standard output, for local use
+	ExporterStdout string = "stdout"
+
+	// instrumentationName names the tracer of the spans started by this package
+	instrumentationName string = "github.com/overridesh/sgg-todolist-service/internal/tracing"
+)
+
+// Propagator carries the trace context and the baggage of a request across the services
+var Propagator propagation.TextMapPropagator = propagation.NewCompositeTextMapPropagator(
+	propagation.TraceContext{},
+	propagation.Baggage{},
+)
+
+// NewExporter creates the exporter of the spans. The OTLP collector is reached at endpoint, or at the endpoint of the
+// OTEL_EXPORTER_OTLP_ENDPOINT variable when it is empty, with TLS unless insecure. ExporterNone has no exporter.
+func NewExporter(ctx context.Context, exporter string, endpoint string, insecure bool) (sdktrace.SpanExporter, error) {
+	switch exporter {
+	case ExporterNone, "":
+		return nil, nil
+	case ExporterStdout:
+		return stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	case ExporterOTLP:
+		var options []otlptracegrpc.Option
+		if len(endpoint) > 0 {
+			options = append(options, otlptracegrpc.WithEndpoint(endpoint))
+		}
+		if insecure {
+			options = append(options, otlptracegrpc.WithInsecure())
+		} else {
+			options = append(options, otlptracegrpc.WithTLSCredentials(credentials.NewClientTLSFromCert(nil, "")))
+		}
+		return otlptracegrpc.New(ctx, options...)
+	}
+
+	return nil, fmt.Errorf("unknown tracing exporter %q, it must be otlp, stdout or none", exporter)
+}
+
+// NewProvider creates the tracer provider of serviceName, it samples sampleRatio of the traces that start in the
+// service and follows the decision of the caller for the others. Without exporter no span is recorded.
+func NewProvider(serviceName string, exporter sdktrace.SpanExporter, sampleRatio float64) *sdktrace.TracerProvider {
+	if exporter == nil {
+		return sdktrace.NewTracerProvider(sdktrace.WithSampler(sdktrace.NeverSample()))
+	}
+
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String(serviceName))),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio))),
+		sdktrace.WithBatcher(exporter),
+	)
+}
+
+// SetGlobal makes provider and Propagator the ones of the instrumentation that has no other
+func SetGlobal(provider *sdktrace.TracerProvider) {
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(Propagator)
+}
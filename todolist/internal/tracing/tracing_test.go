@@ -0,0 +1,241 @@
+package tracing_test
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/overridesh/sgg-todolist-service/internal/auth"
+	"github.com/overridesh/sgg-todolist-service/internal/grpc/healthcheck"
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+	"github.com/overridesh/sgg-todolist-service/internal/repository"
+	"github.com/overridesh/sgg-todolist-service/internal/tracing"
+	storage "github.com/overridesh/sgg-todolist-service/pkg/storage/sql"
+	pbTodoList "github.com/overridesh/sgg-todolist-service/proto"
+)
+
+// newRecorder returns a provider that samples every span and the recorder of its ended spans
+func newRecorder() (*sdktrace.TracerProvider, *tracetest.SpanRecorder) {
+	recorder := tracetest.NewSpanRecorder()
+	return sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)), recorder
+}
+
+func TestStatement(t *testing.T) {
+	tests := []struct {
+		query     string
+		operation string
+		table     string
+	}{
+		{"SELECT id, value FROM tasks WHERE id = $1", "SELECT", "tasks"},
+		{"INSERT INTO comments (task_id,value) VALUES ($1,$2) RETURNING id", "INSERT", "comments"},
+		{"UPDATE tasks SET completed = $1 WHERE id = $2", "UPDATE", "tasks"},
+		{"DELETE FROM labels WHERE id = $1", "DELETE", "labels"},
+		{"SELECT COUNT(*) FROM (SELECT id FROM tasks) AS t", "SELECT", "tasks"},
+		{"select 1", "SELECT", ""},
+		{"", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			operation, table := tracing.Statement(tt.query)
+			assert.Equal(t, tt.operation, operation)
+			assert.Equal(t, tt.table, table)
+		})
+	}
+}
+
+func TestDB(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id FROM tasks")).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM tasks")).WillReturnError(errors.New("connection reset"))
+
+	provider, recorder := newRecorder()
+	database := tracing.NewDB(db, provider)
+
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "GetTask")
+
+	var id int64
+	assert.NoError(t, database.QueryRowContext(ctx, "SELECT id FROM tasks").Scan(&id))
+	_, err = database.ExecContext(ctx, "DELETE FROM tasks")
+	assert.Error(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+	parent.End()
+
+	spans := recorder.Ended()
+	if !assert.Len(t, spans, 3) {
+		return
+	}
+
+	assert.Equal(t, "SELECT tasks", spans[0].Name())
+	assert.Equal(t, codes.Unset, spans[0].Status().Code)
+	assert.Equal(t, "DELETE tasks", spans[1].Name())
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+	assert.Equal(t, "connection reset", spans[1].Status().Description)
+
+	for _, span := range spans[:2] {
+		assert.Equal(t, parent.SpanContext().SpanID(), span.Parent().SpanID())
+		assert.Contains(t, span.Attributes(), semconv.DBSystemPostgreSQL)
+	}
+
+	t.Run("DB_Transaction", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta("UPDATE tasks SET position = 1")).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		ctx, parent := provider.Tracer("test").Start(context.Background(), "MoveTask")
+
+		tx, err := storage.BeginTx(ctx, database, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = tx.ExecContext(ctx, "UPDATE tasks SET position = 1")
+		assert.NoError(t, err)
+		assert.NoError(t, tx.Commit())
+		assert.NoError(t, mock.ExpectationsWereMet())
+		parent.End()
+
+		spans := recorder.Ended()[3:]
+		if !assert.Len(t, spans, 4) {
+			return
+		}
+
+		assert.Equal(t, "BEGIN", spans[0].Name())
+		assert.Equal(t, "UPDATE tasks", spans[1].Name())
+		assert.Equal(t, "COMMIT", spans[2].Name())
+
+		for _, span := range spans[:3] {
+			assert.Equal(t, parent.SpanContext().SpanID(), span.Parent().SpanID())
+		}
+	})
+
+	t.Run("DB_CreateTask", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO tasks")).WillReturnRows(sqlmock.NewRows([]string{
+			"id", "value", "completed", "due_date", "created_at", "updated_at", "deleted_at", "version", "parent_id",
+			"priority", "position", "recurrence",
+		}).AddRow(uuid.NewV4(), "buy milk", false, nil, time.Now(), time.Now(), nil, 1, nil, 0, 1024, ""))
+		mock.ExpectExec(regexp.QuoteMeta("SELECT pg_advisory_xact_lock")).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO events")).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		ctx, parent := provider.Tracer("test").Start(auth.NewContext(context.Background(), &auth.Identity{
+			Subject:  "user",
+			TenantId: "tenant",
+		}), "CreateTask")
+
+		_, err := repository.NewTaskRepository(database).CreateTask(ctx, model.Task{Value: "buy milk"})
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+		parent.End()
+
+		spans := recorder.Ended()[7:]
+		if !assert.Len(t, spans, 6) {
+			return
+		}
+
+		assert.Equal(t, "BEGIN", spans[0].Name())
+		assert.Equal(t, "INSERT tasks", spans[1].Name())
+		assert.Equal(t, "INSERT events", spans[3].Name())
+		assert.Equal(t, "COMMIT", spans[4].Name())
+
+		for _, span := range spans[:5] {
+			assert.Equal(t, parent.SpanContext().SpanID(), span.Parent().SpanID())
+		}
+	})
+}
+
+func TestTraceFromGatewayToServer(t *testing.T) {
+	provider, recorder := newRecorder()
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer(
+		grpc.UnaryInterceptor(tracing.UnaryServerInterceptor(provider)),
+		grpc.StreamInterceptor(tracing.StreamServerInterceptor(provider)),
+	)
+	pbTodoList.RegisterHealthcheckServiceServer(server, healthcheck.NewGRPC(nil))
+
+	go func() {
+		if err := server.Serve(listener); err != nil {
+			log.Fatal(err)
+		}
+	}()
+	defer server.Stop()
+
+	dialOptions := append([]grpc.DialOption{
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return listener.Dial()
+		}),
+	}, tracing.DialOptions(provider)...)
+
+	conn, err := grpc.DialContext(context.Background(), "", dialOptions...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	mux := runtime.NewServeMux(runtime.WithMetadata(tracing.Route))
+	if err := pbTodoList.RegisterHealthcheckServiceHandler(context.Background(), mux, conn); err != nil {
+		t.Fatal(err)
+	}
+
+	response := httptest.NewRecorder()
+	tracing.NewHandler(mux, provider).ServeHTTP(response, httptest.NewRequest(http.MethodGet, "/api/healthcheck", nil))
+	assert.Equal(t, http.StatusOK, response.Code)
+
+	spans := recorder.Ended()
+	if !assert.Len(t, spans, 3) {
+		return
+	}
+
+	// the spans end from the server to the gateway
+	var (
+		serverSpan  sdktrace.ReadOnlySpan = spans[0]
+		clientSpan  sdktrace.ReadOnlySpan = spans[1]
+		gatewaySpan sdktrace.ReadOnlySpan = spans[2]
+	)
+
+	assert.Equal(t, "GET /api/healthcheck", gatewaySpan.Name())
+	assert.Equal(t, "healthcheck.v1.HealthcheckService/GetHealthcheck", clientSpan.Name())
+	assert.Equal(t, "healthcheck.v1.HealthcheckService/GetHealthcheck", serverSpan.Name())
+
+	assert.Equal(t, gatewaySpan.SpanContext().TraceID(), serverSpan.SpanContext().TraceID())
+	assert.Equal(t, gatewaySpan.SpanContext().SpanID(), clientSpan.Parent().SpanID())
+	assert.Equal(t, clientSpan.SpanContext().SpanID(), serverSpan.Parent().SpanID())
+	assert.True(t, serverSpan.Parent().IsRemote())
+}
+
+func TestNewExporter(t *testing.T) {
+	exporter, err := tracing.NewExporter(context.Background(), tracing.ExporterNone, "", false)
+	assert.NoError(t, err)
+	assert.Nil(t, exporter)
+
+	exporter, err = tracing.NewExporter(context.Background(), tracing.ExporterStdout, "", false)
+	assert.NoError(t, err)
+	assert.NotNil(t, exporter)
+
+	_, err = tracing.NewExporter(context.Background(), "jaeger", "", false)
+	assert.EqualError(t, err, `unknown tracing exporter "jaeger", it must be otlp, stdout or none`)
+}
@@ -0,0 +1,106 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/url"
+	"syscall"
+)
+
+// ErrPrivateAddress is returned for the webhooks that reach an address that is not public
+var ErrPrivateAddress = errors.New("webhook address is not public")
+
+// blockedNetworks are the networks that are not public and are not told apart by the net.IP methods, like the shared
+// address space of the cloud metadata services
+var blockedNetworks []*net.IPNet = parseNetworks(
+	"0.0.0.0/8",
+	"100.64.0.0/10",
+	"192.0.0.0/24",
+	"198.18.0.0/15",
+	"64:ff9b::/96",
+)
+
+// Resolver looks up the addresses of a host, net.DefaultResolver is one
+type Resolver interface {
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+// Guard rejects the webhooks that reach a loopback, private, link-local or cloud metadata address, the service would
+// otherwise post to its own network on behalf of the callers
+type Guard struct {
+	resolver Resolver
+}
+
+// NewGuard initializes a guard that looks up the hosts of the webhooks with the resolver
+func NewGuard(resolver Resolver) *Guard {
+	return &Guard{
+		resolver: resolver,
+	}
+}
+
+// CheckURL checks every address of the host of a webhook is public, it is checked again when a delivery connects
+// since the addresses of a host can change
+func (g *Guard) CheckURL(ctx context.Context, webhookURL *url.URL) error {
+	host := webhookURL.Hostname()
+
+	if ip := net.ParseIP(host); ip != nil {
+		return checkIP(ip)
+	}
+
+	addrs, err := g.resolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return err
+	}
+
+	for _, addr := range addrs {
+		if err := checkIP(addr.IP); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// control rejects the connections of the deliveries to an address that is not public, it runs once the host was
+// resolved so it also covers the redirects and the hosts that changed their addresses
+func (g *Guard) control(network string, address string, conn syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return ErrPrivateAddress
+	}
+
+	return checkIP(ip)
+}
+
+func checkIP(ip net.IP) error {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() {
+		return ErrPrivateAddress
+	}
+
+	for _, network := range blockedNetworks {
+		if network.Contains(ip) {
+			return ErrPrivateAddress
+		}
+	}
+
+	return nil
+}
+
+func parseNetworks(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
@@ -0,0 +1,63 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/url"
+	"testing"
+)
+
+// fakeResolver resolves every host to its addresses
+type fakeResolver struct {
+	addrs []net.IPAddr
+	err   error
+}
+
+func (r fakeResolver) LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error) {
+	return r.addrs, r.err
+}
+
+func TestGuardCheckURL(t *testing.T) {
+	var (
+		errLookup error = errors.New("no such host")
+	)
+
+	tests := []struct {
+		name     string
+		url      string
+		resolver fakeResolver
+		expect   error
+	}{
+		{name: "CheckURL_PublicIP", url: "https://93.184.216.34/hooks"},
+		{name: "CheckURL_PublicHost", url: "https://example.com/hooks", resolver: fakeResolver{addrs: []net.IPAddr{{IP: net.ParseIP("93.184.216.34")}}}},
+		{name: "CheckURL_Loopback", url: "http://127.0.0.1:8080/hooks", expect: ErrPrivateAddress},
+		{name: "CheckURL_LoopbackV6", url: "http://[::1]/hooks", expect: ErrPrivateAddress},
+		{name: "CheckURL_Private", url: "http://10.0.0.12/hooks", expect: ErrPrivateAddress},
+		{name: "CheckURL_PrivateV6", url: "http://[fd00:ec2::254]/hooks", expect: ErrPrivateAddress},
+		{name: "CheckURL_Metadata", url: "http://169.254.169.254/latest/meta-data", expect: ErrPrivateAddress},
+		{name: "CheckURL_SharedMetadata", url: "http://100.100.100.200/latest/meta-data", expect: ErrPrivateAddress},
+		{name: "CheckURL_Unspecified", url: "http://0.0.0.0/hooks", expect: ErrPrivateAddress},
+		{name: "CheckURL_MappedLoopback", url: "http://[::ffff:127.0.0.1]/hooks", expect: ErrPrivateAddress},
+		{
+			name:     "CheckURL_HostOfPrivateAddress",
+			url:      "https://internal.example.com/hooks",
+			resolver: fakeResolver{addrs: []net.IPAddr{{IP: net.ParseIP("93.184.216.34")}, {IP: net.ParseIP("192.168.1.20")}}},
+			expect:   ErrPrivateAddress,
+		},
+		{name: "CheckURL_ErrLookup", url: "https://unknown.example.com/hooks", resolver: fakeResolver{err: errLookup}, expect: errLookup},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			webhookURL, err := url.Parse(tt.url)
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when parsing the url", err)
+			}
+
+			if err := NewGuard(tt.resolver).CheckURL(context.Background(), webhookURL); err != tt.expect {
+				t.Errorf("expect %v, but got %v", tt.expect, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+)
+
+const (
+	// SignatureHeader is the HMAC-SHA256 of the body with the secret of the webhook, as "sha256=<hex>"
+	SignatureHeader string = "X-Todolist-Signature"
+	EventHeader     string = "X-Todolist-Event"
+	DeliveryHeader  string = "X-Todolist-Delivery"
+
+	signaturePrefix string = "sha256="
+)
+
+// Sign returns the signature of a payload, the value of the SignatureHeader
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the signature of a payload in constant time, receivers can use it to authenticate a delivery
+func Verify(secret string, payload []byte, signature string) bool {
+	if !strings.HasPrefix(signature, signaturePrefix) {
+		return false
+	}
+
+	expected, err := hex.DecodeString(strings.TrimPrefix(signature, signaturePrefix))
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+// Sender posts the signed deliveries to the webhooks, any status but 2xx is an error
+type Sender struct {
+	client *http.Client
+}
+
+// NewSender initializes a sender that waits up to timeout for a response. With a guard the deliveries are posted
+// directly, without the proxy of the environment, and only connect to public addresses.
+func NewSender(timeout time.Duration, guard *Guard) *Sender {
+	client := &http.Client{
+		Timeout: timeout,
+	}
+
+	if guard != nil {
+		dialer := &net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+			Control:   guard.control,
+		}
+
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.Proxy = nil
+		transport.DialContext = dialer.DialContext
+		client.Transport = transport
+	}
+
+	return &Sender{
+		client: client,
+	}
+}
+
+// Deliver posts the payload of a delivery, returning the status code of the response when there is one
+func (s *Sender) Deliver(ctx context.Context, webhook *model.Webhook, delivery *model.WebhookDelivery) (int, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.Url, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(EventHeader, delivery.Event)
+	request.Header.Set(DeliveryHeader, delivery.Id.String())
+	request.Header.Set(SignatureHeader, Sign(webhook.Secret, delivery.Payload))
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+
+	// Drain the body so the connection can be reused
+	io.Copy(ioutil.Discard, response.Body)
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return response.StatusCode, fmt.Errorf("webhook responded with status %d", response.StatusCode)
+	}
+
+	return response.StatusCode, nil
+}
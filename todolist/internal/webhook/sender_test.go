@@ -0,0 +1,158 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	payload := []byte(`{"event":"task.created"}`)
+	signature := Sign("s3cr3t", payload)
+
+	tests := []struct {
+		name      string
+		secret    string
+		payload   []byte
+		signature string
+		expect    bool
+	}{
+		{
+			name:      "Verify_Success",
+			secret:    "s3cr3t",
+			payload:   payload,
+			signature: signature,
+			expect:    true,
+		},
+		{
+			name:      "Verify_WrongSecret",
+			secret:    "other",
+			payload:   payload,
+			signature: signature,
+			expect:    false,
+		},
+		{
+			name:      "Verify_TamperedPayload",
+			secret:    "s3cr3t",
+			payload:   []byte(`{"event":"task.deleted"}`),
+			signature: signature,
+			expect:    false,
+		},
+		{
+			name:      "Verify_WithoutPrefix",
+			secret:    "s3cr3t",
+			payload:   payload,
+			signature: signature[len(signaturePrefix):],
+			expect:    false,
+		},
+		{
+			name:      "Verify_NotHex",
+			secret:    "s3cr3t",
+			payload:   payload,
+			signature: "sha256=zz",
+			expect:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Verify(tt.secret, tt.payload, tt.signature); got != tt.expect {
+				t.Errorf("expect %v, but got %v", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestSenderDeliver(t *testing.T) {
+	webhook := model.Webhook{
+		Id:     uuid.NewV4(),
+		Secret: "s3cr3t",
+	}
+	delivery := model.WebhookDelivery{
+		Id:      uuid.NewV4(),
+		Event:   model.EventTaskCompleted,
+		Payload: []byte(`{"event":"task.completed","data":{"completed":true}}`),
+	}
+
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{
+			name:    "Deliver_Success",
+			status:  http.StatusOK,
+			wantErr: false,
+		},
+		{
+			name:    "Deliver_ErrStatus",
+			status:  http.StatusServiceUnavailable,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					t.Errorf("cannot read body, error: %v", err)
+				}
+				if !Verify(webhook.Secret, body, r.Header.Get(SignatureHeader)) {
+					t.Errorf("expect a valid signature, but got %s", r.Header.Get(SignatureHeader))
+				}
+				if r.Header.Get(EventHeader) != delivery.Event || r.Header.Get(DeliveryHeader) != delivery.Id.String() {
+					t.Errorf("unexpected headers %v", r.Header)
+				}
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			webhook.Url = server.URL
+
+			code, err := NewSender(time.Second, nil).Deliver(context.Background(), &webhook, &delivery)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expect error %v, but got %v", tt.wantErr, err)
+			}
+			if code != tt.status {
+				t.Errorf("expect status %d, but got %d", tt.status, code)
+			}
+		})
+	}
+
+	t.Run("Deliver_ErrUnreachable", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		server.Close()
+
+		webhook.Url = server.URL
+
+		code, err := NewSender(time.Second, nil).Deliver(context.Background(), &webhook, &delivery)
+		if err == nil || code != 0 {
+			t.Errorf("expect an error without status when the webhook is unreachable, but got %d %v", code, err)
+		}
+	})
+
+	t.Run("Deliver_ErrPrivateAddress", func(t *testing.T) {
+		var called bool
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+		}))
+		defer server.Close()
+
+		webhook.Url = server.URL
+
+		code, err := NewSender(time.Second, NewGuard(net.DefaultResolver)).Deliver(context.Background(), &webhook, &delivery)
+		if !errors.Is(err, ErrPrivateAddress) || code != 0 || called {
+			t.Errorf("expect %v without reaching the webhook, but got %d %v", ErrPrivateAddress, code, err)
+		}
+	})
+}
@@ -0,0 +1,78 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+	"github.com/overridesh/sgg-todolist-service/internal/publisher"
+	"github.com/overridesh/sgg-todolist-service/internal/repository"
+)
+
+// OutboxRelay publishes the events of the outbox, several replicas can run it at the same time. A failed event is
+// published again after the delay of backoff, however many attempts it takes.
+type OutboxRelay struct {
+	eventRepository repository.EventRepository
+	publisher       publisher.EventPublisher
+	backoff         Backoff
+	interval        time.Duration
+	batchSize       uint64
+}
+
+// NewOutboxRelay initializes a relay that looks for up to batchSize unpublished events every interval
+func NewOutboxRelay(eventRepository repository.EventRepository, publisher publisher.EventPublisher, backoff Backoff, interval time.Duration, batchSize int) *OutboxRelay {
+	return &OutboxRelay{
+		eventRepository: eventRepository,
+		publisher:       publisher,
+		backoff:         backoff,
+		interval:        interval,
+		batchSize:       uint64(batchSize),
+	}
+}
+
+// Run relays the events right away and then every interval, until the context is done
+func (r *OutboxRelay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := r.Relay(ctx); err != nil {
+			zap.S().Errorf("cannot relay events, error: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Relay publishes the unpublished events, a full batch of published events means there could be more of them so it
+// goes on. Failed events are left for the next run, they are not due until their backoff is over anyway.
+func (r *OutboxRelay) Relay(ctx context.Context) error {
+	for {
+		published, err := r.eventRepository.ProcessUnpublishedEvents(ctx, time.Now(), r.batchSize, r.publish, r.backoff.Delay)
+		if err != nil {
+			return err
+		}
+
+		if published > 0 {
+			zap.S().Infof("published %d events", published)
+		}
+
+		if uint64(published) < r.batchSize || ctx.Err() != nil {
+			return nil
+		}
+	}
+}
+
+func (r *OutboxRelay) publish(ctx context.Context, event *model.Event) error {
+	if err := r.publisher.Publish(ctx, event); err != nil {
+		zap.S().Errorf("cannot publish event %d, error: %v", event.Sequence, err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+	"github.com/overridesh/sgg-todolist-service/internal/repository"
+	mockRepository "github.com/overridesh/sgg-todolist-service/pkg/mock"
+)
+
+func TestOutboxRelayRelay(t *testing.T) {
+	var (
+		errProcess error = errors.New("cannot process events")
+	)
+
+	tests := []struct {
+		name   string
+		input  func() error
+		expect error
+	}{
+		{
+			name: "Relay_Success",
+			input: func() error {
+				event := model.Event{Id: uuid.NewV4(), Sequence: 1}
+
+				publisher := new(mockRepository.EventPublisher)
+				publisher.On("Publish", mock.Anything, &event).Return(nil)
+
+				eventRepository := new(mockRepository.EventRepository)
+				eventRepository.On("ProcessUnpublishedEvents", mock.Anything, mock.Anything, uint64(10), mock.Anything, mock.Anything).
+					Return(func(ctx context.Context, now time.Time, limit uint64, publish repository.PublishFunc, delay repository.DelayFunc) int {
+						if err := publish(ctx, &event); err != nil {
+							return 0
+						}
+						return 1
+					}, nil)
+
+				if err := NewOutboxRelay(eventRepository, publisher, Backoff{}, time.Minute, 10).Relay(context.Background()); err != nil {
+					return err
+				}
+
+				publisher.AssertNumberOfCalls(t, "Publish", 1)
+				return nil
+			},
+			expect: nil,
+		},
+		{
+			name: "Relay_SuccessFullBatch",
+			input: func() error {
+				eventRepository := new(mockRepository.EventRepository)
+				eventRepository.On("ProcessUnpublishedEvents", mock.Anything, mock.Anything, uint64(2), mock.Anything, mock.Anything).Return(2, nil).Once()
+				eventRepository.On("ProcessUnpublishedEvents", mock.Anything, mock.Anything, uint64(2), mock.Anything, mock.Anything).Return(0, nil).Once()
+
+				if err := NewOutboxRelay(eventRepository, new(mockRepository.EventPublisher), Backoff{}, time.Minute, 2).Relay(context.Background()); err != nil {
+					return err
+				}
+
+				eventRepository.AssertNumberOfCalls(t, "ProcessUnpublishedEvents", 2)
+				return nil
+			},
+			expect: nil,
+		},
+		{
+			name: "Relay_Err",
+			input: func() error {
+				eventRepository := new(mockRepository.EventRepository)
+				eventRepository.On("ProcessUnpublishedEvents", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(0, errProcess)
+
+				return NewOutboxRelay(eventRepository, new(mockRepository.EventPublisher), Backoff{}, time.Minute, 10).Relay(context.Background())
+			},
+			expect: errProcess,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.input(); err != tt.expect {
+				t.Errorf("expect %v, but got %v", tt.expect, err)
+			}
+		})
+	}
+}
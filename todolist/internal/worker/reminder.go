@@ -0,0 +1,65 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/overridesh/sgg-todolist-service/internal/notifier"
+	"github.com/overridesh/sgg-todolist-service/internal/repository"
+)
+
+// ReminderScheduler sends the due reminders to a notifier, several replicas can run it at the same time
+type ReminderScheduler struct {
+	reminderRepository repository.ReminderRepository
+	notifier           notifier.Notifier
+	interval           time.Duration
+	batchSize          uint64
+}
+
+// NewReminderScheduler initializes a scheduler that looks for up to batchSize due reminders every interval
+func NewReminderScheduler(reminderRepository repository.ReminderRepository, notifier notifier.Notifier, interval time.Duration, batchSize int) *ReminderScheduler {
+	return &ReminderScheduler{
+		reminderRepository: reminderRepository,
+		notifier:           notifier,
+		interval:           interval,
+		batchSize:          uint64(batchSize),
+	}
+}
+
+// Run dispatches the due reminders right away and then every interval, until the context is done
+func (s *ReminderScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.Dispatch(ctx); err != nil {
+			zap.S().Errorf("cannot dispatch reminders, error: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Dispatch sends the reminders that are due, a full batch means there could be more of them so it goes on
+func (s *ReminderScheduler) Dispatch(ctx context.Context) error {
+	for {
+		sent, err := s.reminderRepository.ProcessDueReminders(ctx, time.Now(), s.batchSize, s.notifier.Notify)
+		if err != nil {
+			return err
+		}
+
+		if sent > 0 {
+			zap.S().Infof("sent %d reminders", sent)
+		}
+
+		if uint64(sent) < s.batchSize || ctx.Err() != nil {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+	"github.com/overridesh/sgg-todolist-service/internal/repository"
+	mockRepository "github.com/overridesh/sgg-todolist-service/pkg/mock"
+)
+
+func TestReminderSchedulerDispatch(t *testing.T) {
+	var (
+		errProcess error = errors.New("cannot process reminders")
+	)
+
+	tests := []struct {
+		name   string
+		input  func() error
+		expect error
+	}{
+		{
+			name: "Dispatch_Success",
+			input: func() error {
+				reminder := model.Reminder{Id: uuid.NewV4()}
+				task := model.Task{Id: uuid.NewV4()}
+
+				notifier := new(mockRepository.Notifier)
+				notifier.On("Notify", mock.Anything, &reminder, &task).Return(nil)
+
+				reminderRepository := new(mockRepository.ReminderRepository)
+				reminderRepository.On("ProcessDueReminders", mock.Anything, mock.Anything, uint64(10), mock.Anything).
+					Return(func(ctx context.Context, now time.Time, limit uint64, notify repository.NotifyFunc) int {
+						if err := notify(ctx, &reminder, &task); err != nil {
+							return 0
+						}
+						return 1
+					}, nil)
+
+				if err := NewReminderScheduler(reminderRepository, notifier, time.Minute, 10).Dispatch(context.Background()); err != nil {
+					return err
+				}
+
+				notifier.AssertNumberOfCalls(t, "Notify", 1)
+				return nil
+			},
+			expect: nil,
+		},
+		{
+			name: "Dispatch_SuccessFullBatch",
+			input: func() error {
+				reminderRepository := new(mockRepository.ReminderRepository)
+				reminderRepository.On("ProcessDueReminders", mock.Anything, mock.Anything, uint64(2), mock.Anything).Return(2, nil).Once()
+				reminderRepository.On("ProcessDueReminders", mock.Anything, mock.Anything, uint64(2), mock.Anything).Return(0, nil).Once()
+
+				if err := NewReminderScheduler(reminderRepository, new(mockRepository.Notifier), time.Minute, 2).Dispatch(context.Background()); err != nil {
+					return err
+				}
+
+				// A full batch could leave due reminders behind, so it looks for more of them right away
+				reminderRepository.AssertNumberOfCalls(t, "ProcessDueReminders", 2)
+				return nil
+			},
+			expect: nil,
+		},
+		{
+			name: "Dispatch_Err",
+			input: func() error {
+				reminderRepository := new(mockRepository.ReminderRepository)
+				reminderRepository.On("ProcessDueReminders", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(0, errProcess)
+
+				return NewReminderScheduler(reminderRepository, new(mockRepository.Notifier), time.Minute, 10).Dispatch(context.Background())
+			},
+			expect: errProcess,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.input()
+			if err != tt.expect {
+				t.Errorf("expect values are equals, but got diferent, output: %v, expect: %v", err, tt.expect)
+			}
+		})
+	}
+}
+
+func TestReminderSchedulerRun(t *testing.T) {
+	reminderRepository := new(mockRepository.ReminderRepository)
+	reminderRepository.On("ProcessDueReminders", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(0, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		NewReminderScheduler(reminderRepository, new(mockRepository.Notifier), time.Millisecond, 10).Run(ctx)
+		close(done)
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expect the scheduler to stop when the context is done")
+	}
+
+	reminderRepository.AssertCalled(t, "ProcessDueReminders", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
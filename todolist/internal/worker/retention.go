@@ -0,0 +1,58 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/overridesh/sgg-todolist-service/internal/repository"
+)
+
+// RetentionJob permanently deletes the tasks, comments and labels that are in the trash for longer than the retention
+type RetentionJob struct {
+	taskRepository repository.TaskRepository
+	retention      time.Duration
+	interval       time.Duration
+}
+
+// NewRetentionJob initializes a job that keeps the deleted rows for the given days, checking every interval
+func NewRetentionJob(taskRepository repository.TaskRepository, days int, interval time.Duration) *RetentionJob {
+	return &RetentionJob{
+		taskRepository: taskRepository,
+		retention:      time.Duration(days) * 24 * time.Hour,
+		interval:       interval,
+	}
+}
+
+// Run purges the expired rows right away and then every interval, until the context is done
+func (j *RetentionJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := j.Purge(ctx); err != nil {
+			zap.S().Errorf("cannot purge deleted tasks, error: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Purge permanently deletes the rows deleted before the retention
+func (j *RetentionJob) Purge(ctx context.Context) error {
+	purged, err := j.taskRepository.PurgeDeletedTasks(ctx, time.Now().Add(-j.retention))
+	if err != nil {
+		return err
+	}
+
+	if purged > 0 {
+		zap.S().Infof("purged %d deleted rows", purged)
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	mockRepository "github.com/overridesh/sgg-todolist-service/pkg/mock"
+)
+
+func TestRetentionJobPurge(t *testing.T) {
+	var (
+		errPurge error = errors.New("cannot purge")
+	)
+
+	tests := []struct {
+		name   string
+		input  func() error
+		expect error
+	}{
+		{
+			name: "Purge_Success",
+			input: func() error {
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("PurgeDeletedTasks", mock.Anything, mock.MatchedBy(func(before time.Time) bool {
+					// 30 days of retention, with some margin for the test run
+					age := time.Since(before)
+					return age >= 30*24*time.Hour && age < 30*24*time.Hour+time.Minute
+				})).Return(int64(3), nil)
+
+				job := NewRetentionJob(taskRepository, 30, time.Hour)
+				return job.Purge(context.Background())
+			},
+			expect: nil,
+		},
+		{
+			name: "Purge_Err",
+			input: func() error {
+				taskRepository := new(mockRepository.TaskRepository)
+				taskRepository.On("PurgeDeletedTasks", mock.Anything, mock.Anything).Return(int64(0), errPurge)
+
+				job := NewRetentionJob(taskRepository, 30, time.Hour)
+				return job.Purge(context.Background())
+			},
+			expect: errPurge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.input()
+			if err != tt.expect {
+				t.Errorf("expect values are equals, but got diferent, output: %v, expect: %v", err, tt.expect)
+			}
+		})
+	}
+}
+
+func TestRetentionJobRun(t *testing.T) {
+	taskRepository := new(mockRepository.TaskRepository)
+	taskRepository.On("PurgeDeletedTasks", mock.Anything, mock.Anything).Return(int64(0), nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		NewRetentionJob(taskRepository, 1, time.Millisecond).Run(ctx)
+		close(done)
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expect the job to stop when the context is done")
+	}
+
+	taskRepository.AssertCalled(t, "PurgeDeletedTasks", mock.Anything, mock.Anything)
+}
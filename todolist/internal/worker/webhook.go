@@ -0,0 +1,98 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/overridesh/sgg-todolist-service/internal/repository"
+)
+
+// Backoff retries a failed delivery after Base, doubling the delay on every attempt up to Max.
+// A delivery is dead after MaxAttempts.
+type Backoff struct {
+	Base        time.Duration
+	Max         time.Duration
+	MaxAttempts int32
+}
+
+// Retry tells how long to wait after the given number of failed attempts, false when there are no attempts left
+func (b Backoff) Retry(attempts int32) (time.Duration, bool) {
+	if attempts >= b.MaxAttempts {
+		return 0, false
+	}
+
+	return b.Delay(attempts), true
+}
+
+// Delay tells how long to wait after the given number of failed attempts, however many there were
+func (b Backoff) Delay(attempts int32) time.Duration {
+	delay := b.Base
+	for i := int32(1); i < attempts && delay < b.Max; i++ {
+		delay *= 2
+	}
+
+	if delay > b.Max {
+		delay = b.Max
+	}
+
+	return delay
+}
+
+// WebhookDispatcher posts the pending webhook deliveries, several replicas can run it at the same time
+type WebhookDispatcher struct {
+	webhookRepository repository.WebhookRepository
+	deliver           repository.DeliverFunc
+	backoff           Backoff
+	interval          time.Duration
+	batchSize         uint64
+}
+
+// NewWebhookDispatcher initializes a dispatcher that looks for up to batchSize due deliveries every interval
+func NewWebhookDispatcher(webhookRepository repository.WebhookRepository, deliver repository.DeliverFunc, backoff Backoff, interval time.Duration, batchSize int) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		webhookRepository: webhookRepository,
+		deliver:           deliver,
+		backoff:           backoff,
+		interval:          interval,
+		batchSize:         uint64(batchSize),
+	}
+}
+
+// Run dispatches the due deliveries right away and then every interval, until the context is done
+func (d *WebhookDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := d.Dispatch(ctx); err != nil {
+			zap.S().Errorf("cannot dispatch webhook deliveries, error: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Dispatch posts the deliveries that are due, a full batch of successful deliveries means there could be more of them
+// so it goes on. Failed deliveries are left for the next run, they are not due until their backoff is over anyway.
+func (d *WebhookDispatcher) Dispatch(ctx context.Context) error {
+	for {
+		delivered, err := d.webhookRepository.ProcessDueDeliveries(ctx, time.Now(), d.batchSize, d.deliver, d.backoff.Retry)
+		if err != nil {
+			return err
+		}
+
+		if delivered > 0 {
+			zap.S().Infof("delivered %d webhook events", delivered)
+		}
+
+		if uint64(delivered) < d.batchSize || ctx.Err() != nil {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/overridesh/sgg-todolist-service/internal/model"
+	"github.com/overridesh/sgg-todolist-service/internal/repository"
+	"github.com/overridesh/sgg-todolist-service/internal/webhook"
+	mockRepository "github.com/overridesh/sgg-todolist-service/pkg/mock"
+)
+
+func TestBackoffRetry(t *testing.T) {
+	backoff := Backoff{
+		Base:        time.Second,
+		Max:         10 * time.Second,
+		MaxAttempts: 6,
+	}
+
+	tests := []struct {
+		attempts int32
+		delay    time.Duration
+		retry    bool
+	}{
+		{attempts: 1, delay: time.Second, retry: true},
+		{attempts: 2, delay: 2 * time.Second, retry: true},
+		{attempts: 3, delay: 4 * time.Second, retry: true},
+		{attempts: 4, delay: 8 * time.Second, retry: true},
+		{attempts: 5, delay: 10 * time.Second, retry: true},
+		{attempts: 6, delay: 0, retry: false},
+	}
+
+	for _, tt := range tests {
+		delay, retry := backoff.Retry(tt.attempts)
+		if delay != tt.delay || retry != tt.retry {
+			t.Errorf("attempts %d: expect %v %v, but got %v %v", tt.attempts, tt.delay, tt.retry, delay, retry)
+		}
+	}
+
+	// The delay has no limit of attempts
+	if delay := backoff.Delay(20); delay != 10*time.Second {
+		t.Errorf("expect %v, but got %v", 10*time.Second, delay)
+	}
+}
+
+func TestWebhookDispatcherDispatch(t *testing.T) {
+	var (
+		errProcess error   = errors.New("cannot process deliveries")
+		backoff    Backoff = Backoff{Base: time.Second, Max: time.Minute, MaxAttempts: 3}
+	)
+
+	tests := []struct {
+		name   string
+		input  func() error
+		expect error
+	}{
+		{
+			name: "Dispatch_SuccessToReceiver",
+			input: func() error {
+				var received int
+
+				receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					received++
+					w.WriteHeader(http.StatusNoContent)
+				}))
+				defer receiver.Close()
+
+				target := model.Webhook{Id: uuid.NewV4(), Url: receiver.URL, Secret: "s3cr3t"}
+				delivery := model.WebhookDelivery{Id: uuid.NewV4(), WebhookId: target.Id, Payload: []byte(`{}`)}
+
+				webhookRepository := new(mockRepository.WebhookRepository)
+				webhookRepository.On("ProcessDueDeliveries", mock.Anything, mock.Anything, uint64(10), mock.Anything, mock.Anything).
+					Return(func(ctx context.Context, now time.Time, limit uint64, deliver repository.DeliverFunc, retry repository.RetryFunc) int {
+						if _, err := deliver(ctx, &target, &delivery); err != nil {
+							return 0
+						}
+						return 1
+					}, nil)
+
+				dispatcher := NewWebhookDispatcher(webhookRepository, webhook.NewSender(time.Second, nil).Deliver, backoff, time.Minute, 10)
+				if err := dispatcher.Dispatch(context.Background()); err != nil {
+					return err
+				}
+
+				if received != 1 {
+					t.Errorf("expect the receiver to get 1 delivery, but got %d", received)
+				}
+				return nil
+			},
+			expect: nil,
+		},
+		{
+			name: "Dispatch_SuccessFullBatch",
+			input: func() error {
+				webhookRepository := new(mockRepository.WebhookRepository)
+				webhookRepository.On("ProcessDueDeliveries", mock.Anything, mock.Anything, uint64(2), mock.Anything, mock.Anything).Return(2, nil).Once()
+				webhookRepository.On("ProcessDueDeliveries", mock.Anything, mock.Anything, uint64(2), mock.Anything, mock.Anything).Return(1, nil).Once()
+
+				if err := NewWebhookDispatcher(webhookRepository, nil, backoff, time.Minute, 2).Dispatch(context.Background()); err != nil {
+					return err
+				}
+
+				webhookRepository.AssertNumberOfCalls(t, "ProcessDueDeliveries", 2)
+				return nil
+			},
+			expect: nil,
+		},
+		{
+			name: "Dispatch_Err",
+			input: func() error {
+				webhookRepository := new(mockRepository.WebhookRepository)
+				webhookRepository.On("ProcessDueDeliveries", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(0, errProcess)
+
+				return NewWebhookDispatcher(webhookRepository, nil, backoff, time.Minute, 10).Dispatch(context.Background())
+			},
+			expect: errProcess,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.input(); err != tt.expect {
+				t.Errorf("expect %v, but got %v", tt.expect, err)
+			}
+		})
+	}
+}
@@ -8,6 +8,10 @@ import (
 	model "github.com/overridesh/sgg-todolist-service/internal/model"
 	mock "github.com/stretchr/testify/mock"
 
+	sql "database/sql"
+
+	time "time"
+
 	uuid "github.com/satori/go.uuid"
 )
 
@@ -16,6 +20,27 @@ type TaskRepository struct {
 	mock.Mock
 }
 
+// CountTasks provides a mock function with given fields: _a0, _a1
+func (_m *TaskRepository) CountTasks(_a0 context.Context, _a1 model.TaskFilter) (int64, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, model.TaskFilter) int64); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, model.TaskFilter) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateTask provides a mock function with given fields: _a0, _a1
 func (_m *TaskRepository) CreateTask(_a0 context.Context, _a1 model.Task) (*model.Task, error) {
 	ret := _m.Called(_a0, _a1)
@@ -39,13 +64,13 @@ func (_m *TaskRepository) CreateTask(_a0 context.Context, _a1 model.Task) (*mode
 	return r0, r1
 }
 
-// DeleteTask provides a mock function with given fields: _a0, _a1
-func (_m *TaskRepository) DeleteTask(_a0 context.Context, _a1 uuid.UUID) error {
-	ret := _m.Called(_a0, _a1)
+// DeleteTask provides a mock function with given fields: _a0, _a1, _a2
+func (_m *TaskRepository) DeleteTask(_a0 context.Context, _a1 uuid.UUID, _a2 sql.NullInt64) error {
+	ret := _m.Called(_a0, _a1, _a2)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, sql.NullInt64) error); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -53,6 +78,73 @@ func (_m *TaskRepository) DeleteTask(_a0 context.Context, _a1 uuid.UUID) error {
 	return r0
 }
 
+// GetDeletedTasks provides a mock function with given fields: ctx, page, pageSize
+func (_m *TaskRepository) GetDeletedTasks(ctx context.Context, page int32, pageSize uint64) ([]*model.Task, error) {
+	ret := _m.Called(ctx, page, pageSize)
+
+	var r0 []*model.Task
+	if rf, ok := ret.Get(0).(func(context.Context, int32, uint64) []*model.Task); ok {
+		r0 = rf(ctx, page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Task)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int32, uint64) error); ok {
+		r1 = rf(ctx, page, pageSize)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSubtaskHeight provides a mock function with given fields: _a0, _a1
+func (_m *TaskRepository) GetSubtaskHeight(_a0 context.Context, _a1 uuid.UUID) (int, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) int); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSubtasks provides a mock function with given fields: ctx, parentId
+func (_m *TaskRepository) GetSubtasks(ctx context.Context, parentId uuid.UUID) ([]*model.Task, error) {
+	ret := _m.Called(ctx, parentId)
+
+	var r0 []*model.Task
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*model.Task); ok {
+		r0 = rf(ctx, parentId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Task)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, parentId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTask provides a mock function with given fields: _a0, _a1
 func (_m *TaskRepository) GetTask(_a0 context.Context, _a1 uuid.UUID) (*model.Task, error) {
 	ret := _m.Called(_a0, _a1)
@@ -76,12 +168,35 @@ func (_m *TaskRepository) GetTask(_a0 context.Context, _a1 uuid.UUID) (*model.Ta
 	return r0, r1
 }
 
+// GetTaskAncestors provides a mock function with given fields: _a0, _a1
+func (_m *TaskRepository) GetTaskAncestors(_a0 context.Context, _a1 uuid.UUID) ([]uuid.UUID, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []uuid.UUID
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []uuid.UUID); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTasks provides a mock function with given fields: _a0, _a1
-func (_m *TaskRepository) GetTasks(_a0 context.Context, _a1 int32) ([]*model.Task, error) {
+func (_m *TaskRepository) GetTasks(_a0 context.Context, _a1 model.TaskFilter) ([]*model.Task, error) {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 []*model.Task
-	if rf, ok := ret.Get(0).(func(context.Context, int32) []*model.Task); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, model.TaskFilter) []*model.Task); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
@@ -90,7 +205,7 @@ func (_m *TaskRepository) GetTasks(_a0 context.Context, _a1 int32) ([]*model.Tas
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, model.TaskFilter) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -99,12 +214,56 @@ func (_m *TaskRepository) GetTasks(_a0 context.Context, _a1 int32) ([]*model.Tas
 	return r0, r1
 }
 
-// UpdateTask provides a mock function with given fields: _a0, _a1
-func (_m *TaskRepository) UpdateTask(_a0 context.Context, _a1 *model.Task) error {
+// MoveTask provides a mock function with given fields: ctx, id, targetId, after
+func (_m *TaskRepository) MoveTask(ctx context.Context, id uuid.UUID, targetId uuid.UUID, after bool) (*model.Task, error) {
+	ret := _m.Called(ctx, id, targetId, after)
+
+	var r0 *model.Task
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, bool) *model.Task); ok {
+		r0 = rf(ctx, id, targetId, after)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Task)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, bool) error); ok {
+		r1 = rf(ctx, id, targetId, after)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurgeDeletedTasks provides a mock function with given fields: ctx, before
+func (_m *TaskRepository) PurgeDeletedTasks(ctx context.Context, before time.Time) (int64, error) {
+	ret := _m.Called(ctx, before)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = rf(ctx, before)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurgeTask provides a mock function with given fields: _a0, _a1
+func (_m *TaskRepository) PurgeTask(_a0 context.Context, _a1 uuid.UUID) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.Task) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -112,3 +271,63 @@ func (_m *TaskRepository) UpdateTask(_a0 context.Context, _a1 *model.Task) error
 
 	return r0
 }
+
+// RestoreTask provides a mock function with given fields: _a0, _a1
+func (_m *TaskRepository) RestoreTask(_a0 context.Context, _a1 uuid.UUID) (*model.Task, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *model.Task
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *model.Task); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Task)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchTasks provides a mock function with given fields: _a0, _a1
+func (_m *TaskRepository) SearchTasks(_a0 context.Context, _a1 model.TaskSearch) ([]*model.TaskSearchResult, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []*model.TaskSearchResult
+	if rf, ok := ret.Get(0).(func(context.Context, model.TaskSearch) []*model.TaskSearchResult); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.TaskSearchResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, model.TaskSearch) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateTask provides a mock function with given fields: _a0, _a1, _a2
+func (_m *TaskRepository) UpdateTask(_a0 context.Context, _a1 *model.Task, _a2 model.TaskUpdate) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Task, model.TaskUpdate) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
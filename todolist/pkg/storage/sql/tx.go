@@ -0,0 +1,52 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+)
+
+// Tx is a transaction of a DB, *sql.Tx is one
+type Tx interface {
+	ExecContext(ctx context.Context, sql string, arguments ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, sql string, optionsAndArgs ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, sql string, optionsAndArgs ...interface{}) *sql.Row
+	Commit() error
+	Rollback() error
+}
+
+// TxDecorator is a decorator of a DB that also decorates the statements of its transactions, ctx is the context the
+// transaction began with
+type TxDecorator interface {
+	DecorateTx(ctx context.Context, tx Tx) Tx
+}
+
+// BeginTx starts a transaction of db, its statements run on the decorators of db that are a TxDecorator
+func BeginTx(ctx context.Context, db DB, opts *sql.TxOptions) (Tx, error) {
+	tx, err := db.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if decorator, ok := db.(TxDecorator); ok {
+		return decorator.DecorateTx(ctx, tx), nil
+	}
+
+	return tx, nil
+}
+
+// methodKey is the context key for the repository method of the statements
+type methodKey struct{}
+
+// WithMethod returns a copy of ctx where the statements are run by the repository method, like
+// taskRepository.GetTask
+func WithMethod(ctx context.Context, method string) context.Context {
+	return context.WithValue(ctx, methodKey{}, method)
+}
+
+// Method returns the repository method that runs the statements of ctx, it is empty outside of one
+func Method(ctx context.Context) string {
+	if method, ok := ctx.Value(methodKey{}).(string); ok {
+		return method
+	}
+	return ""
+}
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION bump_task_version() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP <> 'DELETE' THEN
+        UPDATE tasks SET version = version + 1 WHERE id = NEW.task_id AND deleted_at IS NULL;
+    ELSE
+        UPDATE tasks SET version = version + 1 WHERE id = OLD.task_id AND deleted_at IS NULL;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+-- +goose StatementBegin
+CREATE TRIGGER comments_bump_task_version AFTER INSERT OR UPDATE OR DELETE ON comments FOR EACH ROW EXECUTE PROCEDURE bump_task_version();
+CREATE TRIGGER labels_bump_task_version AFTER INSERT OR UPDATE OR DELETE ON labels FOR EACH ROW EXECUTE PROCEDURE bump_task_version();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS labels_bump_task_version ON labels;
+DROP TRIGGER IF EXISTS comments_bump_task_version ON comments;
+DROP FUNCTION IF EXISTS bump_task_version();
+-- +goose StatementEnd
@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION bump_parent_task_version() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'UPDATE'
+        AND OLD.version = NEW.version
+        AND OLD.parent_id IS NOT DISTINCT FROM NEW.parent_id
+        AND OLD.deleted_at IS NOT DISTINCT FROM NEW.deleted_at THEN
+        RETURN NULL;
+    END IF;
+
+    IF TG_OP <> 'DELETE' AND NEW.parent_id IS NOT NULL THEN
+        UPDATE tasks SET version = version + 1 WHERE id = NEW.parent_id AND deleted_at IS NULL;
+    END IF;
+    IF TG_OP = 'DELETE' OR (TG_OP = 'UPDATE' AND OLD.parent_id IS DISTINCT FROM NEW.parent_id) THEN
+        UPDATE tasks SET version = version + 1 WHERE id = OLD.parent_id AND deleted_at IS NULL;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+-- +goose StatementBegin
+CREATE TRIGGER tasks_bump_parent_version AFTER INSERT OR UPDATE OR DELETE ON tasks FOR EACH ROW EXECUTE PROCEDURE bump_parent_task_version();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS tasks_bump_parent_version ON tasks;
+DROP FUNCTION IF EXISTS bump_parent_task_version();
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS priority SMALLINT NOT NULL DEFAULT 0;
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS position DOUBLE PRECISION;
+UPDATE tasks SET position = ordered.row_number * 1024
+FROM (SELECT id, ROW_NUMBER() OVER (ORDER BY created_at, id) AS row_number FROM tasks) AS ordered
+WHERE tasks.id = ordered.id;
+ALTER TABLE tasks ALTER COLUMN position SET NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_tasks_priority ON tasks (priority);
+CREATE INDEX IF NOT EXISTS idx_tasks_position ON tasks (position);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_tasks_position;
+DROP INDEX IF EXISTS idx_tasks_priority;
+ALTER TABLE tasks DROP COLUMN IF EXISTS position;
+ALTER TABLE tasks DROP COLUMN IF EXISTS priority;
+-- +goose StatementEnd
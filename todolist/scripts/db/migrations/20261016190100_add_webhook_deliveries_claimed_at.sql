@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A replica claims the due deliveries before posting them, the claim expires when the replica stops before recording
+ALTER TABLE webhook_deliveries ADD COLUMN IF NOT EXISTS claimed_at TIMESTAMPTZ;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE webhook_deliveries DROP COLUMN IF EXISTS claimed_at;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS events (
+    sequence BIGSERIAL,
+    id UUID NOT NULL,
+    type TEXT NOT NULL,
+    task_id UUID NOT NULL,
+    data JSONB NOT NULL,
+    occurred_at TIMESTAMPTZ NOT NULL,
+    published_at TIMESTAMPTZ,
+    PRIMARY KEY (sequence),
+    CONSTRAINT UQ_events_id UNIQUE (id)
+);
+CREATE INDEX IF NOT EXISTS idx_events_unpublished ON events (sequence) WHERE published_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS events;
+-- +goose StatementEnd
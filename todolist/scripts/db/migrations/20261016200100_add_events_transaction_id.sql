@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The events are read in the order of transaction_id and sequence, only once the transactions before them ended. The
+-- events written before are all read first.
+ALTER TABLE events ADD COLUMN IF NOT EXISTS transaction_id BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE events ALTER COLUMN transaction_id DROP DEFAULT;
+CREATE INDEX IF NOT EXISTS idx_events_position ON events (transaction_id, sequence);
+CREATE INDEX IF NOT EXISTS idx_events_task_id ON events (task_id, transaction_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_events_task_id;
+DROP INDEX IF EXISTS idx_events_position;
+ALTER TABLE events DROP COLUMN IF EXISTS transaction_id;
+-- +goose StatementEnd
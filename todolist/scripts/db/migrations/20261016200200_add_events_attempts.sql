@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A replica claims the events before publishing them, a failed event is published again at next_attempt_at and the
+-- later events of its task wait for it
+ALTER TABLE events ADD COLUMN IF NOT EXISTS attempts INT NOT NULL DEFAULT 0;
+ALTER TABLE events ADD COLUMN IF NOT EXISTS next_attempt_at TIMESTAMPTZ;
+ALTER TABLE events ADD COLUMN IF NOT EXISTS claimed_at TIMESTAMPTZ;
+CREATE INDEX IF NOT EXISTS idx_events_unpublished_task_id ON events (task_id, sequence) WHERE published_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_events_unpublished_task_id;
+ALTER TABLE events DROP COLUMN IF EXISTS claimed_at;
+ALTER TABLE events DROP COLUMN IF EXISTS next_attempt_at;
+ALTER TABLE events DROP COLUMN IF EXISTS attempts;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The existing tasks and events are backfilled to the default owner and tenant, the new ones always get the caller
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS owner_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE tasks ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE tasks ALTER COLUMN owner_id DROP DEFAULT;
+DROP INDEX IF EXISTS idx_tasks_created_at_id;
+CREATE INDEX IF NOT EXISTS idx_tasks_tenant_owner_created_at_id ON tasks (tenant_id, owner_id, created_at, id) WHERE deleted_at IS NULL;
+ALTER TABLE events ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE events ADD COLUMN IF NOT EXISTS owner_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE events ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE events ALTER COLUMN owner_id DROP DEFAULT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE events DROP COLUMN IF EXISTS owner_id;
+ALTER TABLE events DROP COLUMN IF EXISTS tenant_id;
+DROP INDEX IF EXISTS idx_tasks_tenant_owner_created_at_id;
+CREATE INDEX IF NOT EXISTS idx_tasks_created_at_id ON tasks (created_at, id) WHERE deleted_at IS NULL;
+ALTER TABLE tasks DROP COLUMN IF EXISTS owner_id;
+ALTER TABLE tasks DROP COLUMN IF EXISTS tenant_id;
+-- +goose StatementEnd
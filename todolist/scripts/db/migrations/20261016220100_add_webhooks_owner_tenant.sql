@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The existing webhooks and deliveries are backfilled to the default owner and tenant, the new ones always get the caller
+ALTER TABLE webhooks ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE webhooks ADD COLUMN IF NOT EXISTS owner_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE webhooks ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE webhooks ALTER COLUMN owner_id DROP DEFAULT;
+CREATE INDEX IF NOT EXISTS idx_webhooks_tenant_owner ON webhooks (tenant_id, owner_id) WHERE deleted_at IS NULL;
+ALTER TABLE webhook_deliveries ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE webhook_deliveries ADD COLUMN IF NOT EXISTS owner_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE webhook_deliveries ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE webhook_deliveries ALTER COLUMN owner_id DROP DEFAULT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE webhook_deliveries DROP COLUMN IF EXISTS owner_id;
+ALTER TABLE webhook_deliveries DROP COLUMN IF EXISTS tenant_id;
+DROP INDEX IF EXISTS idx_webhooks_tenant_owner;
+ALTER TABLE webhooks DROP COLUMN IF EXISTS owner_id;
+ALTER TABLE webhooks DROP COLUMN IF EXISTS tenant_id;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS task_members (
+    task_id UUID NOT NULL,
+    member_id TEXT NOT NULL,
+    role TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (task_id, member_id),
+    CONSTRAINT FK_task_id FOREIGN KEY (task_id)
+    REFERENCES tasks(id) ON DELETE CASCADE,
+    CONSTRAINT CHK_role CHECK (role IN ('viewer', 'commenter', 'editor'))
+);
+CREATE INDEX IF NOT EXISTS idx_task_members_member_id ON task_members (member_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS task_members;
+-- +goose StatementEnd
@@ -0,0 +1,66 @@
+package tools
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Metadata keys used for conditional requests, the gateway maps the http headers into them
+const (
+	EtagHeader        string = "etag"
+	IfMatchHeader     string = "if-match"
+	IfNoneMatchHeader string = "if-none-match"
+)
+
+var ErrInvalidEtag = errors.New("invalid etag")
+
+// FormatEtag build a strong etag from a version
+func FormatEtag(version int64) string {
+	return fmt.Sprintf("%q", strconv.FormatInt(version, 10))
+}
+
+// ParseEtag get the version from an etag, "*" matches any version
+func ParseEtag(etag string) (sql.NullInt64, error) {
+	var version sql.NullInt64
+
+	etag = strings.TrimSpace(etag)
+	if len(etag) == 0 || etag == "*" {
+		return version, nil
+	}
+
+	value, err := strconv.ParseInt(strings.Trim(strings.TrimPrefix(etag, "W/"), `"`), 10, 64)
+	if err != nil {
+		return version, ErrInvalidEtag
+	}
+
+	version.Int64 = value
+	version.Valid = true
+
+	return version, nil
+}
+
+// GetMetadata get the first value of an incoming metadata key
+func GetMetadata(ctx context.Context, key string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
+
+// SetEtag send the etag of the resource as header
+func SetEtag(ctx context.Context, etag string) error {
+	return grpc.SetHeader(ctx, metadata.Pairs(EtagHeader, etag))
+}
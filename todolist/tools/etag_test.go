@@ -0,0 +1,75 @@
+package tools
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+)
+
+func TestParseEtag(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		wantValid bool
+		wantErr   bool
+	}{
+		{
+			name:      "ParseEtag_Empty",
+			input:     "",
+			wantValid: false,
+			wantErr:   false,
+		},
+		{
+			name:      "ParseEtag_Wildcard",
+			input:     "*",
+			wantValid: false,
+			wantErr:   false,
+		},
+		{
+			name:      "ParseEtag_Success",
+			input:     FormatEtag(3),
+			wantValid: true,
+			wantErr:   false,
+		},
+		{
+			name:      "ParseEtag_Weak",
+			input:     `W/"3"`,
+			wantValid: true,
+			wantErr:   false,
+		},
+		{
+			name:      "ParseEtag_Invalid",
+			input:     `"abc"`,
+			wantValid: false,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := ParseEtag(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expect error %v, but got %v", tt.wantErr, err)
+			}
+			if output.Valid != tt.wantValid {
+				t.Errorf("expect valid %v, but got %v", tt.wantValid, output.Valid)
+			}
+			if output.Valid && output.Int64 != 3 {
+				t.Errorf("expect version 3, but got %d", output.Int64)
+			}
+		})
+	}
+}
+
+func TestGetMetadata(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(IfMatchHeader, FormatEtag(1)))
+
+	if GetMetadata(ctx, IfMatchHeader) != FormatEtag(1) {
+		t.Errorf("expect %s, but got %s", FormatEtag(1), GetMetadata(ctx, IfMatchHeader))
+	}
+
+	if GetMetadata(context.Background(), IfMatchHeader) != "" {
+		t.Error("expect empty value without metadata")
+	}
+}
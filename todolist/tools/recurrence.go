@@ -0,0 +1,55 @@
+package tools
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/teambition/rrule-go"
+)
+
+var ErrInvalidRecurrence = errors.New("invalid recurrence")
+
+// ParseRecurrence validate an RFC 5545 recurrence rule and anchor it at start when it has no DTSTART,
+// the result keeps the DTSTART so COUNT and UNTIL apply to the whole series and not to each occurrence
+func ParseRecurrence(rule string, start time.Time) (string, error) {
+	option, err := rrule.StrToROption(strings.TrimSpace(rule))
+	if err != nil {
+		return "", ErrInvalidRecurrence
+	}
+
+	if option.Dtstart.IsZero() {
+		option.Dtstart = start.UTC().Truncate(time.Second)
+	}
+
+	recurrence, err := rrule.NewRRule(*option)
+	if err != nil {
+		return "", ErrInvalidRecurrence
+	}
+
+	return recurrence.String(), nil
+}
+
+// NextOccurrences get up to count occurrences of a recurrence rule after a date,
+// less of them when the rule ends before
+func NextOccurrences(rule string, after time.Time, count int) ([]time.Time, error) {
+	recurrence, err := rrule.StrToRRule(rule)
+	if err != nil {
+		return nil, ErrInvalidRecurrence
+	}
+
+	var occurrences []time.Time
+
+	next := recurrence.Iterator()
+	for len(occurrences) < count {
+		occurrence, ok := next()
+		if !ok {
+			break
+		}
+		if occurrence.After(after) {
+			occurrences = append(occurrences, occurrence)
+		}
+	}
+
+	return occurrences, nil
+}
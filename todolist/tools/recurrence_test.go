@@ -0,0 +1,114 @@
+package tools
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseRecurrence(t *testing.T) {
+	start := time.Date(2022, time.April, 4, 9, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr error
+	}{
+		{
+			name:  "ParseRecurrence_AnchoredAtStart",
+			input: "FREQ=WEEKLY;BYDAY=MO",
+			want:  "DTSTART:20220404T093000Z\nRRULE:FREQ=WEEKLY;BYDAY=MO",
+		},
+		{
+			name:  "ParseRecurrence_WithPrefix",
+			input: "RRULE:FREQ=MONTHLY;COUNT=3",
+			want:  "DTSTART:20220404T093000Z\nRRULE:FREQ=MONTHLY;COUNT=3",
+		},
+		{
+			name:  "ParseRecurrence_KeepDtstart",
+			input: "DTSTART:20220101T080000Z\nRRULE:FREQ=DAILY",
+			want:  "DTSTART:20220101T080000Z\nRRULE:FREQ=DAILY",
+		},
+		{
+			name:    "ParseRecurrence_ErrWithoutFreq",
+			input:   "BYDAY=MO",
+			wantErr: ErrInvalidRecurrence,
+		},
+		{
+			name:    "ParseRecurrence_ErrUnknownProperty",
+			input:   "FREQ=WEEKLY;EVERY=MO",
+			wantErr: ErrInvalidRecurrence,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := ParseRecurrence(tt.input, start)
+			if err != tt.wantErr {
+				t.Errorf("expect error %v, but got %v", tt.wantErr, err)
+			}
+			if output != tt.want {
+				t.Errorf("expect %q, but got %q", tt.want, output)
+			}
+		})
+	}
+}
+
+func TestNextOccurrences(t *testing.T) {
+	tests := []struct {
+		name  string
+		rule  string
+		after time.Time
+		count int
+		want  []time.Time
+	}{
+		{
+			name:  "NextOccurrences_Weekly",
+			rule:  "DTSTART:20220404T093000Z\nRRULE:FREQ=WEEKLY;BYDAY=MO",
+			after: time.Date(2022, time.April, 4, 9, 30, 0, 0, time.UTC),
+			count: 2,
+			want: []time.Time{
+				time.Date(2022, time.April, 11, 9, 30, 0, 0, time.UTC),
+				time.Date(2022, time.April, 18, 9, 30, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "NextOccurrences_EndsWithCount",
+			rule:  "DTSTART:20220131T000000Z\nRRULE:FREQ=MONTHLY;COUNT=3",
+			after: time.Date(2022, time.February, 1, 0, 0, 0, 0, time.UTC),
+			count: 5,
+			want: []time.Time{
+				time.Date(2022, time.March, 31, 0, 0, 0, 0, time.UTC),
+				time.Date(2022, time.May, 31, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "NextOccurrences_Ended",
+			rule:  "DTSTART:20220101T000000Z\nRRULE:FREQ=DAILY;UNTIL=20220103T000000Z",
+			after: time.Date(2022, time.January, 3, 0, 0, 0, 0, time.UTC),
+			count: 1,
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := NextOccurrences(tt.rule, tt.after, tt.count)
+			if err != nil {
+				t.Fatalf("expect no error, but got %v", err)
+			}
+			if len(output) != len(tt.want) {
+				t.Fatalf("expect %v, but got %v", tt.want, output)
+			}
+			for i := range output {
+				if !output[i].Equal(tt.want[i]) {
+					t.Errorf("expect %v, but got %v", tt.want[i], output[i])
+				}
+			}
+		})
+	}
+
+	if _, err := NextOccurrences("FREQ=NEVER", time.Now(), 1); err != ErrInvalidRecurrence {
+		t.Errorf("expect error %v, but got %v", ErrInvalidRecurrence, err)
+	}
+}
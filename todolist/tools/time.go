@@ -1,6 +1,8 @@
 package tools
 
 import (
+	"database/sql"
+	"strings"
 	"time"
 )
 
@@ -9,3 +11,22 @@ const TimeLayout string = "2006-01-02T15:04:05.000Z"
 func FormatDate(t time.Time) string {
 	return t.Format(TimeLayout)
 }
+
+// ParseNullTime parse a date with TimeLayout, an empty value returns an invalid sql.NullTime
+func ParseNullTime(value string) (sql.NullTime, error) {
+	var nullTime sql.NullTime
+
+	if len(strings.TrimSpace(value)) == 0 {
+		return nullTime, nil
+	}
+
+	t, err := time.Parse(TimeLayout, value)
+	if err != nil {
+		return nullTime, err
+	}
+
+	nullTime.Time = t
+	nullTime.Valid = true
+
+	return nullTime, nil
+}
@@ -11,3 +11,43 @@ func TestFormatDate(t *testing.T) {
 		t.Error("datetime must be the same ")
 	}
 }
+
+func TestParseNullTime(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		wantValid bool
+		wantErr   bool
+	}{
+		{
+			name:      "ParseNullTime_Empty",
+			input:     "",
+			wantValid: false,
+			wantErr:   false,
+		},
+		{
+			name:      "ParseNullTime_Success",
+			input:     "2022-03-28T23:37:17.150Z",
+			wantValid: true,
+			wantErr:   false,
+		},
+		{
+			name:      "ParseNullTime_InvalidLayout",
+			input:     "28/03/2022",
+			wantValid: false,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := ParseNullTime(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expect error %v, but got %v", tt.wantErr, err)
+			}
+			if output.Valid != tt.wantValid {
+				t.Errorf("expect valid %v, but got %v", tt.wantValid, output.Valid)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package tools
+
+import (
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// WatchAcceptedHeader is the metadata key sent before the first event of a watch, a refused watch ends without it
+const WatchAcceptedHeader string = "x-watch-accepted"
+
+// SetWatchAccepted tells the client of a stream that the watch was accepted
+func SetWatchAccepted(stream grpc.ServerStream) error {
+	return stream.SendHeader(metadata.Pairs(WatchAcceptedHeader, "true"))
+}
+
+// IsWatchAccepted tells if the headers of a stream are the ones of an accepted watch
+func IsWatchAccepted(header metadata.MD) bool {
+	return len(header.Get(WatchAcceptedHeader)) > 0
+}